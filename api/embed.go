@@ -0,0 +1,9 @@
+// Package api содержит OpenAPI спецификацию сервиса.
+package api
+
+import _ "embed"
+
+// OpenAPISpec исходный текст api/openapi.yml, отдается по /openapi.yml
+//
+//go:embed openapi.yml
+var OpenAPISpec []byte
@@ -2,111 +2,438 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
+	"GreatProject/internal/auth"
+	"GreatProject/internal/config"
 	"GreatProject/internal/db"
 	"GreatProject/internal/generated"
 	"GreatProject/internal/handlers"
+	"GreatProject/internal/health"
+	"GreatProject/internal/logging"
+	"GreatProject/internal/metrics"
+	"GreatProject/internal/middleware"
+	"GreatProject/internal/notify"
+	"GreatProject/internal/ratelimit"
 	"GreatProject/internal/repository"
 	"GreatProject/internal/service"
+	"GreatProject/internal/storage"
+	"GreatProject/internal/tracing"
+	"GreatProject/internal/validation"
 
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
 )
 
+const usage = `Usage:
+  server [flags]               start the API server
+  server config print [flags]  print the effective configuration with secrets redacted
+
+Run "server -h" for the list of flags.`
+
 func main() {
-	conn, err := pgx.Connect(context.Background(), getDatabaseURL())
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] == "config" {
+		os.Exit(configCommand(args[1:]))
+	}
 
+	cfg, err := loadConfig(args)
+	if errors.Is(err, flag.ErrHelp) {
+		fmt.Fprintln(os.Stderr, usage)
+		return
+	}
 	if err != nil {
-		log.Fatalf("Failed to connect to database: %v", err)
+		fatal("Invalid configuration", err)
 	}
 
-	defer conn.Close(context.Background())
+	run(cfg)
+}
+
+// configCommand обрабатывает "server config print"
+func configCommand(args []string) int {
+	if len(args) == 0 || args[0] != "print" {
+		fmt.Fprintln(os.Stderr, usage)
+		return 2
+	}
+
+	cfg, err := config.Load(args[1:], os.LookupEnv, os.Stderr)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	if err := config.Print(os.Stdout, cfg); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	// Печатаем и невалидную конфигурацию: так проще увидеть, откуда взялось неверное значение
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "configuration is invalid:\n%v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// loadConfig собирает и проверяет конфигурацию: файл < переменные окружения < флаги
+func loadConfig(args []string) (config.Config, error) {
+	cfg, err := config.Load(args, os.LookupEnv, os.Stderr)
+	if err != nil {
+		return cfg, err
+	}
+	return cfg, cfg.Validate()
+}
+
+func run(cfg config.Config) {
+	// Логи: уровень и формат можно поменять на лету через /admin/logging
+	logs, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		fatal("Invalid logging configuration", err)
+	}
+	logger := slog.New(logs.Handler())
+	slog.SetDefault(logger)
+
+	// Базовый контекст всех запросов: отменяется, если graceful shutdown не уложился в таймаут
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+
+	// Трассировка: tracing.exporter = stdout, file или otlp, по умолчанию выключена
+	shutdownTracing, err := tracing.Setup(baseCtx, tracing.Config{
+		Exporter:    cfg.Tracing.Exporter,
+		File:        cfg.Tracing.File,
+		ServiceName: cfg.Tracing.ServiceName,
+		SampleRatio: cfg.Tracing.SampleRatio,
+	})
+	if err != nil {
+		fatal("Invalid tracing configuration", err)
+	}
+
+	queryTracer := multitracer.New(
+		logging.NewQueryTracer(logger, cfg.Database.SlowQueryThreshold),
+		tracing.NewQueryTracer(),
+	)
+	pool, err := db.Connect(baseCtx, cfg.Database.DatabaseURL(), queryTracer)
+	if err != nil {
+		fatal("Failed to connect to database", err)
+	}
 
 	// Создаем Queries для работы с БД
-	queries := db.New(conn)
+	queries := db.New(pool)
+
+	// Метрики Prometheus: runtime, пул соединений, бизнес-метрики по задачам
+	registry := metrics.NewRegistry()
 
 	// Создаем слои приложения (Repository → Service → Handler)
-	taskRepo := repository.NewTaskRepository(queries)
-	taskService := service.NewTaskService(taskRepo)
-	taskHandler := handlers.NewTaskHandler(taskService)
+	taskRepo := repository.NewTracedTaskRepository(repository.NewTaskRepository(queries))
+	projectRepo := repository.NewProjectRepository(queries)
+	activityRepo := repository.NewActivityRepository(queries)
+	dependencyRepo := repository.NewDependencyRepository(queries)
+	checklistRepo := repository.NewChecklistRepository(queries)
+	assigneeRepo := repository.NewAssigneeRepository(queries)
+	customFieldRepo := repository.NewCustomFieldRepository(queries)
+	milestoneRepo := repository.NewMilestoneRepository(queries)
+	// операции из нескольких запросов, которые должны выполниться целиком
+	transactor := repository.NewTransactor(pool)
+	limits := service.Limits{
+		MaxTasksPerUser: int64(cfg.Quota.MaxTasksPerUser),
+	}
+	authorizer := service.NewAuthorizer(projectRepo)
+	taskService := service.NewTaskService(taskRepo, authorizer, activityRepo, dependencyRepo, checklistRepo, assigneeRepo, customFieldRepo, milestoneRepo, transactor, limits)
+	registry.MustRegister(
+		metrics.NewPoolCollector(pool),
+		metrics.NewTaskCollector(taskRepo),
+	)
+	taskService = service.NewInstrumentedTaskService(service.NewTracedTaskService(taskService), registry)
+	projectService := service.NewProjectService(projectRepo, authorizer)
+	commentService := service.NewCommentService(repository.NewCommentRepository(queries), activityRepo, taskRepo, projectRepo, authorizer)
+	dependencyService := service.NewDependencyService(dependencyRepo, taskRepo, authorizer)
+	checklistService := service.NewChecklistService(checklistRepo, taskRepo, customFieldRepo, activityRepo, transactor, authorizer, limits)
+	customFieldService := service.NewCustomFieldService(customFieldRepo, authorizer)
+	timeService := service.NewTimeService(repository.NewTimeEntryRepository(queries), taskRepo, authorizer)
+	reminderService := service.NewReminderService(repository.NewReminderRepository(queries), taskRepo, notifier(cfg.Reminders), authorizer)
+	if cfg.Reminders.Enabled {
+		go service.RunReminderScheduler(baseCtx, reminderService, cfg.Reminders.Interval)
+	}
+	templateService := service.NewTemplateService(repository.NewTemplateRepository(queries), taskRepo, customFieldRepo, activityRepo, transactor, authorizer, limits)
+	milestoneService := service.NewMilestoneService(milestoneRepo, authorizer)
+	statsService := service.NewStatsService(repository.NewStatsRepository(queries), transactor, authorizer)
+	apiKeyService := service.NewAPIKeyService(repository.NewAPIKeyRepository(queries))
+
+	// Вложения: содержимое на диске или в S3, описание в БД
+	blobs, err := blobStore(baseCtx, cfg.Storage)
+	if err != nil {
+		fatal("Failed to initialize attachment storage", err)
+	}
+	attachmentService := service.NewAttachmentService(repository.NewAttachmentRepository(queries), taskRepo, blobs, authorizer, service.AttachmentLimits{
+		MaxSize:      int64(cfg.Storage.MaxFileSize),
+		AllowedTypes: cfg.Storage.AllowedTypeList(),
+	})
+	go service.RunAttachmentCleanup(baseCtx, attachmentService, cfg.Storage.CleanupInterval)
+
+	spec, err := generated.GetSwagger()
+	if err != nil {
+		fatal("Failed to load OpenAPI spec", err)
+	}
+	validator := validation.New(spec)
+
+	// Проверки готовности: БД отвечает, схема нужной версии, пул не исчерпан
+	healthService := health.New(health.Config{
+		Timeout:  cfg.Health.CheckTimeout,
+		CacheTTL: cfg.Health.CacheTTL,
+	})
+	healthService.AddReadinessCheck(handlers.DatabaseCheckName, health.DatabaseCheck(pool))
+	healthService.AddReadinessCheck("schema", health.SchemaCheck(queries, db.SchemaVersion))
+	healthService.AddReadinessCheck("pool", health.PoolCheck(pool, cfg.Database.PoolSaturationLimit))
+
+	server := handlers.NewServer(
+		handlers.NewTaskHandler(taskService),
+		handlers.NewCommentHandler(commentService),
+		handlers.NewAttachmentHandler(attachmentService),
+		handlers.NewDependencyHandler(dependencyService, taskService),
+		handlers.NewChecklistHandler(checklistService),
+		handlers.NewCustomFieldHandler(customFieldService),
+		handlers.NewTimeEntryHandler(timeService),
+		handlers.NewReminderHandler(reminderService),
+		handlers.NewTemplateHandler(templateService),
+		handlers.NewMilestoneHandler(milestoneService, taskService),
+		handlers.NewStatsHandler(statsService),
+		handlers.NewProjectHandler(projectService),
+		handlers.NewAPIKeyHandler(apiKeyService),
+		handlers.NewHealthHandler(healthService, spec.Info.Version),
+	)
 
 	// Создаем Echo сервер
 	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	e.Server.BaseContext = func(net.Listener) context.Context {
+		return baseCtx
+	}
+	e.HTTPErrorHandler = handlers.HTTPErrorHandler
+	// Без доверенного прокси X-Forwarded-For подделывается клиентом, и по нему нельзя считать лимиты
+	if cfg.Server.TrustProxy {
+		e.IPExtractor = echo.ExtractIPFromXFFHeader()
+	} else {
+		e.IPExtractor = echo.ExtractIPDirect()
+	}
+
+	routeTimeouts, err := middleware.ParseRouteTimeouts(cfg.Server.RouteTimeouts)
+	if err != nil {
+		fatal("Invalid server.route_timeouts", err)
+	}
 
 	// Middleware
-	e.Use(middleware.Logger())
-	e.Use(middleware.Recover())
-	e.Use(middleware.CORS())
+	e.Use(middleware.RequestID())
+	e.Use(tracing.Middleware())
+	e.Use(middleware.RequestLogger(logger))
+	e.Use(metrics.Middleware(registry))
+	e.Use(echomiddleware.Recover())
+	e.Use(echomiddleware.CORS())
+	e.Use(middleware.Timeout(middleware.TimeoutConfig{
+		Default: cfg.Server.RequestTimeout,
+		Routes:  routeTimeouts,
+	}))
+	// Отклоненные учетные данные считаются по IP до аутентификации, остальные лимиты - после нее,
+	// по ключу или пользователю
+	var rateLimits ratelimit.Store
+	if cfg.RateLimit.Enabled {
+		rateLimits = ratelimit.NewMemoryStore()
+		if cfg.RateLimit.Backend == "postgres" {
+			rateLimits = ratelimit.NewPostgresStore(queries)
+		}
+		e.Use(ratelimit.FailedAuthMiddleware(failedAuthConfig(cfg.RateLimit, rateLimits)))
+	}
+	e.Use(auth.Middleware(authConfig(cfg.Auth, apiKeyService)))
+	if cfg.RateLimit.Enabled {
+		e.Use(ratelimit.Middleware(rateLimitConfig(baseCtx, cfg.RateLimit, rateLimits)))
+	} else {
+		slog.Warn("rate limiting is disabled")
+	}
+	e.Use(validator.Middleware(validation.MiddlewareConfig{
+		ValidateResponses: cfg.Server.ValidateResponses,
+	}))
 
 	// Регистрируем роуты
-	generated.RegisterHandlers(e, taskHandler)
+	generated.RegisterHandlers(e, server)
+	handlers.RegisterDocsRoutes(e)
+	metrics.RegisterRoutes(e, registry)
 
-	// Health check endpoint
-	e.GET("/health", func(c echo.Context) error {
-		return c.JSON(http.StatusOK, map[string]string{
-			"status": "ok",
-			"time":   time.Now().Format(time.RFC3339),
-		})
-	})
+	if cfg.Admin.Token != "" {
+		handlers.RegisterAdminRoutes(e, handlers.NewAdminHandler(logs), cfg.Admin.Token)
+	} else {
+		slog.Warn("admin token is not set, admin endpoints are disabled")
+	}
 
 	// Запуск сервера
-	port := getPort()
+	port := strconv.Itoa(cfg.Server.Port)
 
 	// Graceful shutdown
 	go func() {
 		if err := e.Start(":" + port); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start server: %v", err)
+			fatal("Failed to start server", err)
 		}
 	}()
 
-	fmt.Printf("🚀 Server started on port %s\n", port)
-	fmt.Println("📚 API Documentation: http://localhost:" + port + "/swagger")
-	fmt.Println("🔍 Health check: http://localhost:" + port + "/health")
+	slog.Info("server started",
+		slog.String("port", port),
+		slog.String("docs", "http://localhost:"+port+"/swagger"),
+		slog.String("health", "http://localhost:"+port+"/health"),
+	)
 
 	// Ждем сигнал для graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	fmt.Println("🛑 Shutting down server...")
+	slog.Info("shutting down server")
 
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// Сначала /readyz начинает отвечать 503, чтобы балансировщик успел снять трафик,
+	// и только потом сервер перестает принимать соединения
+	healthService.Shutdown()
+	time.Sleep(cfg.Server.ShutdownDrainDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
 
+	// Ждем завершения запросов в работе; если не успели - отменяем их контексты,
+	// чтобы запросы к БД прервались, и только потом закрываем пул
 	if err := e.Shutdown(ctx); err != nil {
-		log.Fatalf("Server forced to shutdown: %v", err)
+		slog.Warn("server forced to shutdown, cancelling in-flight requests", slog.String("error", err.Error()))
+		cancelRequests()
+	}
+	pool.Close()
+
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Warn("failed to flush traces", slog.String("error", err.Error()))
 	}
 
-	fmt.Println("✅ Server stopped")
+	slog.Info("server stopped")
+}
+
+// blobStore хранилище содержимого вложений по storage.backend
+func blobStore(ctx context.Context, cfg config.StorageConfig) (storage.BlobStore, error) {
+	if cfg.Backend == "s3" {
+		return storage.NewS3Store(ctx, storage.S3Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+			UseSSL:    cfg.S3UseSSL,
+		})
+	}
+	return storage.NewLocalStore(cfg.LocalDir)
+}
+
+// notifier каналы доставки напоминаний из reminders.notifiers
+func notifier(cfg config.RemindersConfig) notify.Notifier {
+	var channels []notify.Channel
+	for _, name := range cfg.NotifierList() {
+		var n notify.Notifier
+		switch name {
+		case "log":
+			n = notify.NewLogNotifier()
+		case "webhook":
+			n = notify.NewWebhookNotifier(notify.WebhookConfig{
+				URL:    cfg.WebhookURL,
+				Secret: cfg.WebhookSecret,
+			})
+		case "smtp":
+			n = notify.NewSMTPNotifier(notify.SMTPConfig{
+				Host:     cfg.SMTPHost,
+				Port:     cfg.SMTPPort,
+				Username: cfg.SMTPUsername,
+				Password: cfg.SMTPPassword,
+				From:     cfg.SMTPFrom,
+			})
+		default:
+			continue
+		}
+		channels = append(channels, notify.Channel{Name: name, Notifier: n})
+	}
+	return notify.Multi(channels...)
 }
 
-func getDatabaseURL() string {
-	host := getEnv("DB_HOST", "localhost")
-	port := getEnv("DB_PORT", "5432")
-	user := getEnv("DB_USER", "postgres")
-	password := getEnv("DB_PASSWORD", "Kekos228@")
-	dbname := getEnv("DB_NAME", "tasks_db")
-	sslmode := getEnv("DB_SSLMODE", "disable")
+// rateLimitConfig настройки лимитов; запускает очистку простаивающих корзин до отмены ctx.
+// Служебные эндпоинты не ограничиваются.
+func rateLimitConfig(ctx context.Context, cfg config.RateLimitConfig, store ratelimit.Store) ratelimit.Config {
+	read := ratelimit.PerMinute(cfg.ReadPerMinute, cfg.ReadBurst)
+	write := ratelimit.PerMinute(cfg.WritePerMinute, cfg.WriteBurst)
+	failures := ratelimit.PerMinute(cfg.AuthFailuresPerMinute, cfg.AuthFailuresBurst)
+	go ratelimit.RunCleanup(ctx, store, time.Minute, max(read.Window(), write.Window(), failures.Window()))
 
-	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s",
-		user, password, host, port, dbname, sslmode)
+	return ratelimit.Config{
+		Store:   store,
+		Read:    read,
+		Write:   write,
+		Skipper: serviceRoute,
+	}
 }
 
-func getPort() string {
-	return getEnv("PORT", "8080")
+// failedAuthConfig лимит отклоненных учетных данных с одного IP адреса; корзины удаляет
+// очистка, запущенная rateLimitConfig
+func failedAuthConfig(cfg config.RateLimitConfig, store ratelimit.Store) ratelimit.FailedAuthConfig {
+	return ratelimit.FailedAuthConfig{
+		Store:   store,
+		Limit:   ratelimit.PerMinute(cfg.AuthFailuresPerMinute, cfg.AuthFailuresBurst),
+		Skipper: serviceRoute,
+	}
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+// authConfig настройки аутентификации: API ключи принимаются всегда, JWT - если задан секрет.
+// Ключами управляют только пользователи (это проверяет APIKeyService), поэтому права ключей
+// проверяются лишь на маршрутах задач.
+func authConfig(cfg config.AuthConfig, apiKeys auth.APIKeyAuthenticator) auth.Config {
+	var verifier *auth.JWTVerifier
+	if cfg.JWTSecret != "" {
+		verifier = auth.NewJWTVerifier([]byte(cfg.JWTSecret), cfg.JWTIssuer)
+	} else {
+		slog.Warn("auth.jwt_secret is not set, user JWTs are not accepted")
 	}
-	return defaultValue
+
+	return auth.Config{
+		JWT:      verifier,
+		APIKeys:  apiKeys,
+		Required: cfg.Required,
+		ScopeFunc: func(c echo.Context) string {
+			if strings.HasPrefix(c.Path(), "/api-keys") {
+				return ""
+			}
+			return auth.TaskScope(c)
+		},
+		// Документация открыта, а /admin проверяет свой токен
+		Skipper: func(c echo.Context) bool {
+			path := c.Request().URL.Path
+			return serviceRoute(c) || path == "/openapi.yml" ||
+				strings.HasPrefix(path, "/swagger") || strings.HasPrefix(path, "/admin/")
+		},
+	}
+}
+
+// serviceRoute служебные эндпоинты, которые опрашивают балансировщик и Prometheus
+func serviceRoute(c echo.Context) bool {
+	switch c.Path() {
+	case "/health", "/livez", "/readyz", "/metrics":
+		return true
+	}
+	return false
+}
+
+// fatal пишет ошибку в лог и завершает процесс
+func fatal(message string, err error) {
+	slog.Error(message, slog.String("error", err.Error()))
+	os.Exit(1)
 }
@@ -0,0 +1,210 @@
+package apperrors
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Категории доменных ошибок. Конкретные ошибки ниже сопоставляются с ними через errors.Is.
+var (
+	ErrNotFound    = errors.New("not found")
+	ErrConflict    = errors.New("conflict")
+	ErrValidation  = errors.New("validation failed")
+	ErrUnavailable = errors.New("service unavailable")
+	ErrQuota       = errors.New("quota exceeded")
+	// ErrUnauthenticated операция требует аутентифицированного клиента
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("forbidden")
+	ErrTooLarge        = errors.New("payload too large")
+	ErrUnsupportedType = errors.New("unsupported media type")
+)
+
+// NotFoundError запрошенный ресурс не существует
+type NotFoundError struct {
+	Resource string
+	ID       any
+}
+
+func NewNotFound(resource string, id any) *NotFoundError {
+	return &NotFoundError{Resource: resource, ID: id}
+}
+
+func (e *NotFoundError) Error() string {
+	if e.ID == nil {
+		return e.Resource + " not found"
+	}
+	return fmt.Sprintf("%s %v not found", e.Resource, e.ID)
+}
+
+func (e *NotFoundError) Is(target error) bool {
+	return target == ErrNotFound
+}
+
+// ConflictError операция противоречит текущему состоянию данных (дубликат, нарушение связи)
+type ConflictError struct {
+	Message    string
+	Constraint string
+	Err        error
+}
+
+func NewConflict(message string, err error) *ConflictError {
+	return &ConflictError{Message: message, Err: err}
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ConflictError) Is(target error) bool {
+	return target == ErrConflict
+}
+
+// ValidationError некорректные входные данные с описанием нарушений по полям
+type ValidationError struct {
+	// Fields путь поля -> описание нарушения
+	Fields map[string]string
+}
+
+func NewValidation(field, message string) *ValidationError {
+	return &ValidationError{Fields: map[string]string{field: message}}
+}
+
+func (e *ValidationError) Error() string {
+	fields := make([]string, 0, len(e.Fields))
+	for field := range e.Fields {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	parts := make([]string, len(fields))
+	for i, field := range fields {
+		parts[i] = field + ": " + e.Fields[field]
+	}
+	return "validation failed: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrValidation
+}
+
+// UnavailableError временная недоступность зависимости (БД, внешний сервис)
+type UnavailableError struct {
+	Err error
+}
+
+func NewUnavailable(err error) *UnavailableError {
+	return &UnavailableError{Err: err}
+}
+
+func (e *UnavailableError) Error() string {
+	return "service unavailable: " + e.Err.Error()
+}
+
+func (e *UnavailableError) Unwrap() error {
+	return e.Err
+}
+
+func (e *UnavailableError) Is(target error) bool {
+	return target == ErrUnavailable
+}
+
+// QuotaExceededError у пользователя исчерпана квота на число ресурсов
+type QuotaExceededError struct {
+	Resource string
+	Limit    int64
+}
+
+func NewQuotaExceeded(resource string, limit int64) *QuotaExceededError {
+	return &QuotaExceededError{Resource: resource, Limit: limit}
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota exceeded: limit is %d", e.Resource, e.Limit)
+}
+
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuota
+}
+
+// ForbiddenError у клиента нет права Permission на операцию
+type ForbiddenError struct {
+	Message    string
+	Permission string
+}
+
+func NewForbidden(message, permission string) *ForbiddenError {
+	return &ForbiddenError{Message: message, Permission: permission}
+}
+
+func (e *ForbiddenError) Error() string {
+	if e.Permission == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s is required", e.Message, e.Permission)
+}
+
+func (e *ForbiddenError) Is(target error) bool {
+	return target == ErrForbidden
+}
+
+// TooLargeError содержимое превышает допустимый размер Limit байт
+type TooLargeError struct {
+	Limit int64
+}
+
+func NewTooLarge(limit int64) *TooLargeError {
+	return &TooLargeError{Limit: limit}
+}
+
+func (e *TooLargeError) Error() string {
+	return fmt.Sprintf("payload exceeds the %d bytes limit", e.Limit)
+}
+
+func (e *TooLargeError) Is(target error) bool {
+	return target == ErrTooLarge
+}
+
+// UnsupportedTypeError тип содержимого ContentType не разрешен
+type UnsupportedTypeError struct {
+	ContentType string
+}
+
+func NewUnsupportedType(contentType string) *UnsupportedTypeError {
+	return &UnsupportedTypeError{ContentType: contentType}
+}
+
+func (e *UnsupportedTypeError) Error() string {
+	return fmt.Sprintf("content type %s is not allowed", e.ContentType)
+}
+
+func (e *UnsupportedTypeError) Is(target error) bool {
+	return target == ErrUnsupportedType
+}
+
+// BlockedError задачу TaskID нельзя выполнить, пока не выполнены задачи Blockers
+type BlockedError struct {
+	TaskID   int32
+	Blockers []int32
+}
+
+func NewBlocked(taskID int32, blockers []int32) *BlockedError {
+	return &BlockedError{TaskID: taskID, Blockers: blockers}
+}
+
+func (e *BlockedError) Error() string {
+	ids := make([]string, len(e.Blockers))
+	for i, id := range e.Blockers {
+		ids[i] = fmt.Sprint(id)
+	}
+	return fmt.Sprintf("task %d is blocked by open tasks %s", e.TaskID, strings.Join(ids, ", "))
+}
+
+func (e *BlockedError) Is(target error) bool {
+	return target == ErrConflict
+}
@@ -0,0 +1,35 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+)
+
+// APIKeyPrefix начало каждого API ключа: по нему ключ отличается от JWT в заголовке Authorization
+const APIKeyPrefix = "tdk_"
+
+// apiKeyDisplayLength сколько первых символов ключа хранится открыто для отображения в списке
+const apiKeyDisplayLength = 12
+
+// NewAPIKey генерирует секрет ключа и возвращает его вместе с отображаемым префиксом и хешем для хранения
+func NewAPIKey() (key, prefix string, hash []byte, err error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", nil, err
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key), nil
+}
+
+// HashAPIKey хеш ключа для поиска в БД. Ключ случайный и длинный, поэтому медленный хеш паролей не нужен.
+func HashAPIKey(key string) []byte {
+	sum := sha256.Sum256([]byte(key))
+	return sum[:]
+}
+
+// IsAPIKey похожа ли строка на API ключ
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+
+	"GreatProject/internal/tenant"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidCredentials токен или ключ не прошел проверку: неизвестен, отозван, истек или подделан
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+// JWTVerifier проверяет JWT пользователей, выпущенные провайдером входа (HS256).
+// Идентификатор пользователя - число в claim sub, рабочее пространство - в claim workspace_id
+// (без него - пространство по умолчанию).
+type JWTVerifier struct {
+	secret []byte
+	parser *jwt.Parser
+}
+
+// NewJWTVerifier проверка подписи секретом secret; непустой issuer дополнительно сверяется с claim iss
+func NewJWTVerifier(secret []byte, issuer string) *JWTVerifier {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+		jwt.WithExpirationRequired(),
+	}
+	if issuer != "" {
+		options = append(options, jwt.WithIssuer(issuer))
+	}
+	return &JWTVerifier{
+		secret: secret,
+		parser: jwt.NewParser(options...),
+	}
+}
+
+// Verify проверяет токен и возвращает пользователя из него
+func (v *JWTVerifier) Verify(token string) (Principal, error) {
+	var claims userClaims
+	if _, err := v.parser.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return v.secret, nil
+	}); err != nil {
+		return Principal{}, errors.Join(ErrInvalidCredentials, err)
+	}
+
+	userID, err := strconv.ParseInt(claims.Subject, 10, 32)
+	if err != nil || userID <= 0 {
+		return Principal{}, errors.Join(ErrInvalidCredentials, errors.New("sub must be a positive user id"))
+	}
+	workspaceID := tenant.DefaultWorkspaceID
+	if claims.WorkspaceID != nil {
+		if *claims.WorkspaceID <= 0 {
+			return Principal{}, errors.Join(ErrInvalidCredentials, errors.New("workspace_id must be a positive id"))
+		}
+		workspaceID = *claims.WorkspaceID
+	}
+	return Principal{UserID: int32(userID), WorkspaceID: workspaceID}, nil
+}
+
+type userClaims struct {
+	jwt.RegisteredClaims
+	WorkspaceID *int32 `json:"workspace_id,omitempty"`
+}
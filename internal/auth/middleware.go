@@ -0,0 +1,123 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/tenant"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// HeaderAPIKey альтернативный заголовок для API ключа
+const HeaderAPIKey = "X-API-Key"
+
+// APIKeyAuthenticator проверяет API ключ и отмечает его использование с адреса ip
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, key, ip string) (Principal, error)
+}
+
+// Config настройки аутентификации запросов
+type Config struct {
+	// JWT проверка токенов пользователей; nil - JWT не принимаются
+	JWT     *JWTVerifier
+	APIKeys APIKeyAuthenticator
+	// Required запрещает анонимные запросы
+	Required bool
+	// ScopeFunc право, нужное API ключу для запроса; по умолчанию TaskScope
+	ScopeFunc func(c echo.Context) string
+	Skipper   echomiddleware.Skipper
+}
+
+// TaskScope чтение задач для GET, HEAD и OPTIONS, запись - для остальных методов
+func TaskScope(c echo.Context) string {
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return ScopeTasksRead
+	}
+	return ScopeTasksWrite
+}
+
+// Middleware аутентифицирует запрос по API ключу (X-API-Key или Authorization: Bearer tdk_...)
+// или JWT пользователя (Authorization: Bearer) и сохраняет Principal в контексте запроса.
+// Неверные учетные данные - всегда 401, даже если анонимный доступ разрешен.
+func Middleware(config Config) echo.MiddlewareFunc {
+	if config.ScopeFunc == nil {
+		config.ScopeFunc = TaskScope
+	}
+	if config.Skipper == nil {
+		config.Skipper = echomiddleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			token := credentials(c.Request())
+			if token == "" {
+				if config.Required {
+					return unauthorized(c, "authentication required")
+				}
+				c.SetRequest(c.Request().WithContext(tenant.WithWorkspace(c.Request().Context(), tenant.DefaultWorkspaceID)))
+				return next(c)
+			}
+
+			principal, err := config.authenticate(c, token)
+			if errors.Is(err, ErrInvalidCredentials) {
+				return unauthorized(c, "invalid or expired credentials").SetInternal(err)
+			}
+			if err != nil {
+				return err
+			}
+
+			if scope := config.ScopeFunc(c); scope != "" && !principal.HasScope(scope) {
+				return apperrors.NewForbidden("API key does not have the required scope", scope)
+			}
+
+			ctx := tenant.WithWorkspace(WithPrincipal(c.Request().Context(), principal), principal.WorkspaceID)
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+func (config Config) authenticate(c echo.Context, token string) (Principal, error) {
+	if IsAPIKey(token) {
+		if config.APIKeys == nil {
+			return Principal{}, ErrInvalidCredentials
+		}
+		return config.APIKeys.Authenticate(c.Request().Context(), token, c.RealIP())
+	}
+	if config.JWT == nil {
+		return Principal{}, ErrInvalidCredentials
+	}
+	return config.JWT.Verify(token)
+}
+
+// HasCredentials запрос предъявляет API ключ или токен, которые проверит Middleware
+func HasCredentials(r *http.Request) bool {
+	return credentials(r) != ""
+}
+
+// credentials ключ из X-API-Key или токен из Authorization: Bearer
+func credentials(r *http.Request) string {
+	if key := r.Header.Get(HeaderAPIKey); key != "" {
+		return key
+	}
+	scheme, token, ok := strings.Cut(r.Header.Get(echo.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+func unauthorized(c echo.Context, message string) *echo.HTTPError {
+	c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="todo-api"`)
+	return echo.NewHTTPError(http.StatusUnauthorized, message)
+}
@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+// Права API ключей. Пользователь, вошедший по JWT, имеет все права.
+const (
+	ScopeTasksRead  = "tasks:read"
+	ScopeTasksWrite = "tasks:write"
+)
+
+// Scopes все права, которые можно выдать API ключу
+var Scopes = []string{ScopeTasksRead, ScopeTasksWrite}
+
+// Principal аутентифицированный клиент запроса
+type Principal struct {
+	UserID int32
+	// WorkspaceID рабочее пространство, в котором работает клиент
+	WorkspaceID int32
+	// APIKeyID ключ, которым аутентифицирован запрос; 0 - пользователь вошел по JWT
+	APIKeyID int32
+	// Scopes права API ключа; для пользователя не используются
+	Scopes []string
+}
+
+// IsAPIKey запрос аутентифицирован API ключом, а не пользователем
+func (p Principal) IsAPIKey() bool {
+	return p.APIKeyID != 0
+}
+
+// HasScope есть ли у клиента право scope
+func (p Principal) HasScope(scope string) bool {
+	return !p.IsAPIKey() || slices.Contains(p.Scopes, scope)
+}
+
+type principalKey struct{}
+
+// WithPrincipal сохраняет клиента запроса в контексте
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom возвращает клиента запроса; ok == false для анонимных запросов
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,44 @@
+package auth
+
+import "slices"
+
+// Role роль участника проекта
+type Role string
+
+const (
+	RoleOwner     Role = "owner"
+	RoleEditor    Role = "editor"
+	RoleCommenter Role = "commenter"
+	RoleViewer    Role = "viewer"
+)
+
+// Roles все роли, от самой сильной к самой слабой
+var Roles = []Role{RoleOwner, RoleEditor, RoleCommenter, RoleViewer}
+
+// Permission право на действие в проекте; возвращается клиенту в details.permission ответа 403
+type Permission string
+
+const (
+	PermissionTasksRead     Permission = "tasks:read"
+	PermissionTasksComment  Permission = "tasks:comment"
+	PermissionTasksWrite    Permission = "tasks:write"
+	PermissionProjectManage Permission = "project:manage"
+)
+
+// rolePermissions права каждой роли; роль включает права всех более слабых ролей
+var rolePermissions = map[Role][]Permission{
+	RoleOwner:     {PermissionTasksRead, PermissionTasksComment, PermissionTasksWrite, PermissionProjectManage},
+	RoleEditor:    {PermissionTasksRead, PermissionTasksComment, PermissionTasksWrite},
+	RoleCommenter: {PermissionTasksRead, PermissionTasksComment},
+	RoleViewer:    {PermissionTasksRead},
+}
+
+// Valid известна ли роль
+func (r Role) Valid() bool {
+	return slices.Contains(Roles, r)
+}
+
+// Allows есть ли у роли право permission
+func (r Role) Allows(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
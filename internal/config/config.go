@@ -0,0 +1,330 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Config настройки сервиса.
+// Тег key задает имя параметра в файле конфигурации и флаг (--database.host),
+// тег env - переменную окружения, secret - что значение скрывается при печати.
+type Config struct {
+	Server    ServerConfig    `key:"server"`
+	Database  DatabaseConfig  `key:"database"`
+	Log       LogConfig       `key:"log"`
+	Tracing   TracingConfig   `key:"tracing"`
+	Health    HealthConfig    `key:"health"`
+	Admin     AdminConfig     `key:"admin"`
+	Auth      AuthConfig      `key:"auth"`
+	RateLimit RateLimitConfig `key:"ratelimit"`
+	Quota     QuotaConfig     `key:"quota"`
+	Storage   StorageConfig   `key:"storage"`
+	Reminders RemindersConfig `key:"reminders"`
+}
+
+type ServerConfig struct {
+	Port               int           `key:"port" env:"PORT"`
+	RequestTimeout     time.Duration `key:"request_timeout" env:"REQUEST_TIMEOUT"`
+	RouteTimeouts      string        `key:"route_timeouts" env:"ROUTE_TIMEOUTS"`
+	ShutdownTimeout    time.Duration `key:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
+	ShutdownDrainDelay time.Duration `key:"shutdown_drain_delay" env:"SHUTDOWN_DRAIN_DELAY"`
+	ValidateResponses  bool          `key:"validate_responses" env:"OPENAPI_VALIDATE_RESPONSES"`
+	// TrustProxy брать IP клиента из X-Forwarded-For/X-Real-IP; включать только за доверенным прокси
+	TrustProxy bool `key:"trust_proxy" env:"TRUST_PROXY"`
+}
+
+type DatabaseConfig struct {
+	// URL строка подключения целиком; если задана, остальные параметры подключения не используются
+	URL                 string        `key:"url" env:"DATABASE_URL" secret:"true"`
+	Host                string        `key:"host" env:"DB_HOST"`
+	Port                int           `key:"port" env:"DB_PORT"`
+	User                string        `key:"user" env:"DB_USER"`
+	Password            string        `key:"password" env:"DB_PASSWORD" secret:"true"`
+	Name                string        `key:"name" env:"DB_NAME"`
+	SSLMode             string        `key:"sslmode" env:"DB_SSLMODE"`
+	SlowQueryThreshold  time.Duration `key:"slow_query_threshold" env:"SLOW_QUERY_THRESHOLD"`
+	PoolSaturationLimit float64       `key:"pool_saturation_threshold" env:"POOL_SATURATION_THRESHOLD"`
+}
+
+type LogConfig struct {
+	Level  string `key:"level" env:"LOG_LEVEL"`
+	Format string `key:"format" env:"LOG_FORMAT"`
+}
+
+type TracingConfig struct {
+	Exporter    string  `key:"exporter" env:"TRACING_EXPORTER"`
+	File        string  `key:"file" env:"TRACING_FILE"`
+	ServiceName string  `key:"service_name" env:"OTEL_SERVICE_NAME"`
+	SampleRatio float64 `key:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+}
+
+type HealthConfig struct {
+	CheckTimeout time.Duration `key:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+	CacheTTL     time.Duration `key:"cache_ttl" env:"HEALTH_CACHE_TTL"`
+}
+
+type AdminConfig struct {
+	// Token включает /admin/*; пустой токен отключает служебные эндпоинты
+	Token string `key:"token" env:"ADMIN_TOKEN" secret:"true"`
+}
+
+type AuthConfig struct {
+	// JWTSecret ключ проверки подписи JWT пользователей (HS256); пустой - JWT не принимаются
+	JWTSecret string `key:"jwt_secret" env:"JWT_SECRET" secret:"true"`
+	JWTIssuer string `key:"jwt_issuer" env:"JWT_ISSUER"`
+	// Required запрещает анонимные запросы к API
+	Required bool `key:"required" env:"AUTH_REQUIRED"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `key:"enabled" env:"RATE_LIMIT_ENABLED"`
+	// Backend memory - корзины в памяти процесса, postgres - общие для всех экземпляров
+	Backend        string  `key:"backend" env:"RATE_LIMIT_BACKEND"`
+	ReadPerMinute  float64 `key:"read_per_minute" env:"RATE_LIMIT_READ_PER_MINUTE"`
+	ReadBurst      int     `key:"read_burst" env:"RATE_LIMIT_READ_BURST"`
+	WritePerMinute float64 `key:"write_per_minute" env:"RATE_LIMIT_WRITE_PER_MINUTE"`
+	WriteBurst     int     `key:"write_burst" env:"RATE_LIMIT_WRITE_BURST"`
+	// AuthFailuresPerMinute и AuthFailuresBurst лимит отклоненных учетных данных с одного IP адреса
+	AuthFailuresPerMinute float64 `key:"auth_failures_per_minute" env:"RATE_LIMIT_AUTH_FAILURES_PER_MINUTE"`
+	AuthFailuresBurst     int     `key:"auth_failures_burst" env:"RATE_LIMIT_AUTH_FAILURES_BURST"`
+}
+
+type QuotaConfig struct {
+	// MaxTasksPerUser максимум задач у одного пользователя, 0 - без ограничения
+	MaxTasksPerUser int `key:"max_tasks_per_user" env:"MAX_TASKS_PER_USER"`
+}
+
+type StorageConfig struct {
+	// Backend local - файлы на диске в LocalDir, s3 - S3-совместимое хранилище
+	Backend     string `key:"backend" env:"STORAGE_BACKEND"`
+	LocalDir    string `key:"local_dir" env:"STORAGE_LOCAL_DIR"`
+	S3Endpoint  string `key:"s3_endpoint" env:"S3_ENDPOINT"`
+	S3Region    string `key:"s3_region" env:"S3_REGION"`
+	S3Bucket    string `key:"s3_bucket" env:"S3_BUCKET"`
+	S3AccessKey string `key:"s3_access_key" env:"S3_ACCESS_KEY" secret:"true"`
+	S3SecretKey string `key:"s3_secret_key" env:"S3_SECRET_KEY" secret:"true"`
+	S3UseSSL    bool   `key:"s3_use_ssl" env:"S3_USE_SSL"`
+	// MaxFileSize максимальный размер вложения в байтах
+	MaxFileSize int `key:"max_file_size" env:"STORAGE_MAX_FILE_SIZE"`
+	// AllowedTypes разрешенные типы содержимого через запятую, допускаются маски вида image/*; пусто - любые
+	AllowedTypes string `key:"allowed_types" env:"STORAGE_ALLOWED_TYPES"`
+	// CleanupInterval как часто удалять из хранилища содержимое удаленных вложений
+	CleanupInterval time.Duration `key:"cleanup_interval" env:"STORAGE_CLEANUP_INTERVAL"`
+}
+
+type RemindersConfig struct {
+	// Enabled отправлять напоминания из этого экземпляра сервера; экземпляры не отправляют одно напоминание дважды
+	Enabled bool `key:"enabled" env:"REMINDERS_ENABLED"`
+	// Interval как часто искать напоминания, время которых пришло
+	Interval time.Duration `key:"interval" env:"REMINDERS_INTERVAL"`
+	// Notifiers каналы доставки через запятую: log, webhook, smtp
+	Notifiers  string `key:"notifiers" env:"REMINDERS_NOTIFIERS"`
+	WebhookURL string `key:"webhook_url" env:"REMINDERS_WEBHOOK_URL"`
+	// WebhookSecret ключ подписи тела запроса к webhook (HMAC-SHA256); пустой - без подписи
+	WebhookSecret string `key:"webhook_secret" env:"REMINDERS_WEBHOOK_SECRET" secret:"true"`
+	SMTPHost      string `key:"smtp_host" env:"SMTP_HOST"`
+	SMTPPort      int    `key:"smtp_port" env:"SMTP_PORT"`
+	// SMTPUsername пустой - без аутентификации
+	SMTPUsername string `key:"smtp_username" env:"SMTP_USERNAME"`
+	SMTPPassword string `key:"smtp_password" env:"SMTP_PASSWORD" secret:"true"`
+	SMTPFrom     string `key:"smtp_from" env:"SMTP_FROM"`
+}
+
+// NotifierList каналы доставки списком
+func (c RemindersConfig) NotifierList() []string {
+	var notifiers []string
+	for _, n := range strings.Split(c.Notifiers, ",") {
+		if n = strings.TrimSpace(n); n != "" {
+			notifiers = append(notifiers, strings.ToLower(n))
+		}
+	}
+	return notifiers
+}
+
+// AllowedTypeList разрешенные типы содержимого списком
+func (c StorageConfig) AllowedTypeList() []string {
+	var types []string
+	for _, t := range strings.Split(c.AllowedTypes, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			types = append(types, strings.ToLower(t))
+		}
+	}
+	return types
+}
+
+// Default значения по умолчанию. Пароля БД по умолчанию нет: его нужно передать явно.
+func Default() Config {
+	return Config{
+		Server: ServerConfig{
+			Port:            8080,
+			RequestTimeout:  15 * time.Second,
+			ShutdownTimeout: 10 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Host:                "localhost",
+			Port:                5432,
+			User:                "postgres",
+			Name:                "tasks_db",
+			SSLMode:             "disable",
+			SlowQueryThreshold:  200 * time.Millisecond,
+			PoolSaturationLimit: 1,
+		},
+		Log: LogConfig{
+			Level:  "info",
+			Format: "json",
+		},
+		Tracing: TracingConfig{
+			Exporter:    "none",
+			File:        "traces.json",
+			ServiceName: "todo-api",
+			SampleRatio: 1,
+		},
+		Health: HealthConfig{
+			CheckTimeout: 2 * time.Second,
+			CacheTTL:     2 * time.Second,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:               true,
+			Backend:               "memory",
+			ReadPerMinute:         600,
+			ReadBurst:             100,
+			WritePerMinute:        120,
+			WriteBurst:            30,
+			AuthFailuresPerMinute: 10,
+			AuthFailuresBurst:     20,
+		},
+		Storage: StorageConfig{
+			Backend:         "local",
+			LocalDir:        "data/attachments",
+			S3UseSSL:        true,
+			MaxFileSize:     10 << 20,
+			AllowedTypes:    "image/*,text/plain,application/pdf,application/zip",
+			CleanupInterval: time.Minute,
+		},
+		Reminders: RemindersConfig{
+			Enabled:   true,
+			Interval:  30 * time.Second,
+			Notifiers: "log",
+			SMTPPort:  25,
+		},
+	}
+}
+
+// DatabaseURL строка подключения к БД: DATABASE_URL или собранная из отдельных параметров
+func (c DatabaseConfig) DatabaseURL() string {
+	if c.URL != "" {
+		return c.URL
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.User, c.Password),
+		Host:     c.Host + ":" + strconv.Itoa(c.Port),
+		Path:     "/" + c.Name,
+		RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+	}
+	return u.String()
+}
+
+// Validate проверяет все параметры и возвращает все найденные ошибки разом
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port <= 65535, "server.port", "must be between 1 and 65535, got %d", c.Server.Port)
+	check(c.Server.RequestTimeout > 0, "server.request_timeout", "must be positive, got %s", c.Server.RequestTimeout)
+	check(c.Server.ShutdownTimeout > 0, "server.shutdown_timeout", "must be positive, got %s", c.Server.ShutdownTimeout)
+	check(c.Server.ShutdownDrainDelay >= 0, "server.shutdown_drain_delay", "must not be negative, got %s", c.Server.ShutdownDrainDelay)
+
+	if c.Database.URL != "" {
+		u, err := url.Parse(c.Database.URL)
+		check(err == nil && (u.Scheme == "postgres" || u.Scheme == "postgresql"), "database.url",
+			"must be a postgres:// or postgresql:// URL")
+	} else {
+		check(c.Database.Host != "", "database.host", "is required when database.url is not set")
+		check(c.Database.Port > 0 && c.Database.Port <= 65535, "database.port", "must be between 1 and 65535, got %d", c.Database.Port)
+		check(c.Database.User != "", "database.user", "is required when database.url is not set")
+		check(c.Database.Name != "", "database.name", "is required when database.url is not set")
+		check(oneOf(c.Database.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full"), "database.sslmode",
+			"must be one of disable, allow, prefer, require, verify-ca, verify-full, got %q", c.Database.SSLMode)
+	}
+	check(c.Database.SlowQueryThreshold >= 0, "database.slow_query_threshold", "must not be negative, got %s", c.Database.SlowQueryThreshold)
+	check(c.Database.PoolSaturationLimit > 0 && c.Database.PoolSaturationLimit <= 1, "database.pool_saturation_threshold",
+		"must be in (0, 1], got %v", c.Database.PoolSaturationLimit)
+
+	check(oneOf(c.Log.Level, "debug", "info", "warn", "error"), "log.level", "must be one of debug, info, warn, error, got %q", c.Log.Level)
+	check(oneOf(c.Log.Format, "json", "text"), "log.format", "must be json or text, got %q", c.Log.Format)
+
+	check(oneOf(c.Tracing.Exporter, "none", "stdout", "file", "otlp"), "tracing.exporter",
+		"must be one of none, stdout, file, otlp, got %q", c.Tracing.Exporter)
+	check(c.Tracing.Exporter != "file" || c.Tracing.File != "", "tracing.file", "is required for the file exporter")
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "must be between 0 and 1, got %v", c.Tracing.SampleRatio)
+
+	check(c.Health.CheckTimeout > 0, "health.check_timeout", "must be positive, got %s", c.Health.CheckTimeout)
+	check(c.Health.CacheTTL >= 0, "health.cache_ttl", "must not be negative, got %s", c.Health.CacheTTL)
+
+	check(c.Auth.JWTSecret == "" || len(c.Auth.JWTSecret) >= 32, "auth.jwt_secret", "must be at least 32 bytes long")
+
+	if c.RateLimit.Enabled {
+		check(oneOf(c.RateLimit.Backend, "memory", "postgres"), "ratelimit.backend", "must be memory or postgres, got %q", c.RateLimit.Backend)
+		check(c.RateLimit.ReadPerMinute > 0, "ratelimit.read_per_minute", "must be positive, got %v", c.RateLimit.ReadPerMinute)
+		check(c.RateLimit.ReadBurst > 0, "ratelimit.read_burst", "must be positive, got %d", c.RateLimit.ReadBurst)
+		check(c.RateLimit.WritePerMinute > 0, "ratelimit.write_per_minute", "must be positive, got %v", c.RateLimit.WritePerMinute)
+		check(c.RateLimit.WriteBurst > 0, "ratelimit.write_burst", "must be positive, got %d", c.RateLimit.WriteBurst)
+		check(c.RateLimit.AuthFailuresPerMinute > 0, "ratelimit.auth_failures_per_minute", "must be positive, got %v", c.RateLimit.AuthFailuresPerMinute)
+		check(c.RateLimit.AuthFailuresBurst > 0, "ratelimit.auth_failures_burst", "must be positive, got %d", c.RateLimit.AuthFailuresBurst)
+	}
+
+	check(c.Quota.MaxTasksPerUser >= 0, "quota.max_tasks_per_user", "must not be negative, got %d", c.Quota.MaxTasksPerUser)
+
+	check(oneOf(c.Storage.Backend, "local", "s3"), "storage.backend", "must be local or s3, got %q", c.Storage.Backend)
+	switch c.Storage.Backend {
+	case "local":
+		check(c.Storage.LocalDir != "", "storage.local_dir", "is required for the local backend")
+	case "s3":
+		check(c.Storage.S3Endpoint != "", "storage.s3_endpoint", "is required for the s3 backend")
+		check(c.Storage.S3Bucket != "", "storage.s3_bucket", "is required for the s3 backend")
+		check(c.Storage.S3AccessKey != "" && c.Storage.S3SecretKey != "", "storage.s3_access_key",
+			"s3_access_key and s3_secret_key are required for the s3 backend")
+	}
+	check(c.Storage.MaxFileSize > 0, "storage.max_file_size", "must be positive, got %d", c.Storage.MaxFileSize)
+	for _, t := range c.Storage.AllowedTypeList() {
+		kind, sub, ok := strings.Cut(t, "/")
+		check(ok && kind != "" && kind != "*" && sub != "", "storage.allowed_types", "%q is not a media type or type/* mask", t)
+	}
+	check(c.Storage.CleanupInterval > 0, "storage.cleanup_interval", "must be positive, got %s", c.Storage.CleanupInterval)
+
+	if c.Reminders.Enabled {
+		check(c.Reminders.Interval > 0, "reminders.interval", "must be positive, got %s", c.Reminders.Interval)
+		notifiers := c.Reminders.NotifierList()
+		check(len(notifiers) > 0, "reminders.notifiers", "at least one notifier is required")
+		for _, n := range notifiers {
+			check(oneOf(n, "log", "webhook", "smtp"), "reminders.notifiers", "must be log, webhook or smtp, got %q", n)
+		}
+		if slices.Contains(notifiers, "webhook") {
+			u, err := url.Parse(c.Reminders.WebhookURL)
+			check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", "reminders.webhook_url",
+				"must be an http:// or https:// URL for the webhook notifier")
+		}
+		if slices.Contains(notifiers, "smtp") {
+			check(c.Reminders.SMTPHost != "", "reminders.smtp_host", "is required for the smtp notifier")
+			check(c.Reminders.SMTPPort > 0 && c.Reminders.SMTPPort <= 65535, "reminders.smtp_port", "must be between 1 and 65535, got %d", c.Reminders.SMTPPort)
+			check(c.Reminders.SMTPFrom != "", "reminders.smtp_from", "is required for the smtp notifier")
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func oneOf(value string, allowed ...string) bool {
+	return slices.Contains(allowed, value)
+}
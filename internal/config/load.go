@@ -0,0 +1,292 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// FileEnv переменная окружения с путем к файлу конфигурации (альтернатива флагу --config)
+const FileEnv = "CONFIG_FILE"
+
+// secretFileSuffix суффикс параметров, значение которых читается из файла (DB_PASSWORD_FILE, database.password_file)
+const secretFileSuffix = "_file"
+
+// field один параметр конфигурации
+type field struct {
+	key    string
+	env    string
+	secret bool
+	value  reflect.Value
+}
+
+// Load собирает конфигурацию из источников в порядке возрастания приоритета:
+// значения по умолчанию, файл (--config или CONFIG_FILE, .yaml/.yml/.toml), переменные окружения, флаги.
+// Ошибка flag.ErrHelp означает, что был запрошен --help и справка уже выведена в output.
+func Load(args []string, lookupEnv func(string) (string, bool), output io.Writer) (Config, error) {
+	cfg := Default()
+	fields := collectFields(&cfg)
+
+	flags, configFile, err := parseFlags(args, fields, output)
+	if err != nil {
+		return cfg, err
+	}
+
+	if configFile == "" {
+		configFile, _ = lookupEnv(FileEnv)
+	}
+	if configFile != "" {
+		if err := applyFile(configFile, fields); err != nil {
+			return cfg, err
+		}
+	}
+
+	if err := applyEnv(lookupEnv, fields); err != nil {
+		return cfg, err
+	}
+
+	for _, f := range fields {
+		if raw, ok := flags[f.key]; ok {
+			if err := setValue(f.value, raw); err != nil {
+				return cfg, fmt.Errorf("flag --%s: %w", f.key, err)
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// collectFields обходит Config и собирает параметры с полными ключами ("database.host")
+func collectFields(cfg *Config) []field {
+	var fields []field
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			key := prefix + sf.Tag.Get("key")
+			if sf.Type.Kind() == reflect.Struct && sf.Type != reflect.TypeOf(time.Duration(0)) {
+				walk(v.Field(i), key+".")
+				continue
+			}
+			fields = append(fields, field{
+				key:    key,
+				env:    sf.Tag.Get("env"),
+				secret: sf.Tag.Get("secret") == "true",
+				value:  v.Field(i),
+			})
+		}
+	}
+	walk(reflect.ValueOf(cfg).Elem(), "")
+	return fields
+}
+
+// parseFlags разбирает флаги, не применяя их: флаги должны перекрыть файл и окружение
+func parseFlags(args []string, fields []field, output io.Writer) (map[string]string, string, error) {
+	values := make(map[string]string)
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.SetOutput(output)
+
+	configFile := fs.String("config", "", "path to a YAML or TOML config file (env "+FileEnv+")")
+	for _, f := range fields {
+		usage := fmt.Sprintf("env %s (default %s)", f.env, formatValue(f.value))
+		if f.value.Kind() == reflect.Bool {
+			fs.BoolFunc(f.key, usage, func(raw string) error {
+				values[f.key] = raw
+				return nil
+			})
+			continue
+		}
+		fs.Func(f.key, usage, func(raw string) error {
+			values[f.key] = raw
+			return nil
+		})
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return nil, "", err
+	}
+	if fs.NArg() > 0 {
+		return nil, "", fmt.Errorf("unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	return values, *configFile, nil
+}
+
+// applyFile применяет параметры из файла; неизвестные ключи считаются ошибкой, чтобы опечатки не терялись молча
+func applyFile(path string, fields []field) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("config file: %w", err)
+	}
+
+	raw := make(map[string]any)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return fmt.Errorf("config file %s: unsupported format %q (expected .yaml, .yml or .toml)", path, ext)
+	}
+	if err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flatten(raw, "", values); err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+
+	byKey := make(map[string]field, len(fields))
+	for _, f := range fields {
+		byKey[f.key] = f
+	}
+
+	var errs []error
+	for _, key := range sortedKeys(values) {
+		value := values[key]
+		f, ok := byKey[key]
+		if !ok {
+			base, isFile := strings.CutSuffix(key, secretFileSuffix)
+			if f, ok = byKey[base]; !ok || !isFile || !f.secret {
+				errs = append(errs, fmt.Errorf("unknown key %q", key))
+				continue
+			}
+			if value, err = readSecret(value); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", key, err))
+				continue
+			}
+		}
+		if err := setValue(f.value, value); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// flatten превращает вложенные секции в ключи через точку
+func flatten(raw map[string]any, prefix string, out map[string]string) error {
+	for key, value := range raw {
+		switch v := value.(type) {
+		case map[string]any:
+			if err := flatten(v, prefix+key+".", out); err != nil {
+				return err
+			}
+		case []any:
+			return fmt.Errorf("%s%s: lists are not supported", prefix, key)
+		case nil:
+		default:
+			out[prefix+key] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
+
+// applyEnv применяет переменные окружения; для секретов поддерживается вариант *_FILE
+func applyEnv(lookupEnv func(string) (string, bool), fields []field) error {
+	var errs []error
+	for _, f := range fields {
+		value, ok := lookupEnv(f.env)
+		ok = ok && value != ""
+
+		if f.secret {
+			fileEnv := f.env + strings.ToUpper(secretFileSuffix)
+			if path, fileOK := lookupEnv(fileEnv); fileOK && path != "" {
+				if ok {
+					errs = append(errs, fmt.Errorf("env %s and %s are both set, use only one", f.env, fileEnv))
+					continue
+				}
+				secret, err := readSecret(path)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("env %s: %w", fileEnv, err))
+					continue
+				}
+				value, ok = secret, true
+			}
+		}
+
+		if !ok {
+			continue
+		}
+		if err := setValue(f.value, value); err != nil {
+			errs = append(errs, fmt.Errorf("env %s: %w", f.env, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// readSecret читает секрет из файла (Docker/Kubernetes secrets), отбрасывая завершающий перевод строки
+func readSecret(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func setValue(v reflect.Value, raw string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q (expected e.g. 500ms, 15s, 1m)", raw)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Int:
+		n, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		v.SetInt(int64(n))
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number %q", raw)
+		}
+		v.SetFloat(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q (expected true or false)", raw)
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// formatValue значение параметра в том же виде, в каком оно задается в файле и окружении
+func formatValue(v reflect.Value) string {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		return time.Duration(v.Int()).String()
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
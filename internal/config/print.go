@@ -0,0 +1,63 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted подстановка вместо значений секретов
+const redacted = "[REDACTED]"
+
+// Print выводит действующую конфигурацию в YAML (в формате файла конфигурации), скрывая секреты
+func Print(w io.Writer, cfg Config) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	sections := make(map[string]*yaml.Node)
+
+	for _, f := range collectFields(&cfg) {
+		sectionKey, key, _ := strings.Cut(f.key, ".")
+		section, ok := sections[sectionKey]
+		if !ok {
+			section = &yaml.Node{Kind: yaml.MappingNode}
+			sections[sectionKey] = section
+			root.Content = append(root.Content, scalar(sectionKey, "!!str"), section)
+		}
+
+		// Строки помечаются явно, чтобы пустые и похожие на числа значения выводились в кавычках
+		value, tag := formatValue(f.value), ""
+		if f.value.Kind() == reflect.String {
+			tag = "!!str"
+		}
+		if f.secret && value != "" {
+			value = redact(value)
+		}
+
+		node := scalar(value, tag)
+		node.LineComment = "env " + f.env
+		section.Content = append(section.Content, scalar(key, "!!str"), node)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// redact скрывает секрет; у строки подключения скрывается только пароль, чтобы был виден адрес БД
+func redact(value string) string {
+	if u, err := url.Parse(value); err == nil && u.Host != "" && u.User != nil && !u.Query().Has("password") {
+		if _, hasPassword := u.User.Password(); hasPassword {
+			return u.Redacted()
+		}
+	}
+	return redacted
+}
+
+func scalar(value, tag string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+}
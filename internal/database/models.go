@@ -10,11 +10,218 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID          int32              `json:"id"`
+	UserID      int32              `json:"user_id"`
+	Name        string             `json:"name"`
+	Prefix      string             `json:"prefix"`
+	KeyHash     []byte             `json:"key_hash"`
+	Scopes      []string           `json:"scopes"`
+	ExpiresAt   pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt   pgtype.Timestamptz `json:"revoked_at"`
+	LastUsedAt  pgtype.Timestamptz `json:"last_used_at"`
+	LastUsedIp  pgtype.Text        `json:"last_used_ip"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type BlobDeletion struct {
+	StorageKey  string             `json:"storage_key"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type Milestone struct {
+	ID          int32              `json:"id"`
+	ProjectID   int32              `json:"project_id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	StartDate   pgtype.Date        `json:"start_date"`
+	EndDate     pgtype.Date        `json:"end_date"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type Project struct {
+	ID          int32              `json:"id"`
+	Name        string             `json:"name"`
+	CreatedBy   int32              `json:"created_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type ProjectCustomField struct {
+	ID          int32              `json:"id"`
+	ProjectID   int32              `json:"project_id"`
+	Key         string             `json:"key"`
+	Name        string             `json:"name"`
+	Type        string             `json:"type"`
+	Options     []string           `json:"options"`
+	Required    bool               `json:"required"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type ProjectInvitation struct {
+	ID          int32              `json:"id"`
+	ProjectID   int32              `json:"project_id"`
+	UserID      int32              `json:"user_id"`
+	Role        string             `json:"role"`
+	InvitedBy   int32              `json:"invited_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type ProjectMember struct {
+	ProjectID   int32              `json:"project_id"`
+	UserID      int32              `json:"user_id"`
+	Role        string             `json:"role"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type RateLimitBucket struct {
+	Key       string             `json:"key"`
+	Tokens    float64            `json:"tokens"`
+	Allowed   bool               `json:"allowed"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type SchemaMigration struct {
+	Version   int32              `json:"version"`
+	AppliedAt pgtype.Timestamptz `json:"applied_at"`
+}
+
 type Task struct {
-	ID          int32       `json:"id"`
-	Name        string      `json:"name"`
-	Description pgtype.Text `json:"description"`
-	Completed   pgtype.Bool `json:"completed"`
-	CreatedAt   time.Time   `json:"created_at"`
-	UpdatedAt   time.Time   `json:"updated_at"`
+	ID              int32              `json:"id"`
+	Name            string             `json:"name"`
+	Description     pgtype.Text        `json:"description"`
+	Completed       pgtype.Bool        `json:"completed"`
+	CreatedAt       time.Time          `json:"created_at"`
+	UpdatedAt       time.Time          `json:"updated_at"`
+	OwnerID         pgtype.Int4        `json:"owner_id"`
+	ProjectID       pgtype.Int4        `json:"project_id"`
+	WorkspaceID     int32              `json:"workspace_id"`
+	Position        float64            `json:"position"`
+	CustomFields    []byte             `json:"custom_fields"`
+	EstimateMinutes pgtype.Int4        `json:"estimate_minutes"`
+	DueAt           pgtype.Timestamptz `json:"due_at"`
+	ParentID        pgtype.Int4        `json:"parent_id"`
+	Tags            []string           `json:"tags"`
+	MilestoneID     pgtype.Int4        `json:"milestone_id"`
+	CompletedAt     pgtype.Timestamptz `json:"completed_at"`
+}
+
+type TaskAssignee struct {
+	TaskID      int32              `json:"task_id"`
+	UserID      int32              `json:"user_id"`
+	AssignedBy  pgtype.Int4        `json:"assigned_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskAttachment struct {
+	ID          int32              `json:"id"`
+	TaskID      int32              `json:"task_id"`
+	Filename    string             `json:"filename"`
+	ContentType string             `json:"content_type"`
+	Size        int64              `json:"size"`
+	Checksum    string             `json:"checksum"`
+	StorageKey  string             `json:"storage_key"`
+	UploadedBy  pgtype.Int4        `json:"uploaded_by"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskChecklistItem struct {
+	ID          int32              `json:"id"`
+	TaskID      int32              `json:"task_id"`
+	Text        string             `json:"text"`
+	Checked     bool               `json:"checked"`
+	Position    int32              `json:"position"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskComment struct {
+	ID          int32              `json:"id"`
+	TaskID      int32              `json:"task_id"`
+	AuthorID    int32              `json:"author_id"`
+	Body        string             `json:"body"`
+	Mentions    []int32            `json:"mentions"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	EditedAt    pgtype.Timestamptz `json:"edited_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskCommentRevision struct {
+	ID          int32              `json:"id"`
+	CommentID   int32              `json:"comment_id"`
+	Body        string             `json:"body"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskDependency struct {
+	TaskID      int32              `json:"task_id"`
+	BlockerID   int32              `json:"blocker_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskEvent struct {
+	ID          int32              `json:"id"`
+	TaskID      int32              `json:"task_id"`
+	ActorID     pgtype.Int4        `json:"actor_id"`
+	Type        string             `json:"type"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+	SubjectID   pgtype.Int4        `json:"subject_id"`
+}
+
+type TaskReminder struct {
+	ID                int32              `json:"id"`
+	TaskID            int32              `json:"task_id"`
+	UserID            int32              `json:"user_id"`
+	RemindAt          pgtype.Timestamptz `json:"remind_at"`
+	OffsetMinutes     pgtype.Int4        `json:"offset_minutes"`
+	Email             string             `json:"email"`
+	SentAt            pgtype.Timestamptz `json:"sent_at"`
+	SentDueAt         pgtype.Timestamptz `json:"sent_due_at"`
+	ClaimedUntil      pgtype.Timestamptz `json:"claimed_until"`
+	Attempts          int32              `json:"attempts"`
+	LastError         string             `json:"last_error"`
+	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID       int32              `json:"workspace_id"`
+	DeliveredChannels []string           `json:"delivered_channels"`
+}
+
+type TaskTemplate struct {
+	ID          int32              `json:"id"`
+	ProjectID   pgtype.Int4        `json:"project_id"`
+	OwnerID     int32              `json:"owner_id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Tasks       []byte             `json:"tasks"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type TaskTimeEntry struct {
+	ID          int32              `json:"id"`
+	TaskID      int32              `json:"task_id"`
+	UserID      int32              `json:"user_id"`
+	StartedAt   pgtype.Timestamptz `json:"started_at"`
+	EndedAt     pgtype.Timestamptz `json:"ended_at"`
+	Note        string             `json:"note"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	WorkspaceID int32              `json:"workspace_id"`
+}
+
+type Workspace struct {
+	ID        int32              `json:"id"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
@@ -11,16 +11,131 @@ import (
 )
 
 type Querier interface {
+	AcceptProjectInvitation(ctx context.Context, arg AcceptProjectInvitationParams) (*ProjectMember, error)
+	AddTaskAssignees(ctx context.Context, arg AddTaskAssigneesParams) ([]int32, error)
+	AddTaskDependency(ctx context.Context, arg AddTaskDependencyParams) (*TaskDependency, error)
+	ClaimDueReminders(ctx context.Context, arg ClaimDueRemindersParams) ([]*ClaimDueRemindersRow, error)
 	CompleteTask(ctx context.Context, id int32) (*Task, error)
+	CompletionStreaks(ctx context.Context, arg CompletionStreaksParams) (*CompletionStreaksRow, error)
+	ConvertChecklistItem(ctx context.Context, arg ConvertChecklistItemParams) (*Task, error)
+	CountAssignedTasks(ctx context.Context, arg CountAssignedTasksParams) (int64, error)
+	CountChecklistProgress(ctx context.Context, taskIds []int32) ([]*CountChecklistProgressRow, error)
+	CountMilestoneProgress(ctx context.Context, milestoneIds []int32) ([]*CountMilestoneProgressRow, error)
+	CountProjectOwners(ctx context.Context, projectID int32) (int64, error)
+	CountTaskActivity(ctx context.Context, taskID int32) (int64, error)
+	CountTaskComments(ctx context.Context, taskID int32) (int64, error)
 	CountTasks(ctx context.Context) (int64, error)
+	CountTasksByOwner(ctx context.Context, ownerID pgtype.Int4) (int64, error)
 	CountTasksByStatus(ctx context.Context, completed pgtype.Bool) (int64, error)
+	CountTasksWithFieldValue(ctx context.Context, arg CountTasksWithFieldValueParams) (int64, error)
+	CountUserReminders(ctx context.Context, arg CountUserRemindersParams) (int64, error)
+	CountVisibleTasks(ctx context.Context, arg CountVisibleTasksParams) (int64, error)
+	CountVisibleTasksByStatus(ctx context.Context, arg CountVisibleTasksByStatusParams) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (*ApiKey, error)
+	CreateChecklistItem(ctx context.Context, arg CreateChecklistItemParams) (*TaskChecklistItem, error)
+	CreateCustomField(ctx context.Context, arg CreateCustomFieldParams) (*ProjectCustomField, error)
+	CreateMilestone(ctx context.Context, arg CreateMilestoneParams) (*Milestone, error)
+	CreateProject(ctx context.Context, arg CreateProjectParams) (*Project, error)
+	CreateProjectInvitation(ctx context.Context, arg CreateProjectInvitationParams) (*ProjectInvitation, error)
+	CreateReminder(ctx context.Context, arg CreateReminderParams) (*TaskReminder, error)
 	CreateTask(ctx context.Context, arg CreateTaskParams) (*Task, error)
-	DeleteTask(ctx context.Context, id int32) error
+	CreateTaskAttachment(ctx context.Context, arg CreateTaskAttachmentParams) (*TaskAttachment, error)
+	CreateTaskComment(ctx context.Context, arg CreateTaskCommentParams) (*TaskComment, error)
+	CreateTaskEvent(ctx context.Context, arg CreateTaskEventParams) error
+	CreateTaskSubjectEvent(ctx context.Context, arg CreateTaskSubjectEventParams) error
+	CreateTemplate(ctx context.Context, arg CreateTemplateParams) (*TaskTemplate, error)
+	CreateTemplateTasks(ctx context.Context, arg CreateTemplateTasksParams) ([]*Task, error)
+	CreateTimeEntry(ctx context.Context, arg CreateTimeEntryParams) (*TaskTimeEntry, error)
+	DeleteBlobDeletion(ctx context.Context, storageKey string) error
+	DeleteChecklistItem(ctx context.Context, id int32) (int64, error)
+	DeleteCustomField(ctx context.Context, id int32) error
+	DeleteIdleRateLimitBuckets(ctx context.Context, idleSince pgtype.Timestamptz) (int64, error)
+	DeleteMilestone(ctx context.Context, id int32) (int64, error)
+	DeleteProject(ctx context.Context, id int32) (int64, error)
+	DeleteProjectInvitation(ctx context.Context, id int32) (int64, error)
+	DeleteProjectMember(ctx context.Context, arg DeleteProjectMemberParams) (int64, error)
+	DeleteReminder(ctx context.Context, id int32) (int64, error)
+	DeleteTask(ctx context.Context, id int32) (int64, error)
+	DeleteTaskAttachment(ctx context.Context, id int32) (int64, error)
+	DeleteTaskComment(ctx context.Context, id int32) (int64, error)
+	DeleteTaskDependency(ctx context.Context, arg DeleteTaskDependencyParams) (int64, error)
+	DeleteTemplate(ctx context.Context, id int32) (int64, error)
+	DeleteTimeEntry(ctx context.Context, id int32) (int64, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash []byte) (*ApiKey, error)
+	GetChecklistItem(ctx context.Context, id int32) (*TaskChecklistItem, error)
+	GetCustomField(ctx context.Context, id int32) (*ProjectCustomField, error)
+	GetMilestone(ctx context.Context, id int32) (*Milestone, error)
+	GetNextTaskPosition(ctx context.Context, arg GetNextTaskPositionParams) (float64, error)
+	GetPreviousTaskPosition(ctx context.Context, arg GetPreviousTaskPositionParams) (float64, error)
+	GetProject(ctx context.Context, id int32) (*Project, error)
+	GetProjectInvitation(ctx context.Context, id int32) (*ProjectInvitation, error)
+	GetProjectMemberRole(ctx context.Context, arg GetProjectMemberRoleParams) (string, error)
+	GetReminder(ctx context.Context, id int32) (*TaskReminder, error)
+	GetRunningTimeEntry(ctx context.Context, userID int32) (*TaskTimeEntry, error)
+	GetSchemaVersion(ctx context.Context) (int32, error)
 	GetTask(ctx context.Context, id int32) (*Task, error)
+	GetTaskAttachment(ctx context.Context, id int32) (*TaskAttachment, error)
+	GetTaskComment(ctx context.Context, id int32) (*TaskComment, error)
+	GetTaskTimeTotals(ctx context.Context, taskID int32) (*GetTaskTimeTotalsRow, error)
+	GetTemplate(ctx context.Context, id int32) (*TaskTemplate, error)
+	GetTimeEntry(ctx context.Context, id int32) (*TaskTimeEntry, error)
+	ListAPIKeysByUser(ctx context.Context, userID int32) ([]*ApiKey, error)
+	ListAssignedTasks(ctx context.Context, arg ListAssignedTasksParams) ([]*Task, error)
+	ListBlobDeletions(ctx context.Context, limit int32) ([]string, error)
+	ListBlockedTaskIDs(ctx context.Context, taskIds []int32) ([]int32, error)
+	ListChecklistItems(ctx context.Context, taskID int32) ([]*TaskChecklistItem, error)
+	ListCustomFields(ctx context.Context, projectID int32) ([]*ProjectCustomField, error)
+	ListInvitationsByUser(ctx context.Context, userID int32) ([]*ProjectInvitation, error)
+	ListMilestoneTasks(ctx context.Context, arg ListMilestoneTasksParams) ([]*Task, error)
+	ListMilestones(ctx context.Context, projectID int32) ([]*Milestone, error)
+	ListNonMembers(ctx context.Context, arg ListNonMembersParams) ([]int32, error)
+	ListOpenBlockerIDs(ctx context.Context, taskID int32) ([]int32, error)
+	ListProjectDependencies(ctx context.Context, projectID pgtype.Int4) ([]*TaskDependency, error)
+	ListProjectInvitations(ctx context.Context, projectID int32) ([]*ProjectInvitation, error)
+	ListProjectMembers(ctx context.Context, projectID int32) ([]*ProjectMember, error)
+	ListProjectTaskTime(ctx context.Context, projectID pgtype.Int4) ([]*ListProjectTaskTimeRow, error)
+	ListProjectTasks(ctx context.Context, projectID pgtype.Int4) ([]*Task, error)
+	ListProjectsByMember(ctx context.Context, userID int32) ([]*ListProjectsByMemberRow, error)
+	ListReminders(ctx context.Context, arg ListRemindersParams) ([]*ListRemindersRow, error)
+	ListTaskActivity(ctx context.Context, arg ListTaskActivityParams) ([]*ListTaskActivityRow, error)
+	ListTaskAssignees(ctx context.Context, taskIds []int32) ([]*TaskAssignee, error)
+	ListTaskAttachments(ctx context.Context, taskID int32) ([]*TaskAttachment, error)
+	ListTaskBlockers(ctx context.Context, taskID int32) ([]*Task, error)
+	ListTaskCommentRevisions(ctx context.Context, commentID int32) ([]*TaskCommentRevision, error)
+	ListTaskComments(ctx context.Context, arg ListTaskCommentsParams) ([]*TaskComment, error)
+	ListTaskDependents(ctx context.Context, blockerID int32) ([]*Task, error)
 	ListTasks(ctx context.Context, arg ListTasksParams) ([]*Task, error)
 	ListTasksByStatus(ctx context.Context, arg ListTasksByStatusParams) ([]*Task, error)
+	ListTemplates(ctx context.Context, arg ListTemplatesParams) ([]*TaskTemplate, error)
+	ListTimeEntries(ctx context.Context, taskID int32) ([]*TaskTimeEntry, error)
+	LockTaskQuota(ctx context.Context, ownerID int32) error
+	MarkReminderFailed(ctx context.Context, arg MarkReminderFailedParams) error
+	MarkReminderSent(ctx context.Context, arg MarkReminderSentParams) error
+	MilestoneBurndown(ctx context.Context, arg MilestoneBurndownParams) ([]*MilestoneBurndownRow, error)
+	PeekRateLimitTokens(ctx context.Context, arg PeekRateLimitTokensParams) (float64, error)
+	RebalanceTaskList(ctx context.Context, id int32) (int64, error)
+	RemoveOtherTaskAssignees(ctx context.Context, arg RemoveOtherTaskAssigneesParams) ([]int32, error)
+	ReorderChecklistItems(ctx context.Context, arg ReorderChecklistItemsParams) (int64, error)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error)
+	RotateAPIKey(ctx context.Context, arg RotateAPIKeyParams) (*ApiKey, error)
+	SetTaskPosition(ctx context.Context, arg SetTaskPositionParams) (*Task, error)
+	StartTimer(ctx context.Context, arg StartTimerParams) (*TaskTimeEntry, error)
+	StopTimer(ctx context.Context, arg StopTimerParams) (*TaskTimeEntry, error)
+	TakeRateLimitToken(ctx context.Context, arg TakeRateLimitTokenParams) (*TakeRateLimitTokenRow, error)
+	TaskStats(ctx context.Context, arg TaskStatsParams) (*TaskStatsRow, error)
+	TaskStatsByProject(ctx context.Context, arg TaskStatsByProjectParams) ([]*TaskStatsByProjectRow, error)
+	TaskStatsByTag(ctx context.Context, arg TaskStatsByTagParams) ([]*TaskStatsByTagRow, error)
+	TaskStatsSeries(ctx context.Context, arg TaskStatsSeriesParams) ([]*TaskStatsSeriesRow, error)
+	Timesheet(ctx context.Context, arg TimesheetParams) ([]*TimesheetRow, error)
+	TouchAPIKey(ctx context.Context, arg TouchAPIKeyParams) error
 	UncompleteTask(ctx context.Context, id int32) (*Task, error)
+	UpdateChecklistItem(ctx context.Context, arg UpdateChecklistItemParams) (*TaskChecklistItem, error)
+	UpdateCustomField(ctx context.Context, arg UpdateCustomFieldParams) (*ProjectCustomField, error)
+	UpdateMilestone(ctx context.Context, arg UpdateMilestoneParams) (*Milestone, error)
+	UpdateProjectMemberRole(ctx context.Context, arg UpdateProjectMemberRoleParams) (*ProjectMember, error)
 	UpdateTask(ctx context.Context, arg UpdateTaskParams) (*Task, error)
+	UpdateTaskComment(ctx context.Context, arg UpdateTaskCommentParams) (*TaskComment, error)
+	UpdateTemplate(ctx context.Context, arg UpdateTemplateParams) (*TaskTemplate, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -13,9 +13,9 @@ import (
 
 const CompleteTask = `-- name: CompleteTask :one
 UPDATE tasks 
-SET completed = true
+SET completed = true, completed_at = COALESCE(completed_at, now())
 WHERE id = $1
-RETURNING id, name, description, completed, created_at, updated_at
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 `
 
 func (q *Queries) CompleteTask(ctx context.Context, id int32) (*Task, error) {
@@ -28,6 +28,17 @@ func (q *Queries) CompleteTask(ctx context.Context, id int32) (*Task, error) {
 		&i.Completed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Position,
+		&i.CustomFields,
+		&i.EstimateMinutes,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Tags,
+		&i.MilestoneID,
+		&i.CompletedAt,
 	)
 	return &i, err
 }
@@ -43,6 +54,17 @@ func (q *Queries) CountTasks(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const CountTasksByOwner = `-- name: CountTasksByOwner :one
+SELECT COUNT(*) FROM tasks WHERE owner_id = $1
+`
+
+func (q *Queries) CountTasksByOwner(ctx context.Context, ownerID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, CountTasksByOwner, ownerID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const CountTasksByStatus = `-- name: CountTasksByStatus :one
 SELECT COUNT(*) FROM tasks WHERE completed = $1
 `
@@ -54,20 +76,76 @@ func (q *Queries) CountTasksByStatus(ctx context.Context, completed pgtype.Bool)
 	return count, err
 }
 
+const CountVisibleTasks = `-- name: CountVisibleTasks :one
+SELECT COUNT(*) FROM tasks
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = $1))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = $1))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest($2::TEXT[], $3::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+`
+
+type CountVisibleTasksParams struct {
+	UserID      pgtype.Int4 `json:"user_id"`
+	FieldKeys   []string    `json:"field_keys"`
+	FieldValues []string    `json:"field_values"`
+}
+
+func (q *Queries) CountVisibleTasks(ctx context.Context, arg CountVisibleTasksParams) (int64, error) {
+	row := q.db.QueryRow(ctx, CountVisibleTasks, arg.UserID, arg.FieldKeys, arg.FieldValues)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const CountVisibleTasksByStatus = `-- name: CountVisibleTasksByStatus :one
+SELECT COUNT(*) FROM tasks
+WHERE completed = $1
+  AND ((project_id IS NULL AND (owner_id IS NULL OR owner_id = $2))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = $2))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest($3::TEXT[], $4::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+`
+
+type CountVisibleTasksByStatusParams struct {
+	Completed   pgtype.Bool `json:"completed"`
+	UserID      pgtype.Int4 `json:"user_id"`
+	FieldKeys   []string    `json:"field_keys"`
+	FieldValues []string    `json:"field_values"`
+}
+
+func (q *Queries) CountVisibleTasksByStatus(ctx context.Context, arg CountVisibleTasksByStatusParams) (int64, error) {
+	row := q.db.QueryRow(ctx, CountVisibleTasksByStatus, arg.Completed, arg.UserID, arg.FieldKeys, arg.FieldValues)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const CreateTask = `-- name: CreateTask :one
-INSERT INTO tasks (name, description, completed)
-VALUES ($1, $2, $3)
-RETURNING id, name, description, completed, created_at, updated_at
+INSERT INTO tasks (name, description, completed, owner_id, project_id, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 `
 
 type CreateTaskParams struct {
-	Name        string      `json:"name"`
-	Description pgtype.Text `json:"description"`
-	Completed   pgtype.Bool `json:"completed"`
+	Name            string             `json:"name"`
+	Description     pgtype.Text        `json:"description"`
+	Completed       pgtype.Bool        `json:"completed"`
+	OwnerID         pgtype.Int4        `json:"owner_id"`
+	ProjectID       pgtype.Int4        `json:"project_id"`
+	CustomFields    []byte             `json:"custom_fields"`
+	EstimateMinutes pgtype.Int4        `json:"estimate_minutes"`
+	DueAt           pgtype.Timestamptz `json:"due_at"`
+	ParentID        pgtype.Int4        `json:"parent_id"`
+	Tags            []string           `json:"tags"`
+	MilestoneID     pgtype.Int4        `json:"milestone_id"`
 }
 
 func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (*Task, error) {
-	row := q.db.QueryRow(ctx, CreateTask, arg.Name, arg.Description, arg.Completed)
+	row := q.db.QueryRow(ctx, CreateTask, arg.Name, arg.Description, arg.Completed, arg.OwnerID, arg.ProjectID, arg.CustomFields, arg.EstimateMinutes, arg.DueAt, arg.ParentID, arg.Tags, arg.MilestoneID)
 	var i Task
 	err := row.Scan(
 		&i.ID,
@@ -76,22 +154,87 @@ func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (*Task,
 		&i.Completed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Position,
+		&i.CustomFields,
+		&i.EstimateMinutes,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Tags,
+		&i.MilestoneID,
+		&i.CompletedAt,
 	)
 	return &i, err
 }
 
-const DeleteTask = `-- name: DeleteTask :exec
+const DeleteTask = `-- name: DeleteTask :execrows
 DELETE FROM tasks 
 WHERE id = $1
 `
 
-func (q *Queries) DeleteTask(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, DeleteTask, id)
-	return err
+func (q *Queries) DeleteTask(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, DeleteTask, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const GetNextTaskPosition = `-- name: GetNextTaskPosition :one
+SELECT t.position
+FROM tasks t, tasks anchor
+WHERE anchor.id = $1
+  AND t.id <> $2
+  AND t.project_id IS NOT DISTINCT FROM anchor.project_id
+  AND (anchor.project_id IS NOT NULL OR t.owner_id IS NOT DISTINCT FROM anchor.owner_id)
+  AND (t.position, t.id) > (anchor.position, anchor.id)
+ORDER BY t.position, t.id
+LIMIT 1
+`
+
+type GetNextTaskPositionParams struct {
+	ID        int32 `json:"id"`
+	ExcludeID int32 `json:"exclude_id"`
+}
+
+// Ранг задачи, стоящей в списке непосредственно после задачи @id (сама @exclude_id не учитывается)
+func (q *Queries) GetNextTaskPosition(ctx context.Context, arg GetNextTaskPositionParams) (float64, error) {
+	row := q.db.QueryRow(ctx, GetNextTaskPosition, arg.ID, arg.ExcludeID)
+	var position float64
+	err := row.Scan(&position)
+	return position, err
+}
+
+const GetPreviousTaskPosition = `-- name: GetPreviousTaskPosition :one
+SELECT t.position
+FROM tasks t, tasks anchor
+WHERE anchor.id = $1
+  AND t.id <> $2
+  AND t.project_id IS NOT DISTINCT FROM anchor.project_id
+  AND (anchor.project_id IS NOT NULL OR t.owner_id IS NOT DISTINCT FROM anchor.owner_id)
+  AND (t.position, t.id) < (anchor.position, anchor.id)
+ORDER BY t.position DESC, t.id DESC
+LIMIT 1
+`
+
+type GetPreviousTaskPositionParams struct {
+	ID        int32 `json:"id"`
+	ExcludeID int32 `json:"exclude_id"`
+}
+
+// Ранг задачи, стоящей в списке непосредственно перед задачей @id (сама @exclude_id не учитывается).
+// Список задан проектом, а вне проектов - владельцем
+func (q *Queries) GetPreviousTaskPosition(ctx context.Context, arg GetPreviousTaskPositionParams) (float64, error) {
+	row := q.db.QueryRow(ctx, GetPreviousTaskPosition, arg.ID, arg.ExcludeID)
+	var position float64
+	err := row.Scan(&position)
+	return position, err
 }
 
 const GetTask = `-- name: GetTask :one
-SELECT id, name, description, completed, created_at, updated_at 
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 FROM tasks 
 WHERE id = $1
 `
@@ -106,24 +249,53 @@ func (q *Queries) GetTask(ctx context.Context, id int32) (*Task, error) {
 		&i.Completed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Position,
+		&i.CustomFields,
+		&i.EstimateMinutes,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Tags,
+		&i.MilestoneID,
+		&i.CompletedAt,
 	)
 	return &i, err
 }
 
 const ListTasks = `-- name: ListTasks :many
-SELECT id, name, description, completed, created_at, updated_at 
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 FROM tasks 
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = $1))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = $1))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest($2::TEXT[], $3::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+ORDER BY CASE WHEN $4::TEXT = 'position' THEN position END,
+         CASE WHEN $4::TEXT = 'position' THEN id END,
+         CASE WHEN $4::TEXT = 'field' THEN custom_fields -> $5::TEXT END,
+         CASE WHEN $4::TEXT = '-field' THEN custom_fields -> $5::TEXT END DESC NULLS LAST,
+         created_at DESC
+LIMIT $6 OFFSET $7
 `
 
 type ListTasksParams struct {
-	Limit  int32 `json:"limit"`
-	Offset int32 `json:"offset"`
+	UserID      pgtype.Int4 `json:"user_id"`
+	FieldKeys   []string    `json:"field_keys"`
+	FieldValues []string    `json:"field_values"`
+	Sort        string      `json:"sort"`
+	SortField   string      `json:"sort_field"`
+	Limit       int32       `json:"limit"`
+	Offset      int32       `json:"offset"`
 }
 
+// Задачи, видимые пользователю: его собственные, общие задачи без владельца и задачи его проектов.
+// Пользовательские поля @field_keys должны иметь значения @field_values (для multi_select - содержать их).
+// sort: position - ручной порядок, field / -field - по полю @sort_field, иначе новые первыми
 func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]*Task, error) {
-	rows, err := q.db.Query(ctx, ListTasks, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, ListTasks, arg.UserID, arg.FieldKeys, arg.FieldValues, arg.Sort, arg.SortField, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -138,6 +310,17 @@ func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]*Task,
 			&i.Completed,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.ProjectID,
+			&i.WorkspaceID,
+			&i.Position,
+			&i.CustomFields,
+			&i.EstimateMinutes,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Tags,
+			&i.MilestoneID,
+			&i.CompletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -150,21 +333,36 @@ func (q *Queries) ListTasks(ctx context.Context, arg ListTasksParams) ([]*Task,
 }
 
 const ListTasksByStatus = `-- name: ListTasksByStatus :many
-SELECT id, name, description, completed, created_at, updated_at 
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 FROM tasks 
 WHERE completed = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+  AND ((project_id IS NULL AND (owner_id IS NULL OR owner_id = $2))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = $2))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest($3::TEXT[], $4::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+ORDER BY CASE WHEN $5::TEXT = 'position' THEN position END,
+         CASE WHEN $5::TEXT = 'position' THEN id END,
+         CASE WHEN $5::TEXT = 'field' THEN custom_fields -> $6::TEXT END,
+         CASE WHEN $5::TEXT = '-field' THEN custom_fields -> $6::TEXT END DESC NULLS LAST,
+         created_at DESC
+LIMIT $7 OFFSET $8
 `
 
 type ListTasksByStatusParams struct {
-	Completed pgtype.Bool `json:"completed"`
-	Limit     int32       `json:"limit"`
-	Offset    int32       `json:"offset"`
+	Completed   pgtype.Bool `json:"completed"`
+	UserID      pgtype.Int4 `json:"user_id"`
+	FieldKeys   []string    `json:"field_keys"`
+	FieldValues []string    `json:"field_values"`
+	Sort        string      `json:"sort"`
+	SortField   string      `json:"sort_field"`
+	Limit       int32       `json:"limit"`
+	Offset      int32       `json:"offset"`
 }
 
 func (q *Queries) ListTasksByStatus(ctx context.Context, arg ListTasksByStatusParams) ([]*Task, error) {
-	rows, err := q.db.Query(ctx, ListTasksByStatus, arg.Completed, arg.Limit, arg.Offset)
+	rows, err := q.db.Query(ctx, ListTasksByStatus, arg.Completed, arg.UserID, arg.FieldKeys, arg.FieldValues, arg.Sort, arg.SortField, arg.Limit, arg.Offset)
 	if err != nil {
 		return nil, err
 	}
@@ -179,6 +377,17 @@ func (q *Queries) ListTasksByStatus(ctx context.Context, arg ListTasksByStatusPa
 			&i.Completed,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.OwnerID,
+			&i.ProjectID,
+			&i.WorkspaceID,
+			&i.Position,
+			&i.CustomFields,
+			&i.EstimateMinutes,
+			&i.DueAt,
+			&i.ParentID,
+			&i.Tags,
+			&i.MilestoneID,
+			&i.CompletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -190,11 +399,81 @@ func (q *Queries) ListTasksByStatus(ctx context.Context, arg ListTasksByStatusPa
 	return items, nil
 }
 
+const LockTaskQuota = `-- name: LockTaskQuota :exec
+SELECT pg_advisory_xact_lock(hashtext('task_quota'), $1::INTEGER)
+`
+
+// Блокировка квоты задач владельца до конца транзакции: подсчет его задач и вставка новых
+// в конкурирующих транзакциях выполняются по очереди
+func (q *Queries) LockTaskQuota(ctx context.Context, ownerID int32) error {
+	_, err := q.db.Exec(ctx, LockTaskQuota, ownerID)
+	return err
+}
+
+const RebalanceTaskList = `-- name: RebalanceTaskList :execrows
+UPDATE tasks
+SET position = ranked.n
+FROM (
+    SELECT t.id, row_number() OVER (ORDER BY t.position, t.id) AS n
+    FROM tasks t, tasks anchor
+    WHERE anchor.id = $1
+      AND t.project_id IS NOT DISTINCT FROM anchor.project_id
+      AND (anchor.project_id IS NOT NULL OR t.owner_id IS NOT DISTINCT FROM anchor.owner_id)
+) ranked
+WHERE tasks.id = ranked.id
+`
+
+// Перенумеровывает список, в котором стоит задача @id, рангами 1, 2, ... с сохранением порядка
+func (q *Queries) RebalanceTaskList(ctx context.Context, id int32) (int64, error) {
+	result, err := q.db.Exec(ctx, RebalanceTaskList, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const SetTaskPosition = `-- name: SetTaskPosition :one
+UPDATE tasks
+SET position = $2
+WHERE id = $1
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
+`
+
+type SetTaskPositionParams struct {
+	ID       int32   `json:"id"`
+	Position float64 `json:"position"`
+}
+
+func (q *Queries) SetTaskPosition(ctx context.Context, arg SetTaskPositionParams) (*Task, error) {
+	row := q.db.QueryRow(ctx, SetTaskPosition, arg.ID, arg.Position)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Completed,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Position,
+		&i.CustomFields,
+		&i.EstimateMinutes,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Tags,
+		&i.MilestoneID,
+		&i.CompletedAt,
+	)
+	return &i, err
+}
+
 const UncompleteTask = `-- name: UncompleteTask :one
 UPDATE tasks
-SET completed = false
+SET completed = false, completed_at = NULL
 WHERE id = $1
-RETURNING id, name, description, completed, created_at, updated_at
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 `
 
 func (q *Queries) UncompleteTask(ctx context.Context, id int32) (*Task, error) {
@@ -207,31 +486,44 @@ func (q *Queries) UncompleteTask(ctx context.Context, id int32) (*Task, error) {
 		&i.Completed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Position,
+		&i.CustomFields,
+		&i.EstimateMinutes,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Tags,
+		&i.MilestoneID,
+		&i.CompletedAt,
 	)
 	return &i, err
 }
 
 const UpdateTask = `-- name: UpdateTask :one
 UPDATE tasks 
-SET name = $2, description = $3, completed = $4
+SET name = $2, description = $3, completed = $4, custom_fields = $5, estimate_minutes = $6, due_at = $7, tags = $8,
+    milestone_id = $9, completed_at = CASE WHEN $4 THEN COALESCE(completed_at, now()) END
 WHERE id = $1
-RETURNING id, name, description, completed, created_at, updated_at
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 `
 
 type UpdateTaskParams struct {
-	ID          int32       `json:"id"`
-	Name        string      `json:"name"`
-	Description pgtype.Text `json:"description"`
-	Completed   pgtype.Bool `json:"completed"`
+	ID              int32              `json:"id"`
+	Name            string             `json:"name"`
+	Description     pgtype.Text        `json:"description"`
+	Completed       pgtype.Bool        `json:"completed"`
+	CustomFields    []byte             `json:"custom_fields"`
+	EstimateMinutes pgtype.Int4        `json:"estimate_minutes"`
+	DueAt           pgtype.Timestamptz `json:"due_at"`
+	Tags            []string           `json:"tags"`
+	MilestoneID     pgtype.Int4        `json:"milestone_id"`
 }
 
+// completed_at - момент выполнения: сохраняется, пока задача выполнена, и сбрасывается при возврате в работу
 func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (*Task, error) {
-	row := q.db.QueryRow(ctx, UpdateTask,
-		arg.ID,
-		arg.Name,
-		arg.Description,
-		arg.Completed,
-	)
+	row := q.db.QueryRow(ctx, UpdateTask, arg.ID, arg.Name, arg.Description, arg.Completed, arg.CustomFields, arg.EstimateMinutes, arg.DueAt, arg.Tags, arg.MilestoneID)
 	var i Task
 	err := row.Scan(
 		&i.ID,
@@ -240,6 +532,17 @@ func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) (*Task,
 		&i.Completed,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.OwnerID,
+		&i.ProjectID,
+		&i.WorkspaceID,
+		&i.Position,
+		&i.CustomFields,
+		&i.EstimateMinutes,
+		&i.DueAt,
+		&i.ParentID,
+		&i.Tags,
+		&i.MilestoneID,
+		&i.CompletedAt,
 	)
 	return &i, err
 }
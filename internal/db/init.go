@@ -1,11 +1,41 @@
 package db
 
 import (
+	"context"
+
 	"GreatProject/internal/database"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-func New(conn *pgx.Conn) *db.Queries {
-	return db.New(conn)
+// SchemaVersion версия схемы (последний файл sql/schema), с которой работает этот код.
+// Увеличивается вместе с каждой новой миграцией.
+const SchemaVersion = 19
+
+// Connect открывает пул соединений с БД и проверяет доступность сервера.
+// tracer (может быть nil) получает события всех SQL запросов пула.
+func Connect(ctx context.Context, databaseURL string, tracer pgx.QueryTracer) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(databaseURL)
+	if err != nil {
+		return nil, err
+	}
+	config.ConnConfig.Tracer = tracer
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// New запросы к БД в рабочем пространстве из контекста каждого вызова (см. tenantDB)
+func New(pool *pgxpool.Pool) *db.Queries {
+	return db.New(&tenantDB{pool: pool})
 }
@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"GreatProject/internal/database"
+	"GreatProject/internal/tenant"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// setTenant устанавливает настройки, по которым политики RLS (sql/schema/006_workspaces.sql)
+// отбирают строки; is_local = true - только до конца транзакции
+const setTenant = `SELECT set_config('app.workspace_id', $1, true), set_config('app.all_workspaces', $2, true)`
+
+type txKey struct{}
+
+// WithTenantTx выполняет fn в одной транзакции с рабочим пространством из ctx: либо все запросы fn,
+// либо ни одного. Запросы репозиториев с контекстом, переданным в fn, тоже идут в эту транзакцию.
+// Вложенный вызов присоединяется к внешней транзакции. Ошибка fn откатывает транзакцию и возвращается.
+func WithTenantTx(ctx context.Context, pool *pgxpool.Pool, fn func(ctx context.Context, q *db.Queries) error) error {
+	return WithTenantTxOptions(ctx, pool, pgx.TxOptions{}, fn)
+}
+
+// WithTenantTxOptions как WithTenantTx, но транзакция начинается с options (уровень изоляции,
+// только чтение). Вложенный вызов присоединяется к внешней транзакции с ее параметрами.
+func WithTenantTxOptions(ctx context.Context, pool *pgxpool.Pool, options pgx.TxOptions, fn func(ctx context.Context, q *db.Queries) error) error {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return fn(ctx, db.New(nil).WithTx(tx))
+	}
+
+	tx, err := pool.BeginTx(ctx, options)
+	if err != nil {
+		return err
+	}
+	if workspace, all, scoped := tenantSettings(ctx); scoped {
+		if _, err := tx.Exec(ctx, setTenant, workspace, all); err != nil {
+			_ = tx.Rollback(ctx)
+			return err
+		}
+	}
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx), db.New(nil).WithTx(tx)); err != nil {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil && !errors.Is(rollbackErr, pgx.ErrTxClosed) {
+			return errors.Join(err, rollbackErr)
+		}
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+// tenantSettings значения настроек RLS для контекста; scoped == false - пространство не задано
+func tenantSettings(ctx context.Context) (workspace, all string, scoped bool) {
+	id, ok := tenant.WorkspaceFrom(ctx)
+	if ok {
+		workspace = strconv.Itoa(int(id))
+	}
+	if tenant.AllWorkspaces(ctx) {
+		all = "on"
+	}
+	return workspace, all, ok || all != ""
+}
+
+// tenantDB выполняет запросы в рабочем пространстве из контекста. Внутри WithTenantTx запрос идет
+// в ее транзакцию. Отдельный запрос отправляется одним пакетом вместе с установкой пространства:
+// пакет выполняется как одна неявная транзакция, поэтому настройка действует только на этот запрос,
+// а лишних обращений к серверу (BEGIN, COMMIT) нет.
+// Запросы без пространства идут в пул напрямую: данные команд им не видны, а служебные таблицы доступны.
+type tenantDB struct {
+	pool *pgxpool.Pool
+}
+
+var _ db.DBTX = (*tenantDB)(nil)
+
+// batch пакет из установки пространства и запроса; nil - запрос выполняется без пакета
+func batch(ctx context.Context, sql string, args []any) *pgx.Batch {
+	workspace, all, scoped := tenantSettings(ctx)
+	if !scoped {
+		return nil
+	}
+	b := &pgx.Batch{}
+	b.Queue(setTenant, workspace, all)
+	b.Queue(sql, args...)
+	return b
+}
+
+func (t *tenantDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Exec(ctx, sql, args...)
+	}
+	b := batch(ctx, sql, args)
+	if b == nil {
+		return t.pool.Exec(ctx, sql, args...)
+	}
+
+	results := t.pool.SendBatch(ctx, b)
+	if _, err := results.Exec(); err != nil {
+		_ = results.Close()
+		return pgconn.CommandTag{}, err
+	}
+	tag, err := results.Exec()
+	if closeErr := results.Close(); err == nil {
+		err = closeErr
+	}
+	return tag, err
+}
+
+func (t *tenantDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Query(ctx, sql, args...)
+	}
+	b := batch(ctx, sql, args)
+	if b == nil {
+		return t.pool.Query(ctx, sql, args...)
+	}
+
+	results := t.pool.SendBatch(ctx, b)
+	if _, err := results.Exec(); err != nil {
+		_ = results.Close()
+		return nil, err
+	}
+	rows, err := results.Query()
+	if err != nil {
+		_ = results.Close()
+		return nil, err
+	}
+	return &batchRows{Rows: rows, results: results}, nil
+}
+
+func (t *tenantDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.QueryRow(ctx, sql, args...)
+	}
+	b := batch(ctx, sql, args)
+	if b == nil {
+		return t.pool.QueryRow(ctx, sql, args...)
+	}
+
+	results := t.pool.SendBatch(ctx, b)
+	if _, err := results.Exec(); err != nil {
+		_ = results.Close()
+		return errRow{err: err}
+	}
+	return &batchRow{row: results.QueryRow(), results: results}
+}
+
+// batchRows закрывает пакет, когда строки прочитаны или закрыты; ошибка завершения
+// неявной транзакции возвращается из Err
+type batchRows struct {
+	pgx.Rows
+	results pgx.BatchResults
+	done    bool
+	err     error
+}
+
+func (r *batchRows) Next() bool {
+	if r.Rows.Next() {
+		return true
+	}
+	r.finish()
+	return false
+}
+
+func (r *batchRows) Err() error {
+	if err := r.Rows.Err(); err != nil {
+		return err
+	}
+	return r.err
+}
+
+func (r *batchRows) Close() {
+	r.finish()
+}
+
+func (r *batchRows) finish() {
+	if r.done {
+		return
+	}
+	r.done = true
+	r.Rows.Close()
+	r.err = r.results.Close()
+}
+
+// batchRow закрывает пакет после чтения строки
+type batchRow struct {
+	row     pgx.Row
+	results pgx.BatchResults
+}
+
+func (r *batchRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	if closeErr := r.results.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+type errRow struct {
+	err error
+}
+
+func (r errRow) Scan(...any) error {
+	return r.err
+}
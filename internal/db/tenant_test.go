@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"GreatProject/internal/database"
+	"GreatProject/internal/dbtest"
+	"GreatProject/internal/tenant"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// newWorkspace заводит рабочее пространство, которое удаляется вместе с его задачами после теста
+func newWorkspace(t *testing.T, pool *pgxpool.Pool) int32 {
+	t.Helper()
+	ctx := context.Background()
+	var id int32
+	if err := pool.QueryRow(ctx, `INSERT INTO workspaces (name) VALUES ('tenant test') RETURNING id`).Scan(&id); err != nil {
+		t.Fatalf("create workspace: %v", err)
+	}
+	t.Cleanup(func() {
+		all := tenant.WithAllWorkspaces(ctx)
+		if _, err := (&tenantDB{pool: pool}).Exec(all, `DELETE FROM tasks WHERE workspace_id = $1`, id); err != nil {
+			t.Errorf("delete tasks of workspace %d: %v", id, err)
+		}
+		if _, err := pool.Exec(ctx, `DELETE FROM workspaces WHERE id = $1`, id); err != nil {
+			t.Errorf("delete workspace %d: %v", id, err)
+		}
+	})
+	return id
+}
+
+// createTask общая задача (без владельца и проекта) в пространстве из ctx
+func createTask(t *testing.T, ctx context.Context, q *db.Queries, name string) *db.Task {
+	t.Helper()
+	task, err := q.CreateTask(ctx, db.CreateTaskParams{Name: name, CustomFields: []byte("{}")})
+	if err != nil {
+		t.Fatalf("create task %q: %v", name, err)
+	}
+	return task
+}
+
+func listTasks(t *testing.T, ctx context.Context, q *db.Queries) []*db.Task {
+	t.Helper()
+	tasks, err := q.ListTasks(ctx, db.ListTasksParams{UserID: pgtype.Int4{}, Limit: 1000})
+	if err != nil {
+		t.Fatalf("list tasks: %v", err)
+	}
+	return tasks
+}
+
+func contains(tasks []*db.Task, id int32) bool {
+	for _, task := range tasks {
+		if task.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTenantWithoutWorkspaceSeesNothing(t *testing.T) {
+	pool := dbtest.Pool(t)
+	q := New(pool)
+	workspace := newWorkspace(t, pool)
+	task := createTask(t, tenant.WithWorkspace(context.Background(), workspace), q, "scoped")
+
+	if !contains(listTasks(t, tenant.WithWorkspace(context.Background(), workspace), q), task.ID) {
+		t.Fatalf("task %d is not visible in its own workspace", task.ID)
+	}
+	if tasks := listTasks(t, context.Background(), q); len(tasks) != 0 {
+		t.Fatalf("ListTasks without workspace returned %d rows, want 0", len(tasks))
+	}
+}
+
+func TestTenantWorkspacesAreIsolated(t *testing.T) {
+	pool := dbtest.Pool(t)
+	q := New(pool)
+	first, second := newWorkspace(t, pool), newWorkspace(t, pool)
+	firstCtx := tenant.WithWorkspace(context.Background(), first)
+	secondCtx := tenant.WithWorkspace(context.Background(), second)
+
+	own := createTask(t, firstCtx, q, "first")
+	foreign := createTask(t, secondCtx, q, "second")
+	if foreign.WorkspaceID != second {
+		t.Fatalf("task written in workspace %d got workspace_id %d", second, foreign.WorkspaceID)
+	}
+
+	tasks := listTasks(t, firstCtx, q)
+	if !contains(tasks, own.ID) {
+		t.Fatalf("task %d is not visible in its own workspace", own.ID)
+	}
+	if contains(tasks, foreign.ID) {
+		t.Fatalf("task %d of workspace %d is listed in workspace %d", foreign.ID, second, first)
+	}
+	if _, err := q.GetTask(firstCtx, foreign.ID); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("GetTask of workspace %d task from workspace %d: err = %v, want no rows", second, first, err)
+	}
+	if _, err := q.CompleteTask(firstCtx, foreign.ID); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("update of workspace %d task from workspace %d: err = %v, want no rows", second, first, err)
+	}
+}
+
+func TestTenantInsertIntoOtherWorkspaceIsRejected(t *testing.T) {
+	pool := dbtest.Pool(t)
+	first, second := newWorkspace(t, pool), newWorkspace(t, pool)
+	firstCtx := tenant.WithWorkspace(context.Background(), first)
+	const insert = `INSERT INTO tasks (name, workspace_id) VALUES ('foreign', $1)`
+
+	assertRejected := func(err error) {
+		t.Helper()
+		var pgErr *pgconn.PgError
+		// 42501 insufficient_privilege: new row violates row-level security policy
+		if !errors.As(err, &pgErr) || pgErr.Code != "42501" {
+			t.Fatalf("insert into workspace %d from workspace %d: err = %v, want RLS violation", second, first, err)
+		}
+	}
+
+	_, err := (&tenantDB{pool: pool}).Exec(firstCtx, insert, second)
+	assertRejected(err)
+
+	err = WithTenantTx(firstCtx, pool, func(ctx context.Context, _ *db.Queries) error {
+		_, err := (&tenantDB{pool: pool}).Exec(ctx, insert, second)
+		return err
+	})
+	assertRejected(err)
+}
+
+func TestWithTenantTxRollsBack(t *testing.T) {
+	pool := dbtest.Pool(t)
+	q := New(pool)
+	workspace := newWorkspace(t, pool)
+	ctx := tenant.WithWorkspace(context.Background(), workspace)
+	failure := errors.New("failure")
+
+	var created *db.Task
+	err := WithTenantTx(ctx, pool, func(ctx context.Context, tx *db.Queries) error {
+		created = createTask(t, ctx, tx, "in tx")
+		// запрос через пул с контекстом транзакции идет в нее же и видит незафиксированную задачу
+		if _, err := q.GetTask(ctx, created.ID); err != nil {
+			t.Errorf("task created in the transaction is not visible inside it: %v", err)
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("WithTenantTx err = %v, want %v", err, failure)
+	}
+	if _, err := q.GetTask(ctx, created.ID); !errors.Is(err, pgx.ErrNoRows) {
+		t.Fatalf("task %d of a rolled back transaction: err = %v, want no rows", created.ID, err)
+	}
+}
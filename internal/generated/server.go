@@ -13,9 +13,114 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получить API ключи
+	// (GET /api-keys)
+	GetApiKeys(ctx echo.Context) error
+	// Создать API ключ
+	// (POST /api-keys)
+	PostApiKeys(ctx echo.Context) error
+	// Отозвать API ключ
+	// (DELETE /api-keys/{id})
+	DeleteApiKeysId(ctx echo.Context, id int) error
+	// Перевыпустить секрет API ключа
+	// (POST /api-keys/{id}/rotate)
+	PostApiKeysIdRotate(ctx echo.Context, id int) error
 	// Проверка здоровья сервиса
 	// (GET /health)
 	GetHealth(ctx echo.Context) error
+	// Получить мои приглашения
+	// (GET /invitations)
+	GetInvitations(ctx echo.Context) error
+	// Отклонить или отозвать приглашение
+	// (DELETE /invitations/{id})
+	DeleteInvitationsId(ctx echo.Context, id int) error
+	// Принять приглашение
+	// (POST /invitations/{id}/accept)
+	PostInvitationsIdAccept(ctx echo.Context, id int) error
+	// Проверка живости
+	// (GET /livez)
+	GetLivez(ctx echo.Context) error
+	// Задачи, назначенные на меня
+	// (GET /me/tasks)
+	GetMeTasks(ctx echo.Context, params GetMeTasksParams) error
+	// Мой идущий таймер
+	// (GET /me/timer)
+	GetMeTimer(ctx echo.Context) error
+	// Удалить веху
+	// (DELETE /milestones/{id})
+	DeleteMilestonesId(ctx echo.Context, id int) error
+	// Получить веху
+	// (GET /milestones/{id})
+	GetMilestonesId(ctx echo.Context, id int) error
+	// Изменить веху
+	// (PATCH /milestones/{id})
+	PatchMilestonesId(ctx echo.Context, id int) error
+	// Диаграмма сгорания вехи
+	// (GET /milestones/{id}/burndown)
+	GetMilestonesIdBurndown(ctx echo.Context, id int) error
+	// Задачи вехи
+	// (GET /milestones/{id}/tasks)
+	GetMilestonesIdTasks(ctx echo.Context, id int, params GetMilestonesIdTasksParams) error
+	// Получить проекты
+	// (GET /projects)
+	GetProjects(ctx echo.Context) error
+	// Создать проект
+	// (POST /projects)
+	PostProjects(ctx echo.Context) error
+	// Удалить проект
+	// (DELETE /projects/{id})
+	DeleteProjectsId(ctx echo.Context, id int) error
+	// Получить проект
+	// (GET /projects/{id})
+	GetProjectsId(ctx echo.Context, id int) error
+	// Пользовательские поля проекта
+	// (GET /projects/{id}/fields)
+	GetProjectsIdFields(ctx echo.Context, id int) error
+	// Добавить поле
+	// (POST /projects/{id}/fields)
+	PostProjectsIdFields(ctx echo.Context, id int) error
+	// Удалить поле
+	// (DELETE /projects/{id}/fields/{field_id})
+	DeleteProjectsIdFieldsFieldId(ctx echo.Context, id int, fieldId int) error
+	// Изменить поле
+	// (PATCH /projects/{id}/fields/{field_id})
+	PatchProjectsIdFieldsFieldId(ctx echo.Context, id int, fieldId int) error
+	// Получить приглашения проекта
+	// (GET /projects/{id}/invitations)
+	GetProjectsIdInvitations(ctx echo.Context, id int) error
+	// Пригласить пользователя в проект
+	// (POST /projects/{id}/invitations)
+	PostProjectsIdInvitations(ctx echo.Context, id int) error
+	// Получить участников проекта
+	// (GET /projects/{id}/members)
+	GetProjectsIdMembers(ctx echo.Context, id int) error
+	// Исключить участника
+	// (DELETE /projects/{id}/members/{user_id})
+	DeleteProjectsIdMembersUserId(ctx echo.Context, id int, userId int) error
+	// Изменить роль участника
+	// (PUT /projects/{id}/members/{user_id})
+	PutProjectsIdMembersUserId(ctx echo.Context, id int, userId int) error
+	// Вехи проекта
+	// (GET /projects/{id}/milestones)
+	GetProjectsIdMilestones(ctx echo.Context, id int) error
+	// Создать веху
+	// (POST /projects/{id}/milestones)
+	PostProjectsIdMilestones(ctx echo.Context, id int) error
+	// План проекта
+	// (GET /projects/{id}/plan)
+	GetProjectsIdPlan(ctx echo.Context, id int) error
+	// Оценки и учтенное время проекта
+	// (GET /projects/{id}/time)
+	GetProjectsIdTime(ctx echo.Context, id int) error
+	// Проверка готовности
+	// (GET /readyz)
+	GetReadyz(ctx echo.Context) error
+	// Табель учтенного времени
+	// (GET /reports/timesheet)
+	GetReportsTimesheet(ctx echo.Context, params GetReportsTimesheetParams) error
+	// Статистика задач
+	// (GET /stats)
+	GetStats(ctx echo.Context, params GetStatsParams) error
 	// Получить все задачи
 	// (GET /tasks)
 	GetTasks(ctx echo.Context, params GetTasksParams) error
@@ -37,12 +142,120 @@ type ServerInterface interface {
 	// Обновить задачу
 	// (PUT /tasks/{id})
 	PutTasksId(ctx echo.Context, id int) error
+	// Лента активности задачи
+	// (GET /tasks/{id}/activity)
+	GetTasksIdActivity(ctx echo.Context, id int, params GetTasksIdActivityParams) error
+	// Получить вложения задачи
+	// (GET /tasks/{id}/attachments)
+	GetTasksIdAttachments(ctx echo.Context, id int) error
+	// Загрузить вложение
+	// (POST /tasks/{id}/attachments)
+	PostTasksIdAttachments(ctx echo.Context, id int, params PostTasksIdAttachmentsParams) error
+	// Удалить вложение
+	// (DELETE /tasks/{id}/attachments/{attachment_id})
+	DeleteTasksIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error
+	// Получить описание вложения
+	// (GET /tasks/{id}/attachments/{attachment_id})
+	GetTasksIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error
+	// Скачать содержимое вложения
+	// (GET /tasks/{id}/attachments/{attachment_id}/content)
+	GetTasksIdAttachmentsAttachmentIdContent(ctx echo.Context, id int, attachmentId int) error
+	// Получить чек-лист задачи
+	// (GET /tasks/{id}/checklist)
+	GetTasksIdChecklist(ctx echo.Context, id int) error
+	// Добавить пункт чек-листа
+	// (POST /tasks/{id}/checklist)
+	PostTasksIdChecklist(ctx echo.Context, id int) error
+	// Изменить порядок пунктов чек-листа
+	// (PUT /tasks/{id}/checklist/order)
+	PutTasksIdChecklistOrder(ctx echo.Context, id int) error
+	// Удалить пункт чек-листа
+	// (DELETE /tasks/{id}/checklist/{item_id})
+	DeleteTasksIdChecklistItemId(ctx echo.Context, id int, itemId int) error
+	// Изменить пункт чек-листа
+	// (PATCH /tasks/{id}/checklist/{item_id})
+	PatchTasksIdChecklistItemId(ctx echo.Context, id int, itemId int) error
+	// Превратить пункт в задачу
+	// (POST /tasks/{id}/checklist/{item_id}/convert)
+	PostTasksIdChecklistItemIdConvert(ctx echo.Context, id int, itemId int) error
+	// Получить комментарии задачи
+	// (GET /tasks/{id}/comments)
+	GetTasksIdComments(ctx echo.Context, id int, params GetTasksIdCommentsParams) error
+	// Добавить комментарий
+	// (POST /tasks/{id}/comments)
+	PostTasksIdComments(ctx echo.Context, id int) error
+	// Удалить комментарий
+	// (DELETE /tasks/{id}/comments/{comment_id})
+	DeleteTasksIdCommentsCommentId(ctx echo.Context, id int, commentId int) error
+	// Получить комментарий
+	// (GET /tasks/{id}/comments/{comment_id})
+	GetTasksIdCommentsCommentId(ctx echo.Context, id int, commentId int) error
+	// Изменить комментарий
+	// (PUT /tasks/{id}/comments/{comment_id})
+	PutTasksIdCommentsCommentId(ctx echo.Context, id int, commentId int) error
+	// История правок комментария
+	// (GET /tasks/{id}/comments/{comment_id}/history)
+	GetTasksIdCommentsCommentIdHistory(ctx echo.Context, id int, commentId int) error
 	// Отметить задачу выполненной
 	// (PATCH /tasks/{id}/complete)
-	PatchTasksIdComplete(ctx echo.Context, id int) error
+	PatchTasksIdComplete(ctx echo.Context, id int, params PatchTasksIdCompleteParams) error
+	// Получить зависимости задачи
+	// (GET /tasks/{id}/dependencies)
+	GetTasksIdDependencies(ctx echo.Context, id int) error
+	// Добавить блокирующую задачу
+	// (POST /tasks/{id}/dependencies)
+	PostTasksIdDependencies(ctx echo.Context, id int) error
+	// Удалить блокирующую задачу
+	// (DELETE /tasks/{id}/dependencies/{blocker_id})
+	DeleteTasksIdDependenciesBlockerId(ctx echo.Context, id int, blockerId int) error
+	// Переместить задачу
+	// (POST /tasks/{id}/move)
+	PostTasksIdMove(ctx echo.Context, id int) error
+	// Мои напоминания о задаче
+	// (GET /tasks/{id}/reminders)
+	GetTasksIdReminders(ctx echo.Context, id int) error
+	// Поставить напоминание
+	// (POST /tasks/{id}/reminders)
+	PostTasksIdReminders(ctx echo.Context, id int) error
+	// Удалить напоминание
+	// (DELETE /tasks/{id}/reminders/{reminder_id})
+	DeleteTasksIdRemindersReminderId(ctx echo.Context, id int, reminderId int) error
+	// Учет времени по задаче
+	// (GET /tasks/{id}/time-entries)
+	GetTasksIdTimeEntries(ctx echo.Context, id int) error
+	// Записать время вручную
+	// (POST /tasks/{id}/time-entries)
+	PostTasksIdTimeEntries(ctx echo.Context, id int) error
+	// Запустить таймер
+	// (POST /tasks/{id}/time-entries/start)
+	PostTasksIdTimeEntriesStart(ctx echo.Context, id int) error
+	// Остановить таймер
+	// (POST /tasks/{id}/time-entries/stop)
+	PostTasksIdTimeEntriesStop(ctx echo.Context, id int) error
+	// Удалить запись времени
+	// (DELETE /tasks/{id}/time-entries/{entry_id})
+	DeleteTasksIdTimeEntriesEntryId(ctx echo.Context, id int, entryId int) error
 	// Снять отметку выполнения с задачи
 	// (PATCH /tasks/{id}/uncomplete)
 	PatchTasksIdUncomplete(ctx echo.Context, id int) error
+	// Шаблоны задач
+	// (GET /templates)
+	GetTemplates(ctx echo.Context, params GetTemplatesParams) error
+	// Создать шаблон
+	// (POST /templates)
+	PostTemplates(ctx echo.Context) error
+	// Удалить шаблон
+	// (DELETE /templates/{id})
+	DeleteTemplatesId(ctx echo.Context, id int) error
+	// Получить шаблон
+	// (GET /templates/{id})
+	GetTemplatesId(ctx echo.Context, id int) error
+	// Изменить шаблон
+	// (PUT /templates/{id})
+	PutTemplatesId(ctx echo.Context, id int) error
+	// Создать задачи по шаблону
+	// (POST /templates/{id}/instantiate)
+	PostTemplatesIdInstantiate(ctx echo.Context, id int) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -50,25 +263,153 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiKeys(ctx)
+	return err
+}
+
+// PostApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiKeys(ctx)
+	return err
+}
+
+// DeleteApiKeysId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApiKeysId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApiKeysId(ctx, id)
+	return err
+}
+
+// PostApiKeysIdRotate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiKeysIdRotate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiKeysIdRotate(ctx, id)
+	return err
+}
+
 // GetHealth converts echo context to params.
 func (w *ServerInterfaceWrapper) GetHealth(ctx echo.Context) error {
 	var err error
 
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealth(ctx)
+	return err
+}
+
+// GetInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInvitations(ctx echo.Context) error {
+	var err error
+
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetHealth(ctx)
+	err = w.Handler.GetInvitations(ctx)
 	return err
 }
 
-// GetTasks converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
+// DeleteInvitationsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInvitationsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteInvitationsId(ctx, id)
+	return err
+}
+
+// PostInvitationsIdAccept converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInvitationsIdAccept(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInvitationsIdAccept(ctx, id)
+	return err
+}
+
+// GetLivez converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLivez(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLivez(ctx)
+	return err
+}
+
+// GetMeTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMeTasks(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTasksParams
+	var params GetMeTasksParams
 	// ------------- Optional query parameter "completed" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
@@ -76,6 +417,20 @@ func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
 	}
 
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", ctx.QueryParams(), &params.Field)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter field: %s", err))
+	}
+
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -91,56 +446,120 @@ func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTasks(ctx, params)
+	err = w.Handler.GetMeTasks(ctx, params)
 	return err
 }
 
-// PostTasks converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTasks(ctx echo.Context) error {
+// GetMeTimer converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMeTimer(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTasks(ctx)
+	err = w.Handler.GetMeTimer(ctx)
 	return err
 }
 
-// GetTasksCompleted converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTasksCompleted(ctx echo.Context) error {
+// DeleteMilestonesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMilestonesId(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTasksCompletedParams
-	// ------------- Optional query parameter "limit" -------------
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMilestonesId(ctx, id)
+	return err
+}
+
+// GetMilestonesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMilestonesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	ctx.Set(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMilestonesId(ctx, id)
+	return err
+}
+
+// PatchMilestonesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchMilestonesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTasksCompleted(ctx, params)
+	err = w.Handler.PatchMilestonesId(ctx, id)
 	return err
 }
 
-// GetTasksPending converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTasksPending(ctx echo.Context) error {
+// GetMilestonesIdBurndown converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMilestonesIdBurndown(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMilestonesIdBurndown(ctx, id)
+	return err
+}
+
+// GetMilestonesIdTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMilestonesIdTasks(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTasksPendingParams
+	var params GetMilestonesIdTasksParams
 	// ------------- Optional query parameter "limit" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
@@ -156,12 +575,38 @@ func (w *ServerInterfaceWrapper) GetTasksPending(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTasksPending(ctx, params)
+	err = w.Handler.GetMilestonesIdTasks(ctx, id, params)
 	return err
 }
 
-// DeleteTasksId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteTasksId(ctx echo.Context) error {
+// GetProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjects(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjects(ctx)
+	return err
+}
+
+// PostProjects converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjects(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjects(ctx)
+	return err
+}
+
+// DeleteProjectsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectsId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -173,13 +618,15 @@ func (w *ServerInterfaceWrapper) DeleteTasksId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteTasksId(ctx, id)
+	err = w.Handler.DeleteProjectsId(ctx, id)
 	return err
 }
 
-// GetTasksId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTasksId(ctx echo.Context) error {
+// GetProjectsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -191,13 +638,15 @@ func (w *ServerInterfaceWrapper) GetTasksId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTasksId(ctx, id)
+	err = w.Handler.GetProjectsId(ctx, id)
 	return err
 }
 
-// PutTasksId converts echo context to params.
-func (w *ServerInterfaceWrapper) PutTasksId(ctx echo.Context) error {
+// GetProjectsIdFields converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsIdFields(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -209,13 +658,15 @@ func (w *ServerInterfaceWrapper) PutTasksId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutTasksId(ctx, id)
+	err = w.Handler.GetProjectsIdFields(ctx, id)
 	return err
 }
 
-// PatchTasksIdComplete converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTasksIdComplete(ctx echo.Context) error {
+// PostProjectsIdFields converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectsIdFields(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -227,13 +678,43 @@ func (w *ServerInterfaceWrapper) PatchTasksIdComplete(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTasksIdComplete(ctx, id)
+	err = w.Handler.PostProjectsIdFields(ctx, id)
 	return err
 }
 
-// PatchTasksIdUncomplete converts echo context to params.
-func (w *ServerInterfaceWrapper) PatchTasksIdUncomplete(ctx echo.Context) error {
+// DeleteProjectsIdFieldsFieldId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectsIdFieldsFieldId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "field_id" -------------
+	var fieldId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "field_id", ctx.Param("field_id"), &fieldId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter field_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectsIdFieldsFieldId(ctx, id, fieldId)
+	return err
+}
+
+// PatchProjectsIdFieldsFieldId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchProjectsIdFieldsFieldId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -243,10 +724,1426 @@ func (w *ServerInterfaceWrapper) PatchTasksIdUncomplete(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// ------------- Path parameter "field_id" -------------
+	var fieldId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "field_id", ctx.Param("field_id"), &fieldId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter field_id: %s", err))
+	}
+
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PatchTasksIdUncomplete(ctx, id)
+	err = w.Handler.PatchProjectsIdFieldsFieldId(ctx, id, fieldId)
+	return err
+}
+
+// GetProjectsIdInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsIdInvitations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectsIdInvitations(ctx, id)
+	return err
+}
+
+// PostProjectsIdInvitations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectsIdInvitations(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectsIdInvitations(ctx, id)
+	return err
+}
+
+// GetProjectsIdMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsIdMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectsIdMembers(ctx, id)
+	return err
+}
+
+// DeleteProjectsIdMembersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteProjectsIdMembersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteProjectsIdMembersUserId(ctx, id, userId)
+	return err
+}
+
+// PutProjectsIdMembersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutProjectsIdMembersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutProjectsIdMembersUserId(ctx, id, userId)
+	return err
+}
+
+// GetProjectsIdMilestones converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsIdMilestones(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectsIdMilestones(ctx, id)
+	return err
+}
+
+// PostProjectsIdMilestones converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProjectsIdMilestones(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProjectsIdMilestones(ctx, id)
+	return err
+}
+
+// GetProjectsIdPlan converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsIdPlan(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectsIdPlan(ctx, id)
+	return err
+}
+
+// GetProjectsIdTime converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProjectsIdTime(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProjectsIdTime(ctx, id)
+	return err
+}
+
+// GetReadyz converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReadyz(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReadyz(ctx)
+	return err
+}
+
+// GetReportsTimesheet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsTimesheet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsTimesheetParams
+	// ------------- Required query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsTimesheet(ctx, params)
+	return err
+}
+
+// GetStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsParams
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", ctx.QueryParams(), &params.Period)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter period: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStats(ctx, params)
+	return err
+}
+
+// GetTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksParams
+	// ------------- Optional query parameter "completed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "completed", ctx.QueryParams(), &params.Completed)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter completed: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", ctx.QueryParams(), &params.Field)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter field: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasks(ctx, params)
+	return err
+}
+
+// PostTasks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasks(ctx)
+	return err
+}
+
+// GetTasksCompleted converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksCompleted(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksCompletedParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksCompleted(ctx, params)
+	return err
+}
+
+// GetTasksPending converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksPending(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksPendingParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksPending(ctx, params)
+	return err
+}
+
+// DeleteTasksId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksId(ctx, id)
+	return err
+}
+
+// GetTasksId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksId(ctx, id)
+	return err
+}
+
+// PutTasksId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTasksId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTasksId(ctx, id)
+	return err
+}
+
+// GetTasksIdActivity converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdActivity(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksIdActivityParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdActivity(ctx, id, params)
+	return err
+}
+
+// GetTasksIdAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdAttachments(ctx, id)
+	return err
+}
+
+// PostTasksIdAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTasksIdAttachmentsParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "X-Checksum-Sha256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Checksum-Sha256")]; found {
+		var XChecksumSha256 string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Checksum-Sha256, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Checksum-Sha256", valueList[0], &XChecksumSha256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Checksum-Sha256: %s", err))
+		}
+
+		params.XChecksumSha256 = &XChecksumSha256
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdAttachments(ctx, id, params)
+	return err
+}
+
+// DeleteTasksIdAttachmentsAttachmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksIdAttachmentsAttachmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", ctx.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksIdAttachmentsAttachmentId(ctx, id, attachmentId)
+	return err
+}
+
+// GetTasksIdAttachmentsAttachmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdAttachmentsAttachmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", ctx.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdAttachmentsAttachmentId(ctx, id, attachmentId)
+	return err
+}
+
+// GetTasksIdAttachmentsAttachmentIdContent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdAttachmentsAttachmentIdContent(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachment_id" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachment_id", ctx.Param("attachment_id"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdAttachmentsAttachmentIdContent(ctx, id, attachmentId)
+	return err
+}
+
+// GetTasksIdChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdChecklist(ctx, id)
+	return err
+}
+
+// PostTasksIdChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdChecklist(ctx, id)
+	return err
+}
+
+// PutTasksIdChecklistOrder converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTasksIdChecklistOrder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTasksIdChecklistOrder(ctx, id)
+	return err
+}
+
+// DeleteTasksIdChecklistItemId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksIdChecklistItemId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", ctx.Param("item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksIdChecklistItemId(ctx, id, itemId)
+	return err
+}
+
+// PatchTasksIdChecklistItemId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTasksIdChecklistItemId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", ctx.Param("item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTasksIdChecklistItemId(ctx, id, itemId)
+	return err
+}
+
+// PostTasksIdChecklistItemIdConvert converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdChecklistItemIdConvert(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "item_id", ctx.Param("item_id"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdChecklistItemIdConvert(ctx, id, itemId)
+	return err
+}
+
+// GetTasksIdComments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTasksIdCommentsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", ctx.QueryParams(), &params.Offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdComments(ctx, id, params)
+	return err
+}
+
+// PostTasksIdComments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdComments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdComments(ctx, id)
+	return err
+}
+
+// DeleteTasksIdCommentsCommentId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksIdCommentsCommentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", ctx.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksIdCommentsCommentId(ctx, id, commentId)
+	return err
+}
+
+// GetTasksIdCommentsCommentId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdCommentsCommentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", ctx.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdCommentsCommentId(ctx, id, commentId)
+	return err
+}
+
+// PutTasksIdCommentsCommentId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTasksIdCommentsCommentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", ctx.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTasksIdCommentsCommentId(ctx, id, commentId)
+	return err
+}
+
+// GetTasksIdCommentsCommentIdHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdCommentsCommentIdHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "comment_id" -------------
+	var commentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "comment_id", ctx.Param("comment_id"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter comment_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdCommentsCommentIdHistory(ctx, id, commentId)
+	return err
+}
+
+// PatchTasksIdComplete converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTasksIdComplete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchTasksIdCompleteParams
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", ctx.QueryParams(), &params.Force)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter force: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTasksIdComplete(ctx, id, params)
+	return err
+}
+
+// GetTasksIdDependencies converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdDependencies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdDependencies(ctx, id)
+	return err
+}
+
+// PostTasksIdDependencies converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdDependencies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdDependencies(ctx, id)
+	return err
+}
+
+// DeleteTasksIdDependenciesBlockerId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksIdDependenciesBlockerId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "blocker_id" -------------
+	var blockerId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blocker_id", ctx.Param("blocker_id"), &blockerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blocker_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksIdDependenciesBlockerId(ctx, id, blockerId)
+	return err
+}
+
+// PostTasksIdMove converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdMove(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdMove(ctx, id)
+	return err
+}
+
+// GetTasksIdReminders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdReminders(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdReminders(ctx, id)
+	return err
+}
+
+// PostTasksIdReminders converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdReminders(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdReminders(ctx, id)
+	return err
+}
+
+// DeleteTasksIdRemindersReminderId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksIdRemindersReminderId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "reminder_id" -------------
+	var reminderId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "reminder_id", ctx.Param("reminder_id"), &reminderId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reminder_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksIdRemindersReminderId(ctx, id, reminderId)
+	return err
+}
+
+// GetTasksIdTimeEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTasksIdTimeEntries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTasksIdTimeEntries(ctx, id)
+	return err
+}
+
+// PostTasksIdTimeEntries converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdTimeEntries(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdTimeEntries(ctx, id)
+	return err
+}
+
+// PostTasksIdTimeEntriesStart converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdTimeEntriesStart(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdTimeEntriesStart(ctx, id)
+	return err
+}
+
+// PostTasksIdTimeEntriesStop converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTasksIdTimeEntriesStop(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTasksIdTimeEntriesStop(ctx, id)
+	return err
+}
+
+// DeleteTasksIdTimeEntriesEntryId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTasksIdTimeEntriesEntryId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "entry_id" -------------
+	var entryId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entry_id", ctx.Param("entry_id"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter entry_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTasksIdTimeEntriesEntryId(ctx, id, entryId)
+	return err
+}
+
+// PatchTasksIdUncomplete converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchTasksIdUncomplete(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchTasksIdUncomplete(ctx, id)
+	return err
+}
+
+// GetTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTemplatesParams
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", ctx.QueryParams(), &params.ProjectId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter project_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTemplates(ctx, params)
+	return err
+}
+
+// PostTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTemplates(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTemplates(ctx)
+	return err
+}
+
+// DeleteTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteTemplatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteTemplatesId(ctx, id)
+	return err
+}
+
+// GetTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTemplatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTemplatesId(ctx, id)
+	return err
+}
+
+// PutTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutTemplatesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutTemplatesId(ctx, id)
+	return err
+}
+
+// PostTemplatesIdInstantiate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTemplatesIdInstantiate(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTemplatesIdInstantiate(ctx, id)
 	return err
 }
 
@@ -278,7 +2175,42 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api-keys", wrapper.GetApiKeys)
+	router.POST(baseURL+"/api-keys", wrapper.PostApiKeys)
+	router.DELETE(baseURL+"/api-keys/:id", wrapper.DeleteApiKeysId)
+	router.POST(baseURL+"/api-keys/:id/rotate", wrapper.PostApiKeysIdRotate)
 	router.GET(baseURL+"/health", wrapper.GetHealth)
+	router.GET(baseURL+"/invitations", wrapper.GetInvitations)
+	router.DELETE(baseURL+"/invitations/:id", wrapper.DeleteInvitationsId)
+	router.POST(baseURL+"/invitations/:id/accept", wrapper.PostInvitationsIdAccept)
+	router.GET(baseURL+"/livez", wrapper.GetLivez)
+	router.GET(baseURL+"/me/tasks", wrapper.GetMeTasks)
+	router.GET(baseURL+"/me/timer", wrapper.GetMeTimer)
+	router.DELETE(baseURL+"/milestones/:id", wrapper.DeleteMilestonesId)
+	router.GET(baseURL+"/milestones/:id", wrapper.GetMilestonesId)
+	router.PATCH(baseURL+"/milestones/:id", wrapper.PatchMilestonesId)
+	router.GET(baseURL+"/milestones/:id/burndown", wrapper.GetMilestonesIdBurndown)
+	router.GET(baseURL+"/milestones/:id/tasks", wrapper.GetMilestonesIdTasks)
+	router.GET(baseURL+"/projects", wrapper.GetProjects)
+	router.POST(baseURL+"/projects", wrapper.PostProjects)
+	router.DELETE(baseURL+"/projects/:id", wrapper.DeleteProjectsId)
+	router.GET(baseURL+"/projects/:id", wrapper.GetProjectsId)
+	router.GET(baseURL+"/projects/:id/fields", wrapper.GetProjectsIdFields)
+	router.POST(baseURL+"/projects/:id/fields", wrapper.PostProjectsIdFields)
+	router.DELETE(baseURL+"/projects/:id/fields/:field_id", wrapper.DeleteProjectsIdFieldsFieldId)
+	router.PATCH(baseURL+"/projects/:id/fields/:field_id", wrapper.PatchProjectsIdFieldsFieldId)
+	router.GET(baseURL+"/projects/:id/invitations", wrapper.GetProjectsIdInvitations)
+	router.POST(baseURL+"/projects/:id/invitations", wrapper.PostProjectsIdInvitations)
+	router.GET(baseURL+"/projects/:id/members", wrapper.GetProjectsIdMembers)
+	router.DELETE(baseURL+"/projects/:id/members/:user_id", wrapper.DeleteProjectsIdMembersUserId)
+	router.PUT(baseURL+"/projects/:id/members/:user_id", wrapper.PutProjectsIdMembersUserId)
+	router.GET(baseURL+"/projects/:id/milestones", wrapper.GetProjectsIdMilestones)
+	router.POST(baseURL+"/projects/:id/milestones", wrapper.PostProjectsIdMilestones)
+	router.GET(baseURL+"/projects/:id/plan", wrapper.GetProjectsIdPlan)
+	router.GET(baseURL+"/projects/:id/time", wrapper.GetProjectsIdTime)
+	router.GET(baseURL+"/readyz", wrapper.GetReadyz)
+	router.GET(baseURL+"/reports/timesheet", wrapper.GetReportsTimesheet)
+	router.GET(baseURL+"/stats", wrapper.GetStats)
 	router.GET(baseURL+"/tasks", wrapper.GetTasks)
 	router.POST(baseURL+"/tasks", wrapper.PostTasks)
 	router.GET(baseURL+"/tasks/completed", wrapper.GetTasksCompleted)
@@ -286,7 +2218,43 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/tasks/:id", wrapper.DeleteTasksId)
 	router.GET(baseURL+"/tasks/:id", wrapper.GetTasksId)
 	router.PUT(baseURL+"/tasks/:id", wrapper.PutTasksId)
+	router.GET(baseURL+"/tasks/:id/activity", wrapper.GetTasksIdActivity)
+	router.GET(baseURL+"/tasks/:id/attachments", wrapper.GetTasksIdAttachments)
+	router.POST(baseURL+"/tasks/:id/attachments", wrapper.PostTasksIdAttachments)
+	router.DELETE(baseURL+"/tasks/:id/attachments/:attachment_id", wrapper.DeleteTasksIdAttachmentsAttachmentId)
+	router.GET(baseURL+"/tasks/:id/attachments/:attachment_id", wrapper.GetTasksIdAttachmentsAttachmentId)
+	router.GET(baseURL+"/tasks/:id/attachments/:attachment_id/content", wrapper.GetTasksIdAttachmentsAttachmentIdContent)
+	router.GET(baseURL+"/tasks/:id/checklist", wrapper.GetTasksIdChecklist)
+	router.POST(baseURL+"/tasks/:id/checklist", wrapper.PostTasksIdChecklist)
+	router.PUT(baseURL+"/tasks/:id/checklist/order", wrapper.PutTasksIdChecklistOrder)
+	router.DELETE(baseURL+"/tasks/:id/checklist/:item_id", wrapper.DeleteTasksIdChecklistItemId)
+	router.PATCH(baseURL+"/tasks/:id/checklist/:item_id", wrapper.PatchTasksIdChecklistItemId)
+	router.POST(baseURL+"/tasks/:id/checklist/:item_id/convert", wrapper.PostTasksIdChecklistItemIdConvert)
+	router.GET(baseURL+"/tasks/:id/comments", wrapper.GetTasksIdComments)
+	router.POST(baseURL+"/tasks/:id/comments", wrapper.PostTasksIdComments)
+	router.DELETE(baseURL+"/tasks/:id/comments/:comment_id", wrapper.DeleteTasksIdCommentsCommentId)
+	router.GET(baseURL+"/tasks/:id/comments/:comment_id", wrapper.GetTasksIdCommentsCommentId)
+	router.PUT(baseURL+"/tasks/:id/comments/:comment_id", wrapper.PutTasksIdCommentsCommentId)
+	router.GET(baseURL+"/tasks/:id/comments/:comment_id/history", wrapper.GetTasksIdCommentsCommentIdHistory)
 	router.PATCH(baseURL+"/tasks/:id/complete", wrapper.PatchTasksIdComplete)
+	router.GET(baseURL+"/tasks/:id/dependencies", wrapper.GetTasksIdDependencies)
+	router.POST(baseURL+"/tasks/:id/dependencies", wrapper.PostTasksIdDependencies)
+	router.DELETE(baseURL+"/tasks/:id/dependencies/:blocker_id", wrapper.DeleteTasksIdDependenciesBlockerId)
+	router.POST(baseURL+"/tasks/:id/move", wrapper.PostTasksIdMove)
+	router.GET(baseURL+"/tasks/:id/reminders", wrapper.GetTasksIdReminders)
+	router.POST(baseURL+"/tasks/:id/reminders", wrapper.PostTasksIdReminders)
+	router.DELETE(baseURL+"/tasks/:id/reminders/:reminder_id", wrapper.DeleteTasksIdRemindersReminderId)
+	router.GET(baseURL+"/tasks/:id/time-entries", wrapper.GetTasksIdTimeEntries)
+	router.POST(baseURL+"/tasks/:id/time-entries", wrapper.PostTasksIdTimeEntries)
+	router.POST(baseURL+"/tasks/:id/time-entries/start", wrapper.PostTasksIdTimeEntriesStart)
+	router.POST(baseURL+"/tasks/:id/time-entries/stop", wrapper.PostTasksIdTimeEntriesStop)
+	router.DELETE(baseURL+"/tasks/:id/time-entries/:entry_id", wrapper.DeleteTasksIdTimeEntriesEntryId)
 	router.PATCH(baseURL+"/tasks/:id/uncomplete", wrapper.PatchTasksIdUncomplete)
+	router.GET(baseURL+"/templates", wrapper.GetTemplates)
+	router.POST(baseURL+"/templates", wrapper.PostTemplates)
+	router.DELETE(baseURL+"/templates/:id", wrapper.DeleteTemplatesId)
+	router.GET(baseURL+"/templates/:id", wrapper.GetTemplatesId)
+	router.PUT(baseURL+"/templates/:id", wrapper.PutTemplatesId)
+	router.POST(baseURL+"/templates/:id/instantiate", wrapper.PostTemplatesIdInstantiate)
 
 }
@@ -0,0 +1,415 @@
+// Package generated provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.0 DO NOT EDIT.
+package generated
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+y9e3MbR5Iv+lU6cPcPaS9AgtTDNhUbd2VJ9nBs2ToSvfOwdBhNoCliBaAxjYZkjo4i",
+	"RHJkeYI65lnv3BjH7Ngejzdi958bAUKEBb6gr9D9jW5kZlV1VXdVd4MPSaQQsesRAXR11iOz8vnLh4WK",
+	"22i5TafptwszDwtLjl11PPznFbfpO02/dLXWbrntml9zm/Bx1WlXvFqL/iwE3wZ74YYV/iHoBtvBbtC1",
+	"gq1gFz5ZCYbhk/Bx0A32g36wHwzCjUKx0K4sOQ0bxvGXW05hptD2vVrzbuHRo2Lh2px9N/mGW7+4XJq+",
+	"cBEHDLaCfvg4+DkYBHvBMHgeDK2gZwU7QTfohevhU/hX+CTjNTdt3/m41qj5JfyvZk5/C7rBi2AP3gWD",
+	"D8PHwYtgEOyH61a4GgyDHZzQkF69Gwzgz3A16Fpngr2gG+yEK0BguBbsWcGLoBu8DB8HQ6S/ZwUvYRrh",
+	"43Aj2Dqro7TW9J27jhcj9YZbr1WWNbT+EAyBhHA1GMDsLfgj2MMPurg4vWAAy2bdLtzulMvnKgsdr+3j",
+	"P51LD/6JPgtXgn6wE64F+8GWFewHXSJzF2fZpz/4B7iXQZ9GuF3Ivdg3nYZda8LnyUn8CKsc7IbP4H81",
+	"a9YP/whkwJ7/DDTBYdgK+nDewtXwmRVsBv3ghYVfw2y75vOmX96bTtvRnYT/wvMGY4crCo3qiklnJOjC",
+	"d3g6YBk3wzU8tKvyim5nEub43nLp8qLveAcnKlotfHUPjm74OBjQiklrnEXNr0tXlpzKvXanUbq1ZE9f",
+	"uHgQJl1yvkg9Ko+KBc9pt9xm20Hp875dven8ruO0cVsqJIvgn3arVa9VbHjxZMtzF+pO4//+1zbJJucL",
+	"u9GqO/REFcb/l8sfz169PDf76Sfz127e/PRmoVioOr5dq+NLmnYDJ9u2POd3nZrnVAuPigXH81yvMAMk",
+	"WJyGYqHhtNv2XRzTrteqSIC1aNfqThUm5tt+p12YOV8uFwt+za87iQHYjO0Ft+PPLNTt5r3CI3lF/sFz",
+	"Fgszhf9rMpLIk/Rte/IakoSrFDsM3wX9oIcLvh+uA5PQ6Wd/yJzUhdddcZuL9VpllEU9LIV/CYbBfvgH",
+	"FEw74aoVroAUxYMa/hEOCd0VK3g+N0i6BHvSRMInQPkHrrdQq1ad5ujnAfe65XiNWrvNbrF2p9GwvWWg",
+	"7yfr8o1ZlOTh1+FTYNp9Ytj9cI0YiM7wS7zMekG3UCzct+sd+Zh98OnN92evXr32iXq+5HcWfLt9rz3z",
+	"wKv5jnzKonnJZwxIuucsW1XXaVtN17eW7PuO5S854qRa7YrbcpSjdy46evKouoP3qFj4Xcf17dhafBuu",
+	"hE/xNL3E1e/CuvSCId0m8Hf4NBiEK8Eul9NbQTd8qlmR//HZp3OX56/9+sq1a1evXVWXpU737lQZmMVz",
+	"2m7Hqzh8hbLXBn9mIfmW80XFcapOdcbCQa1a24JhD7wsR8SRW+xAd/FQPw32pQNknZFOVl98HAytEghK",
+	"tk4T0dk5awUD4B4rGKRuz0t2FbygyweZbDfcgAX90PadB/byXK3huJ1Xyfx/jgQQ8pUVrsHZwdthN1wJ",
+	"N2DKOIEeLhtoF2xdenjJ7dEEZpu+4zXtOr3p1dH/DexVuIqkgDDaAIqH4VfBINhEfQvu3PAxE8EoYj9x",
+	"/Q/cTrN6qHtr7vKtj+Y/+XRu/oNPP/vkaiFiiU9c36Lh4yxhTaGgWGRfRuf/fHT+5aeP5z76W9APV8K1",
+	"8DHfcDimwTZtLbzghr1cd+3qnOt+bHt3nUOt0o3Lv/n408tX5+c+/XT+48s3P7xmkDPn373wzsWyJFgY",
+	"Edac61pEhryaLfYtCZc2il0+iLWw7DttkjbyKk9JUkY3+vGs9o8JdasPyjDKgfArUgeGwctwDaVRpJCF",
+	"jyMTB+0WYTUgP27ClqH4eqIKJZz22QLqzs27zieuf8v2a+3Fmr1AG2Nk/68YA60H20DUAL5AGobBPt4k",
+	"JAj7XK8HagzqZKGoM1ORoAxjTj9ekc4ovH7Afkib/I+T59+dnjqXrrc+KhZuOd79WsX5rGnft2t1vhSv",
+	"UML28E7GCcFGh8+sM7iHL8geF3rUWSFVuaBFHuXXVbgWvISlgP2dc93rdnOZqa/tQ7Hpzctz1+Y/nr0+",
+	"O3dNFmXAHvAOS7xEZkLP9h12qfNLvmh5YBhZddt3PJn5pt+LmE836vEw3w+4lOB4wMMtmd6gJXWZRrsa",
+	"rmssWsVvoB5ojX9CRx97YjL+c4PbIN8Q7Pdmwz3fMNEjeis77yjw86Q5nPq09FPcs8+adsdfcr3a753D",
+	"3ciffXL5s7lffHpz9rfKIVbGl89vrXkf7ETL9SznixZq7BXPqTpNv2bX28oFPRWd3thwx2YzwhldQ11y",
+	"lUSDIiesM3CLk87ZRX1sH4+2EN9CXERHG300wX6krOJDJF+EgVokXW8YvAANFT4hrtkMhqTdomEIH6k8",
+	"8atf/ap0ueMvwepVbN9RBadOJn/WbHdaLdfznep1p1qz55ZbbDcPvP+3Prtx49Obc9euzl+/dnX28vzc",
+	"b27EFA42+DzfNukFX5Qa7ar7oAmKgWziSHRaSKiFlMoniY1qwaiWaUywfED9s+t194Hqkpi6IJ8vw+uO",
+	"56T9PRgEL41eIbQHUBPB80NS9AwqLJEmEKkNqMG8VBUSecXPFiLzDbfj8o3ZjxyN01S297nvkPnOHgd9",
+	"JpFbnttyPL9G3oOK59i+U5238eQsul4D/lWo2r5T8msNaQX5KSwWiO3b7Jm45ga3QbBj4Sy38eTDLb5x",
+	"yWp26nWY5CZq0ivhY7IgifUY1YWingZ4lvQP3+s4GppqVeV0TxUTDr9ioW63/flOO322mW+KRqm1lHcW",
+	"psvnJsoTU1PnJt7JMxA56eQBKrVS1WnV3WXdqrc8Z7H2hdZTjoYaOsaYttRDTXldcv4UrfApCqhN+LhP",
+	"51TypG6G6+j7CNeCF6AqgbJVKEq0+dV787/+3bn7780tXNaR5zn33XuHXFr0/OCxrPlOo53FnsQGt+Ch",
+	"wiMxnO159nKB/K7M9znzOZwPtuJiJcX7lBOtzCR2ZmKbX5S5544gwF34V6eC9zsR+HGNfL0q49mt2vw9",
+	"Z3nU2WZOVIxrJohWTHOQhMMmOjczFrn3PMeuWiXrw2tzitIHN5/k/wP2HpBVgj6Fr9ER2o89crsJS97s",
+	"NIDeaPhCUXEl3tEcEEa/U/G0UY2/cNHXQ3MHb110y8oycBjsXVI+IQfTDorrdby+++EquXCGaNHtM2Ge",
+	"EJ5srfPv3D2d1A5+lGmR3LUUcMS1ew4Mje4v8M38unT5xmzpI2eZ6ySXmW5Fzvv3HdtzPCP3Xmj8tlyZ",
+	"+uX08q/evXfjfPPmxfbcO51/ee/Br8vLv52y35+uXD2XZHD9KSvQlLRHreLX7tf85VnfaWhOf8V3vfla",
+	"VbMa/4dHdDD8FOyxwwRGOwR5Nvic8fLdDNfDVfV60eh1klFiFkPSRbHgVnX79Hfcp5Vw1UAZo0EKFHP6",
+	"MDKWKf4Ochdrl/Bb8krhyvyB4qcHWNKCbmnaHdxh/dZ9F3TZ7fFU9oqIwUEkrLLPwhUROBwwj+4z/dJZ",
+	"drtdu9t0qlYwsDpN/tclKxjwd+HC59paf1kn+ipuowGaaEm7QMF2UX4XU9fUxZKCBsFAEm9s5OiuKBQL",
+	"nVaV/QuERd1hnzblv/gs8Qvxx50svvRJ6cXbTvCYsmvsdGffXYx/9beXuLHyXV2yLEhcYEXuz3yo2S93",
+	"cbHtGL7zXd+uG2K7yt2PNPLf89eJsbWz9327stRwmpq5V1jQ+ODR4kguv7f47sVq+d2pd989X3mnevHC",
+	"e/b0omPb5cqFC3a1PHXBPreweH5xamF6obzw7vR0pTp1oXqxMnVhobxYLtvld3UiQTXWHmpNF7BXDdYI",
+	"sKVuBnvhmkK6YmVWFwtHJNAWa3UnqRl7Tt2x206p6fpOe8Lwujw2QLv2+yxnasxRLE8a3aaSiVJr+hfP",
+	"a+UkaDLzeQjqtMDKdarzC8YsmETo61mRaQXhY9TXB0EPIzfbIl9phOtQkPdOtvzUKdV8ptLWxc4gW/Vi",
+	"xDnqtLNlkeBGgy4tvh9BJolnslVqafh08j7DWSUJhJXRKn7JKEeUcYYXHCShocX8ONJKB8ELi6915JEd",
+	"yLbzQq0Jke+s6wLp0k3p/Y7XBA9McipVewSbhQ9z1V7Wyf1Gre60fbfp5OCUGOXKo0WiKm0iQEFSkosL",
+	"Vx/hYdd50ULrWk4Ui9JQLKZrKdbytI7Tq8y/JzsMpi+UyudK5XNxv4devDl2XUPp91FEPtgh8U2pcUEX",
+	"ZQdmZpE7EsTAQCb0vYkp+c1uZ6EuvbvZaSwQ7V5Kktt3FCwwrA9fRIuH9UEY9cMvYd32ww2ZmKmybtnE",
+	"q+cbtWbHd9oaEr4Pv8TX7rAsFx094ROJnhzUvFcuZ55CtlXR6qgqXZJ0vou6o4rJaPUjULfEQCZ9q+W5",
+	"dz2nnX+gG/wBk2olRkydmN4WxFvBUQXAol1vR/fPguvWHbuZ945Pyer9LhjyW/4l5hPu8HxSNFl2Sphz",
+	"io4Dip7C0cX/BavEmioUM97tO1/4KpOjW2XIQgUsSXEvGMDlHXTDLxlHpstpumhh6KJYLmmeqYt+Q9ps",
+	"48In+GkVl4nMuKG8WMOgl70IXDGPJ52g2+V56oDnM5mOq/GcfO3smdmVVBXQUxK/b945mBegZ123vXt4",
+	"TxblHf93FgwiV5rFwqnPwq+L1j+fn0YRHa5gUBsScOB+eYmeWJDXa8xp3j0qhdqp1qJHEhvCUpE4Tbto",
+	"EIDM3I4SuHaCQaRPau1jlhHDH2ApUMfqzIftrblN3W3wEy7nXrjBcpzWeap3QpPGtd+h3Qofh+uQnkl5",
+	"5Xg9RNdXV97gz89P3ylGotjkZIjkbU5jIF27jk6uMOHFGignQ97yFN7Qq9LMXzHCZUMPpJr1sf35jygn",
+	"B/3C6Azqo48szQGQUJ73eGwWrJnCKILo+2ATn+zTeQaZ/5Q5jIlnNYe8H/SI3sjJk7GBYi1Hcj2wBZWS",
+	"xNUdGlEsNewvPnaad/0lykstg9bdFJ9kXTz4slQq79d46vGoZBpckVtyTvKO7nIEElt1u2KSan9Bf8sW",
+	"JDGuRq/DCBftIL4T/t8koXIsikpEjiXS85vHvh2Z4cTKZ1mv0RsMRIK6WnObt3zPse/p1ISO54Etzy0/",
+	"7Y5CyjspSUzP2bgkXQqUSYqZvUUrSsFQzIOYyg41SUxbIN1c5F/0WKqwIpPPaQO+bvOu044oj4TvdDbz",
+	"yrOODaVdSBTAFOox8u4BI+e8DGifa09yjD8eS895pqP4s9aJT7s1CPriZUFXk08YeSiGTGeR5M30hQsZ",
+	"0uawId9GrTlLj03FuADc5rXfdRz2NSga8R1mripGgXlPFfPFuLWKMr1od+p+ihVzUCtBWtwLo4pyfGfK",
+	"LDtt3218UHPqVeMc9eFLEXUlFWujaKFuj2EnckCQ27FHsmCI8mIVb+xo/m3f9ZbnW26N7suW7fuOB8P/",
+	"z8/t0u/vwH/Kpffm7zwsFy9OP/qHQq58ilswqCUGHfFoui2TgvkNUwkg0YulQUZBr0GwfcnC0NAGCDju",
+	"skWJxuJbbafuVHwIZzU6db82T3/DullYQrkWPA8G4RMWaaLESvibCngKku6p3u6ZU2rYX3B+KZdjHKMc",
+	"l1yHmMUXUi+r6FRhPlb8UFIMmXEiDmg+oVedltOsOs2KWb4u1N3KPccQWI48el1Z6w+6TOsIdjEZBosb",
+	"14kD426kLmUC7FMSeswObtSatUanIa+86XKR6DTPd7Z5v+ZjfMU4X8+tZ27BDc+FcW/CTyHe0BbrMwLB",
+	"/KkivdJM9HXulzXSrOyKzK1JZVVnzTar89yRmoyVSBbsgBI60TVroVx9gtaeyM1jm2i1fdvz55knL+mb",
+	"nTqfxzeblD3Bj+yi3A9Xranz6uRy3YwRXQd2GhtuPGXGfEHNe8pPkGlH+eRHmqCONDMJN51GrVl1PCMN",
+	"4GmtGzxZwi1BTI32ZvgM/C+s9O9/U0I7BMu2UFtdkRKF5UIo/D2mCNM9tk8DBEPpLTvhGnlTnsLdcFbe",
+	"JCIydhLO664eNA9THN5/pgotpbp8Dw/bWrhKNhRpheQSj/vhyUFCqRdq5OJiGckjuXBh+sJFdqvQByYH",
+	"fa1pMMX+Sv5WZALpxehSpVp2CrdCNTnaEP1gL79RZjgsc3b7nvGgsFwKiBvpFvbbZF5KMLBKlFSOW89U",
+	"m4GqzaiLXOQ3PXMtsBCMvA3hqrx36IroYX0Qi8eHXyr3fIqolq/16XIOPbhYqOC1PL8I9zKtSrWKrmS7",
+	"fkNaLXLPJY6epOswz6EuRo3HE7UW/EHkUpSW7KVkzDC2gWUz6U+RH29DqmLmXiSxaXiiE2cjdu8kPELk",
+	"hhJWTyydJ5Lr31gIhvEczaSBKAW0UGvbDddU/jZcY9WOk2EEqu/P6ept+7WG7Tt5I2U9SWhAqsMlq2yV",
+	"hLU5FD9lpgc7gWXhRkoRCvHQbkKDhvu4m8ZDhWLGqc9rvxp38i9YFyaMLcqw3AmG6g7muatbNvoKalVD",
+	"kP8FEsBuH/BnCJrCNXb9xOTDpcQvSeKzsaJkgLhVZeEfW8FA4UPC5khfzxZd87lmIdOfJCEqBO8SOZDb",
+	"5z5oOh534oCH2vXOZtLk23fb2msFMnl3goFxdz8vuM0F1/aqsEN39BbThVEMplySVa9vycSbVZw5p9Gq",
+	"o6qTT3HOFjIapfT7YB99NY8psTl4jmcK9Bm4gbnVfgAGSDs7/x10g01krv0Yw18StfM7VNUZ7FslcWlS",
+	"ccxX0dM5jkv7XlvvEYY6LZYY0I9zWi+KS/YslpGDxV1EnlJ4PF0uK3kEA+sCKAfkvNlnYYxcviy+4aCu",
+	"qGeNHUyDa8twymjuKeer1nCuNX3PbD1XOx6am/L1IaVDyHfA+fPlTPHs+k6C4comQ8fky/+ORB/hcqAx",
+	"JZzJu+L8oKh8EfxMybncHS0VuexJFakHcPgnFka7ypGrQ+MdPHBqd3oUNJ9HDo61ovKZL8WYH250J9sR",
+	"utEMfjJFzGsCsdGrNXkvkpRKX1nVF2bWgIO+VlllskS6vVETloVf4bicauinkeZa1LjZon2Rns1MxJTe",
+	"rA9nRRZFvlhWNF5mHIsNnUHWXErSs8QPg9hhy+IRq3S7CQ50sAS5+b9DyX9DBOZRKu/gLihalD1nlSRo",
+	"oaIFHA9XHGpSQdf6zW9+85vS9eulq1eLt5vsvJeiwiPM9Qx6MqPQ4DJDwAN7aJwCDT3d7283wYNH5Vmm",
+	"2pCEiRtXz9W6LcpGokkWitwBJVg0xrHwem1JV+TVHdGd+29MXR+A7oKVZl21DKObmad0EKks5XJo/Mub",
+	"EVFkDSMaRRph2ZHIKAVEWo9MVhWAQlmGbgT6M8A8uD+grrRHwsy6+cEV6513y+9YZ0xV5WeTNcVYWK4N",
+	"y28p71NuHg2iXdJsjkrSR/BY/ElCeRzE/QlbVBjNSvultTjDXTj8QzTLSfFALEaKygFPwQ7DIfxKcYoI",
+	"cRPsndU5JBzDDjFZZVynCKWP9linOjTbvt2saHbhs5uzMfS8IoMa5eGQISVBDYMXTAzsRoJgE/04e+oJ",
+	"Lkyi6jk5paNEVPuPdBCV4T+BNHcZylCvQyImQPwtv5ibu2Hx/GhACxKYXOwGjvIOy1o/BsMXSB5mDByB",
+	"DYrQRMPYZFjkLr5w4boyMy2OYlx90e1gvuFlzIMsFddhR4nvV5G4WCdZfuHYdX8Jo+JpNoRmN4I/Bbsy",
+	"BwpUHzYNin4zQYSuqV18QIL/jFfkTE1MX9Dkq5t46weMrrOc3ui1XfmQCyqUxay4zaZTQbZruW7dats+",
+	"TBUQ+qbKlrsI/41+07ZqTavTdtLPKr9L3XsCeOWO/E43e9/YYOZ9uiXepqtiM0vSdMVNPgNarLTgBQDi",
+	"hc8Y560T321Fsjd8oq63XLtA+VkDrRfX9u0Fu+3Iy8eWHSVDtdaO/ryj2cAs+XGQPQEx0XDavt1opSfZ",
+	"xuPJdFWoq6CJQk6VyufmpqZnyuWZcvm3ud3B9x2vrfd2g/v1MWiL4QaAhCqvnJooT5Tznjt55tELdadx",
+	"Fq8kv5bH00UByix/AGHuqFcXWvxD9I0+Rc8uFvSHXzNvqYiJyY7DS6zkcY18wDg8bM3XVknxHsTuzfzb",
+	"YHsII5fKbHF/m0b1yYrC9GUENGQw2Xem3nYQMAWgEcehenHUVC2EEiHjpldIxtceabeVJykcpdMjeQ/X",
+	"4D2iSjLLeZ38/nCZErpbBKI9QVfF/zOEwgr5cr0Vkz2WcaGsQKbyH+2KoXxbfJ/fVo/GzAZbkYbXkSeS",
+	"RLLOjCbrYWpuqjyqIExLOTniDJND5JDk8UrlyTPROedzlXuJbYnKvUbzmakpK0aYorzLdoAMlyQfaWIw",
+	"pgyYWBVFak2bWCs9g4nwZ37+EiNmspc0eCppcuWXubpV7AECkEWRkGRKNl65PSv8CkwpaklhBYNkGDke",
+	"Ok4puhWbfkHrn+E/nddFtqWcFa3plvoMRTBSKkZGyRUXFWlS7Wfi7anT0e6jez+eypIMyuJ+bSWS6UU1",
+	"l6IY8GoZSs7HEC3kMIVPUGXasAgcjPkei+jXDrpSbmS4LudGWpgqM8D4cl8TupcVnKn4KbAByTJPnqY0",
+	"E1nR28azCueNQzjJs+ziv7pYgpYepVpwFl3PyUcIvWHrOAjRqVY36nbzlu+0TH5RA2RE0i8az4IgLkYk",
+	"rMg33We+Zjn/WHH/xuo0P58arQau7evdQP8fLh365nepQoRlociJDxTl+5l3HFFolGKx8fCKILZsChZn",
+	"xmjhNzqHbIHPqCjvho6NmVZ5NOoxf2Y0sBApb4QDhchrFQMB0SjfB9NM/oaHf8hwRhJzGVklT4H0k9ZF",
+	"KMwZOjIbWn9/MxUi/+3Ndznr7hYDp5B03UE31pEcmOO1izLuxXSDJt/+0GIYtCz8buRNYuubqWax0VOI",
+	"AxGtc184rRGI4mI+ix4aNoWam25dj21ECViJPK2Z201KyuKKn9QLBu6IqPILXCdr4stdEflOhgwR3h7S",
+	"W9dQ3EQ/jSX+3G5SChiGR8NV/rOivvZ2oMgwNuBAwpqMXhO5dW43WQGuE38LPqt9z6Xbzfs15wE9IWfo",
+	"yo8rcVBcQVZzjR508VJwiOFY2rgn2zNwz7YzkGmiIkftPebed7xqxzk4MgssbeQao/iPJdobgDaxlwlu",
+	"k5oA9kO09RGQgFr8GdWKGvSNqVzofgnt/Xy29q5IKZ0qzxc4hfUguSq5iznycX9Et+Ne0I2MJjX9N54i",
+	"ewa57OdgKxFVJm4UTk8TiunZQm4As/xCDPQkXAMd+oFno37UdipuU5t0/5PgrniLHBYSILN0T5lusBdb",
+	"mjzzim29xkiLU5uWWMerUo7mqjaVsPwfUZTCI9JRBQtkwPEuKGCLlBLlLX21OgXC0exg6NH3PCerqF5X",
+	"x4E4u/gKVgkc9CVeD9fknRvwRmRRhcohkUJMqBGvqoBGTNSwNMGOFRXJ5JFjhyupySRniPf1EANAcsaa",
+	"YcYH3p02JMXbfpYnlTefkmqoDnEkpASd0QBW5C2KnZ+IMYqiiItPLlOR5VLCBABB3+aXtXy8HMgPfGg9",
+	"Wa5XdTxR3W6MxgFRhmqpb7jWyMCcALQhhqNFZSbUNxRVi5cItbMRbIHbSPEonCtOFUdC1tGAkSGhuune",
+	"8m0Pr2lzDWHOjOVH+tF1apx93/Hsu858RaBspNyCPzI41n1WDRbdgcNwNab/CqAUbURZ8K4euZB5Tbiv",
+	"agAKQiSrQXjvqOXmYovevYhpMknAQANXRgkZC8vzrcgRMoq1Rkur0SsWlud9XWNlyPeyLmBGZjfYo0Ao",
+	"s1LwD3TNrbJQe07t5q6RikXPbehCVdOl8nSeqE/L8WpuVc48qGJxyQPHuac1HNqOVxshrICE33Breoim",
+	"dgT6koE5E0OJgbHcw6BZoqKdj/o5+mkiIRhWHskQqyiGVQ6cOCpi8YppnBmtyh0Tp9N65rXaUlJPVVtF",
+	"98PofOSKp0ksHcvSO0RUTaxuhGEeTdW4SHNih0+6bes0qwz6NDUqdED7U2dy8lemG59CLp38NWaSXPxI",
+	"Lt8zSY+RFxpFwKgW/hwLExxBGXnM5xHThUhdj5UlKArSO6OFXFhgQmdwx0jp8zzMffOOb2bGlWRajagx",
+	"FRnldkTw2WIqYvOPcpZvul6Ul1YRsM0yiZVgVfzdXdkg62u+P7Cto7oZEmoQlZgEA1mZjOuRxnTDkbNs",
+	"3nhQAcUNYfI5Sv6U04UioDRgw8Zwh/a+jIo3EBd/MfABhUm4N7QfT12cgkrsbBeK1hH9kw6XzFwRZdq+",
+	"qaysgrzoB9rtUWUJExnwRC7X0QlBRfibBqQg7tA2LgkkLz8RaIhR9TVKs+wlSsEH/xsu0PPkUe1Tcz2R",
+	"73LJYk44qgovWbxfrqLpTFzIha6fN3hiODAcY0ZTZpa9GEePsJBZhsv6H41yayXgqSnRO9hknqXdoH+0",
+	"11lKroGaSiirkUr6oDRL6cgVVc0xfmtq88aYmJcZiu1bMd4HQ9FYIg1QVrtMOq4oxIxSIPKmHKm9MmQ8",
+	"bY3euIqAJ8pdjNdd7jiTUdXVt6dIJe5nhJ06BpK0gH8sP0UQa9oKvaf4DYDTHjkmqNurg4Jyy15NxMaB",
+	"vMVgK9wI/xiphFsW3uFYeRQ+LuQqrh0RoFvEOg8S7zXpQxnaTx45bgx7sNnNH0U4mrI7KCALa94P+gmk",
+	"IusMKN9YMbttkTobbKKqt543+Iz0Hi5w/KpJPhSxgjit3cO4edTodhTayhfn1h8S025oWYNVdh1NQHyk",
+	"so0D5ijmBkRKvBATf7JamJorsN45TBZNbNyEKqhDThoxk+YASErJ2R4IByldXTxAzZ3WcS5VygX9GOXM",
+	"hwBW4h8EcuiaqvyK+rkRVN/MYhVxpgzKJr+toumZlc40/pyV8AFUPjXt+4+S10jX3CvhzIyt6Jk4LJ2S",
+	"UJ+AugOF5uyRamDmLB6+KHqty2ffjqD3sCeySRJDp5HF/c/m4oSENAh6FvYGDAaW9JTS713035XvGnlX",
+	"ZO54+BCGe/QoUctzOCxnsGsyE3V0riyLdYBgbqxVQxKPVDqyL8qGu9YZXl581gA/p/GPxeqGRkTI1dw7",
+	"34TrEZoi5OKAFwxgcHeshw+5bHn0SF3HXLDRnQUTD/8Q47LB0SDVJbsdnXjMRi0/cvy8FNALs/JnQL4g",
+	"p/RAgB+jP2sVO4DuUZMTAosa5gC2i87ou+VyDm0RKx8Nbhg5GWQ76vWxxRJCjq+tF8/BUcpIgDc3ySO7",
+	"S0X+mBucgSQ4Mp5TOmVS2UOaEpeeYxYVQUi0ShtRTJ4ltiapR1J/dTlNf6RUETGc0WofIQ84tg6cFjGQ",
+	"aT7tJcfRdjp4MNpEcJyb7gPjXGRmHdWyQmri46TOCCjRhixFxiXy2mbQZ7Eqdq0Fm+GX4bqIA1DH55fo",
+	"5CGn9w4W7rL+94lWvQk+yMOnaVbICNn71hnesUn6godA9VPpn83l6xhl5xTGHbG/NWViRTyr6Opp2/4Z",
+	"quGmxkFZtbFSKyFz66BRWwGZaNS2/cmi8EAdHw7ZUQcd/RLMBrdyeVAmls93fJ1x4ntiXlxdM5SspR0Z",
+	"5VnGq8jdp+RQvUiyk8cM66HU3x1dU5uEcDZ0qCEiDtMd4jtxBuVjJ1lTUrpPP9g22BcDfXIQ/JQpdBsc",
+	"sahofX4HMUfxQ9aTaIUFg4+tO8SrTbI5RNrIX2m1qJpEMQOFc0H2WIgGZXiLwaJi6IRV7qsip2/YvKj/",
+	"hGa7jjdjJFwJ19jE9lBe7gDhPHOE8IjwLgYnNsCvBQPw4R9bKklsAbnBXNQvnLxeUgnSAUyJYuGL0l23",
+	"xD4EDNKJm/aD6wK5UHxbat+rtUp069j1EmJUOx4f9IuS2wAWavnL4ugfRQeN+LkSYCjhmmZpyMIzLM4r",
+	"b7rBdxajH+FjPgWl3QODJFYaauWekCSpMpSxY9zlY8uRwcrnJyj8N0d33RgcJ6ZLR5QxGCTVXuRwMciq",
+	"N75bRlaeObvRj61zxsgbOFJ8/Gj7P+giBXd0GH5tp9Lxav7yLaCDFuhyq/aRs3y54y8llqtw+cZs1HBW",
+	"NEPDO4t3c7kyi/h0hZnCkmNXkXdpBQu/Ll2+MVv6COHn+STwXTDh9x3bczz+1gX86wN+HfzyV3OF+H3/",
+	"y1/N0TW/Q73X9EmiolOwQvkZv3pvfmJi4iy2fIWZF2bYOyPalny/VXj0CPEGF11KpG/6NhUtsfrcQrvT",
+	"arme/8+MeyYqbiOaMLzzFv0gcfkXbl67NUdUUSFvElMhlsGFaArhCs10i3Um+Zn6+Vg33LZ/13Nu/Y+P",
+	"MW+h4jQJFpVRcn0WFrDj1dm82jOTk27LabbdjldxJlzv7iR7qD0Jv43AjgtzbtW1wKfEwEEFkCgDB0VT",
+	"zmnarVphpnAOP8KWrUt4mCbtVq10z6GWy3e1ySbfYDQJ4t9dAkkKV5Xdov5oMX+nYbcTSBWU4SMkOep+",
+	"E1bwI473GC+j9ehNmEHMciwVoricnMBeCQ555WarhZnCh45PDEPNE9otFxYRpjldLvND41CBkIyaDncY",
+	"fEYCIF+rY9gFOpGJgJx0FUiTgc05X54yjS7InfysaXf8Jder/d6p0kPnsh/6wPUWatWqg5r7+en3sp+Y",
+	"c93rdpM3ncEk/gvlcvZzs3CJN+06YZzjUznou+V492sV57Omfd+ukXaBj57PfvRD23ce2MvgsXM7TFJ2",
+	"Gg3bW+axk10BsPAsdlp5Pt7M5ygA8GjcoUxT7fEHuwlMqGBHc/i5dAC3P0YJ1gjqm7Vli5rgdFGBGzCV",
+	"n9oXUU4URLmuzBYlpCxKXxWyO1w/O3G7KfNENJtughUElIRqY/V467I9GVW9f0l5kxU+QVLR1E10PQSi",
+	"wydBP/wKyPkLp4Dk3loctSBh4xnwroB+BGbdEmhXwdD65a/mJm43E9wMYlRmZzyn77vV5RycLOPpSq3d",
+	"IfH0IiaeTs2VpcRTJpkrtVLVgQhf1H2cB6hnPMfmEYP2zAOvBp7/R8WcIkPXg/6Rqi4wDTgmtaaOWGrd",
+	"ciqeo5dbf4k0CZFRQOInh1B43xZeyrGYOw4xp7b5k6WSXsQ9KkbX/eTDWvURSTvQ2NP7UvUJ0kTCTSQQ",
+	"WzKc4e0RYHcXwOQvSdmtYHUNo4d5I0QFjzJcsTznvnuPx9VUpr+KJDK2n61SbrXdcHwEYvg8Wc9nrhER",
+	"YpPrwKAIRczOoCVk/pPZORUU8k6CUc+nraqi97zhPJXnqH7i+h+4nWb1bWPC76N9PCgbTnquz/MwzTrI",
+	"Fmc+qVpuX/Y2RNoBXOSrmBY0sLDRnmjT0A/2ihJwnACAU+qBgXu2WSr3INxA3YNQ15KvOoh0mLCC7xNa",
+	"/7akUr3kTR256oWsLGpeUY1AutL0g9nqTVrWkyIuyq/uXv9Of26k+Y4F0sk1fkzMo+y1YhN1zZJqCZvH",
+	"mL0DP6L9sSUCvFLIKeF/4kYEKgf0McgI0g76wfaElexGYwU74f8GywCNI6FA7DOg4RgsmNx9qHi7iVmF",
+	"zOyB5s0CWYY7jFmDkHCduRWgqJ9yHX4mpwJYcii0rCg6FT7RiZ4PHZ8a7Rynn0FpE6T3NEjGXAzijXIX",
+	"MR7FE9uk4/rq6eMZJ3h88IAEL2hbNGeDRL9sZe+H64qLlBqMypygdIjC2MkWAwLvhc/wSpIPqMQDbCOJ",
+	"A2L9N/RskOwyMkg2/A7Xixb4C+nCjHoOUla77EPbC9dMJvPXupM3K9F4jMcv1qok09X1MrkqY6fXK3J6",
+	"AfMEA+0ehBvSWb8hQK7jpz3bRjT21hliMAtz3Jk3SHsW+sUEKjF50JmCCuooy+OaadhN+y62Y4XBX0S4",
+	"qQZ7UWKJw9mMpgV8LfbjD7pVVAGch2Pl7URbk4xvOBvzSzJmZ+r5KT9fT9qVitPyU4zOv4v7aJDSLoup",
+	"fKy0f8B9zzGuZmiSSioDaSg4aPg1u/xNvJa08hT2vkxzOYlMfnSXcwwwX3M364WHaEm1AYsxFh4nVwOI",
+	"9vEAAqJeu+/8Pl3BxeSocIUYN2ZaDMijO0TVb5NC/jzO9JRk1iWlh6vFuhZT7LwfrhYJt1+EmljTo305",
+	"Q5BsNsmo1SnDH+NMXqcVpi5W8DPE/V65qRUjYj/oS7s2jO/WiKYUTolZZwOT5dRwJkWWjSm3YIC13Huj",
+	"mUDxDkloPcbQQobBPtnzUqqoKfl3D3ycf5JtSyrikuKXXcwalG4P6CguN44P1+GKg8t7wH7WxXF1J/Q6",
+	"5hG1My+s/4zQMVjfU8m/AuujSerll9bvOo63LMUxJeib6IAl0+O16N9UKRzrdVCyompm64zwRYsuZvAH",
+	"VAYXLQ6oA4XFqBlQ1qtUhIyZqOFKsDdhsQblqKM03PvO2dtNpgNh1vHE7U65fK7CXVf4l2NNWqWUb8/g",
+	"2sXQGr9Ox60bynksG5diRTQsvP9Cg4SnNJ2E+d9uGrak7Xq+shtVZ9Hu1H3YLaW3oe3DPVOYKfzPM9EX",
+	"/4uv6v8q/T8499u3Jz63S7+/A/8pl96bv/OwXLw4/ejsP+iy/jWZsqu8bO9lMExfm0GwJ1aGAYj2qOBQ",
+	"uBRnNBnaL/GEcIULk6K7lPjIayepsQ0c7mCX6ZNK4AC5FNItGp26X5tvO3Wn4scQxyS0GeDwIgJm4vnB",
+	"gVgDPjicm+HjyKVn4SxFWQuhCwqozdhkcEudL1p1tyoyYnU7jPuibLG25AWrWKRd1m3jTCGjEEaHF72M",
+	"GWKQtV3QbPlfMSllhaU/M49gKqKPDFekmy8H5NEc6QtlNUM6q8VeFuaR1F+jizkzsEFfBgMqlNbQxkCC",
+	"tMRlJGsfq9ouMKT0gRrl/tKgOYzTMd4U9fvPcZVEt3OoqbDkbkltInUg0ppqDceTtCadAoE/Oc5zGRUY",
+	"aw7mt8GWcA0ohee5U0HHVuObcWz/SrrYwLCh8iGtNRxrDpClsPKADqtob5ztLdb2Mea5QaLtf6LzndC2",
+	"2CMTBoevKODM4+8dFVw2an39Wty+vBpIcfSOo/Qnl+t+YvvInLx4vMI1idmuS33DHxXNF8EpOfRHd29J",
+	"rdk19xZjpDHnnJ44ZybvtGy/sqQHOTpAQXLy+rkB459gRhwliT4fDxoQFXKlt79iSRDvSzsWDSdXNHwr",
+	"djKfaNAosJMLHa9ZdR80zT7r77kTlhyihi4ssW73Qt3lrmr4ZfilJRrEMn8jdli0znw2d+UstZJT0PCi",
+	"YbYoTtI3gK3jMMJxyjpk0pf0neQWY4N2LXKvCOiwCQtQcKMetZGG/iIFpZv8QxT9FSVOEJJibkLcEHDk",
+	"UTCW+52wFnCX0obB5/dYoCOgFcI6XsK3kJkntculon2+IztRp7yeuqTBFg8p7XIjI+arlTtzcVPD4L6X",
+	"ZP37/LyMlS+7PSlWQydx/x4Mw6dU476FftbnzAO8F64zrzPuVbA3lsEnVgb/Sd1Z6tz7HI501AwzOtq5",
+	"xXJGHFHvWuhZUrwp3s2URYV4FOXsRBan5wvZvTFsPvb3n0B/v+4cj4XhaQgJ5JJ6LZ6SMxIYQyLFvKco",
+	"ZeETgsknkEpW2J0nta+Iubis7lvK1OvJWuueTmyK1KLjT3fLn4guoV2OI2CvxjWjHEx96pkRdyECsSdg",
+	"rmBTHNdo1EtSaXp6Riq3jAC9pYvXMZxmAPvas84glP9ZbZ6pcpQPCDfAbiQsbtrDnPjVwog4ARxD8fUA",
+	"BbC3m9PMeKeLMU7AG48TILOPORuU30TZUT0WyZDLPPhxCHqow2EFFzUepgwfKjuWagElBKUJK/g7+l43",
+	"w7VoRKxWjpeCnOGXkkX8awoM8qkdvgxEJK+/zvIPwWxSLHCsI56WSGAmdxYNmmE8fVX0yMLK11g9Rrim",
+	"vAn7kDD7imN1MPM1fKL8kqEXrlCCB1mLjFHPnC+fP5umDZ4w/iu/8usTk4a2aQnGHH0q1eCcN+5khLBs",
+	"KLsHIC7q/Sc115YCDgq7TFiHFA8T6Xz9AW+IerS+sRPM8hJUv9FC/YGjU8cgfpPtueJt4Mey4WTLBlNa",
+	"fV+CLI8ffi426GRZjOVSDOg/YWmPjMjHRQXhb5EESbC6dUYUXnWtuIZtmZr/S1FBKZFPgYtX4oYm8J5T",
+	"IVOOPqGCnACa/h+v2BEgUWAWaeSsF4fvdBall3PIrCtuc7Feq/hvm5CLJA9XgOhYpEgxkwY0+RD/d35U",
+	"LwQ/h7KfISa4esIfoeRqQGeAUURiptOB5oj/PYbUsGOWZ8WRTDS6CvRU8H08dhcJ2/oxJsYpdZBkypJ8",
+	"GZ+sQkd0kCjGKgAhJ0UAVG2GG8GLSF2LekJG+k+62iRwPxEsfRC8VJvTSEmI++E6pEf9ROWJPI9NIqyY",
+	"qF6Eko0n2Ah7wwo2sQMdR9UaKmYhzlKCUASfzXtntboYrOBYhh2PDDuuhNuD6oflV68fqkm3Y+3wbc7S",
+	"PYh2mAuU73uEqSCb9I9kXkfQ910D6E/+AFSGU0zF5Hsb/d5HCBc41txOiyNcg5JpcnXlSRP5IQuWDKt7",
+	"dHBk4YrShYu34hOJTpTaHjzHDh2M9L0ULEPQ2vJKDiv4IQLD4GmYL/XwXAPTN4lZAYbG+fJ72b61EySa",
+	"DphyQ31JC0615rue1Bd45vz0iJk30Vq9Jp9bRMAIoG5KyGCsXr11KHB0HlYUFUvXfK13oOhkAzEG22kI",
+	"FZGwuc5+/FbH/mkRcqlBmsuqN1aCTo0SpNveXCqQkQ0nH7LbLd1FnlM3uRSlBmwniCVU6Z9pBOwgAewq",
+	"gbfK8K4MAC/4TnI4sZY/rBWbEE7JCsZ4nm7X7KuKO9yZwPms7XhvdMrRAfxSCQmup4prO8fsav8pfjjk",
+	"zT2FOUxjtSPFq5Pg66R5YjTtOv7B5dWEpQoYCBR8pcm8OrycudHxx0LmqIXM4ey7ittoOMAI+espyFWu",
+	"qGWvyVmeDdj9NxZGOu0YFWPBmt9dLmKLeeWrRm+M6g5TCg1ZEXUsOZGV6XcJmU0Gp+CNO4ZY/Y2o01R2",
+	"LcKPx52GKtVTjlNRkzA4RutTv9djm/PEygzDhpoRsjJKMBU0HdkGzOzeI/khRecSBUk7gfZIxZg894Ic",
+	"uGcnMhzaJ5zzjyth9GAIXFOvGIFL8VWPxc6pKTTNgb6l6iWtut3MifGSKJkAdmWZBBCzY2AmovOQXE8x",
+	"E+EXdFU4q64Av5KsxShJNFxBqTaABNFNfNEO9aWk/AIljRQcXzLFhGEfbBKeFuGAYWtKaGiybp1p+/Zd",
+	"52y8zwBP4kIIBvZr1vlS6pRI8FpbQEnwnGFisb+CrgHDKpKdN2DVx/pS3CzDZdHnMIHHYH+sLZ0eD712",
+	"QyOxddVpOc2q06zUDILLrzUcs+D6FmXTcxRbwVB5DWhT/OMIU2VbUxzfz7B9YG5jLk5wMS6LVvlA7+Je",
+	"Mv1kzMcnt3kiwLnsE8LgAG0TatnEE2x6pj1Px7j3HLu6/Pu0FthST+PnrFNjT9MH36JON8RlM6y1NFzn",
+	"W3IfGqlrGmuLthIV+4qeaMC58KvHCOg2wK7+a8EuKdPY5Ia3hGK9kzE6g1rRY9Ahgn3QUL4JhvKy3PXs",
+	"irPYqVvtpY4POI5M9+FJUD0N+tSF8jmDinGT1u1NaYcdbc3r7XqNe5H7mIzYl02My0sUzN3ZPKflen4b",
+	"L6/2kuP45hMuC0sFGxDAOovCDQiaLfWDUhPrCD8bUVYJ2pYeiRVdFXkXKWwHB+p23nZwxdtN3F9UeV8i",
+	"ZbQsO6LPGL4ec/qMLeUGsZt5woLGSbb/T5X2feJKDkaF1+MqNMGjjOLwKQuIW1du/YuRGXC158RiZ93V",
+	"P4gmajIwLWHbDtAw6BqQDxc9t5F6KdO8CjMFiITk6g72QwpWrkKSdSboyXHAqF6GNr1vsUK9Z5CsZp27",
+	"eNFi0TjqGm0x4nXz8t0jntWPhPoebIZfhuv68zOQG30t2vW2odPXXc/ttOYXlvV4k58XqvZy4U4xav/l",
+	"NDsN/jllJ8KBIJ2lcCdBfKy5V7HQadZ+13FY8y/mxsnq9/V3GeL+hdGaNqw+I44ifgcOPiZoeEmt/jNj",
+	"jzFqouBjflK0vEKHRrtrBbwFimKv2J+V9n3NDh0vZqiQGiC5fecLfxKoUJ6P06PDX+5iK53d8NlYyz2x",
+	"Wq60i3EV97mkzeGHgwzNtu3bfirIDKGqD0iR4OjqcdA29eIGp3/uPq5nYEzIHOpZH16bY70/oV4yZjPL",
+	"fU/xlbebqtACtWNgUX4+jrlnlUjJiIOd7hGmPBi8vCExNoYc8kZtSadgyYDyn/wlgtzRcNz1yAMc4Vfo",
+	"McSMuL+BK1L1ezPlf2DlaCYgtbLcpyYAkmWjaQkLE2B2BqhgcMVOTPgu6WJAz4/sCh9YJf4j1kcTnaZF",
+	"GfOVOw1exEFmjR3iFZIGwW68WJVaEqxJUwzXDFrULTywWarTm3DRadU3Wk5EW8NTvYancRfZiHrSnitL",
+	"+hCFwKam6fht8eWETga+a6Ce6U/HpOzxCRyJmmdeBbVXRZo6eJh5/jcgict7IlaZNeqAuQX7EqQNRjeD",
+	"YfRDnDPPvdAeJseruVWDfkHKn6oKPnCce69avSCu0hquyRtgrDqc3Pic7j4X8lHSFehAkI6Q2cE96RIK",
+	"V5haj1WdSYgVnWQ/OQ3RR2+jwCf9NrVQGDeSHzeSHzeSP42N5A+miUi52yTyULwxCYMShO6Zzx9Kkpl7",
+	"3KRTPFOYLk9fKJWnSuVzc1PlmTL8328LyfieheL5OWw4xUKYOQhJEbuYlVKroiBlSxz8BZ1/rHBgj2WS",
+	"7ARD8La1qhnvf1RU6Kad1JM9PVd+z0j2t3A8o9KoIfY8Q3loAQRRuI6X9ocuI386Iv+bcF168kM3jeyp",
+	"mXOMbNA0Xd+uF2by10WndrBRAB0iGY+sCOL8K0r7fSmq4kSd0Bj3/9g7paJUlC8gTQv6vA056FiuhV9L",
+	"A6IGZmHZMYL+g1DWZm5ybe/A9R9JboeELGoOuI118HuIUs5UiD0UyTtAOVKk4/nwCd63m6O25oC5HHl+",
+	"pTTVEcThdJo4HGmBQLicy1inFAEzHcnFEYRKRkusblyKnKzE0XGNS97EUb1o0cgqYZ9OSkxyBJZqlgvW",
+	"aMBekYzKdEt2bEW+0Y34flTPRcZxGOsvr0h/SexDtj4TyQhI4gR75ygkRJ6uz0YpcYMRMpYRp0dG5DoQ",
+	"YznxSuSEYS9GkRWjtj1TTCAIjspGULieEAWEWYLvPQZAXXWWr6VTWZraLiNzj+NJpwaZO11XL45w6eIF",
+	"tx/sYB35arCfsAWYb7kf/lsKH4RrCbbjN/Cx85yw36dOVNVELitcblM25t/TdHEmOGz2qt5D2DFCsUbQ",
+	"+MCy0AcErXlxU8ZbB0LppM7UT0DvnNy78oAuzqTfL+HTMznwWHo9gkBSKQBiuaGo/tLoAJWDHphkNrJD",
+	"lLB1RnaIvmL5FY9FSId0DK/z1hWNic3PpcWoFsKkXfFr97Ewx+RT+Aumm7KEUxYYpvI0MBw3w3WUSRtq",
+	"WsAZteMftu1QIaDwM8hzwL+6sQTZs0XuRF0nLGAJpydFJ7rMZ3OiZOxRZOiQId8Ptse+kyOTxfw0Gf0n",
+	"/8GZwsL9gow0uWxtrFeeVJmavrEmJ8wVwvLTSVnftytL9GWK83aXYIlEf7d4G1V8LxV5vWClXUZJKL3x",
+	"hDtnjpChxaLkDJvIOzJu5HGqIjFxZtOztMxGKZkl/4n+hF2e79nnSSaUc88AxtCgXKzVHSv8AzASuFUp",
+	"fa9le/4k5KaVqrZvc90qfEJZeeGGGArlwSovzcF+HdiKDX4O/Bo+xoZBezxncxi8ZOT0g93YOAzfmiMX",
+	"JkfYY9gekJO6S0tlkb6HCxgFlrDDMyUmPkajRIQOoK4Zv0AN4bHFoBWpSuIpjbjPVgfqWrZZwXPbdz1A",
+	"xm3YX8zDis23a78HnNz/l+UvKuu8b/26dGXJqdxrdxqlW0v29IWLWE+dmFAfyx84rhCkbt76xeXS9IWL",
+	"VBy9g0dCWu7Ufigo3WceeDXfgWRe0suoXV5RgL4Z+picdBldNDeqomhBsC2W1nA0wz8QyxD21ZLzBady",
+	"ybGrjhfRmdhbRU+UU0XLpffs0uLl0gd3Hl48/0iX65vqzNBw4kFuls9addeuvmqcuuj9BqA6+SJTFImf",
+	"T2f/uqkcr7hhL8Nezbnux7Z316HnLuSZT7vTarme71SvO9WaPbfcooffogv1z5ImqrtSlW546i1q1o0n",
+	"H0Z/ZHaD+J7dPl3RTEoOaLJqN54vX7S090EJr2LmPhlSad9TFvakm4hfwlR4AfEdKjdNjhXXFtUoYaKR",
+	"6YC/64+yqrBKxcQ/ZzTjOujlowRtpV2J/jlbPelX0ajkxTRBPYnKuTzuyHNcWo8bQZ/OcHNegVk09qga",
+	"c/Kbw8nlV6XMxS++xLzHAuLUuCmGWXt9eCVrUjqzJnhAjbYjwYnpXBPUH3RLeq4nwRCQ/cGCtYhSZd20",
+	"m3ehPnVIyH+idf2+QEEWgBhbWJr4Ev0KTHk7W7zdnF0s0SDBwJpdLH3iNp3SdegCf8m6NmfftUoZNqkB",
+	"sSNdxl5hizcWta9R1P7j5D+q0lVAaSzUmjaGmrLBpXSnHPE4d3nD3UKReSeQDLbzpau1Nq8/NrE/e2pS",
+	"98ijYgFOZ9aj+JtHRY07JOPB5AM4+enyxeNYRDALOVhQX0ZOVDg2XDewoH6Fkat1FiATQfv8wMZeY53h",
+	"kUgZhTz+q6B7VgegICY5yv7Ar89pdXqtDCVQ06g9DjBVuBI+s84o8uvsqXPOXMx+ADf9E9e/Zfu19mKN",
+	"X7JvV1HZjoAlfaZ3YBxUH6iATKjX2im3/g/hGmawIth/T9xIzJexl4hNDhLNkyx08e+Ugl3mUklL3bgi",
+	"KBqHK3l1rlgSnaj9L2Vt1WmOLYBT02z3aco2S6kH4qikRCkFR1NMbxOdiUpYsMcT1/vhl0nunbAO6ZFM",
+	"C4adZP4/ru5HYk0A2uQ1dUBSaDD079cdq9PYPvctkkN/EjspGtHyXdbd6jo5ZFI5Jl2v6nhAoCELX1I8",
+	"sKhmhV5DwiryISTSowBRaL5WbV9KZCcYchgUQNBogkqUhgFpszxr8k1QVOl40gM6CYH4qUcB8bFUvOng",
+	"0REr85py9EfTy3qWiC/GleaxfDw1TW1VBD9JmsDWH15kPmSyLT0kfihplB4sVtSAty+0FG1nV7uZOoJp",
+	"x447WBxpX3KYeCxZTkuQeHS9C1jTryxpzspf8XhHKlS4KodVlJxK7rll2JbHYPoBjWPh8lqFy9FraFQ/",
+	"eXC7tfx67FalNG0sO0+RVnZ0VqtQwSBgft/x8HzmQ5qUq9LBLU4NPVbCVWYTSPzP+lRIBX5UCw0IEyxv",
+	"fkPTczx8zFF+FeDm7URVJgXueZeRYbB9u6ltuCETdZaaEap4MS8V7kkuL4AY87DbNiUy9MPHrBKwH2xP",
+	"WPGSZnJ5ruM8VY9kD4kmSOVgwMRoV21QrHThFo8zdOpUCGfR+QPIov5q2KmYVUAk13oFK64BzTYaCsX8",
+	"XtQGagP/KFnny+XjLiOI3Z5X2OkcX6KvQUOfesVAAOPG86ck7IPygTCEVpOXV9BTLpGcVxcvRx21tD9Z",
+	"dxrz68MNUWS9ZNQSb2yPQx2xwjXZB9vNAysIwqtxKuqhxgX8b0IBPztN+Yp9dzTM0A96Y6F6erJpd/Ty",
+	"LrOa3xhR/ztX5EFoXre9e9ByesIKfkItcI9YRyjtVCFp/fP56Vh9rxzTgnfW3GZb6tZh7vhLQ8Y7EedQ",
+	"ONndYFI5ixb7gZOtfZ5IiX0YWKsFfKIQ/LvoVD204L6jG/wZ9GiGLWYdcPbgV3DSyLJA+PrdcC3YZppp",
+	"fqx+WujXlQZAb9eKUZ0asT1OBjjFyQA6QbqdCwmFK6WTD9m/soNaso+6y9smXbKYbQdACsxhQSJuiLpn",
+	"ohY06KGSsyJMeP19v5cRBmPks/89+b7qb82UaBbIlOkf7eVxh7v0smYc+jqNoa8RpExmieSYcV8h45Zf",
+	"p9ohYfuORcHptt2MwkCfWKjXJKBUEXX3nzlugwjPGGGYeor2EQwU7WMiLalvLIaOVQwdQyb2Acyu1yr/",
+	"xrHsUxrLPmKja3Kp1vZdbzmtAiwSi1hq1g8fo097IIewu0WO3ft4NLDehCz8BSNoLBJPjmZ207lfa9fc",
+	"ptHH/q3RSh/LppMrm8yuF+MZzyWmWtwJZEph/AFH76N4SfYtosijpnXSWvg1g6TYof4f/cSvwnXqMDrE",
+	"nJDH1NiBJF8/1nipaAW9ZNMXVA3Pl98D7ElaBvCA7llzl299NP/+x59e+ejaVSyTxYwTo7xCUE6MwlYd",
+	"367V2xMLdbdyz6nOLyxfshZdr+L8E0gBZQLaLk4EncEc0LBPiLOOqS1ap76ckcm34oTL4m+kPU7C4xfB",
+	"SQ0lQH0p2GI4Awpym/78GKKjuGX64Chri8EmsuC6dcduvhEdcl4KPiN4/iRPQV7XuM/EW9RnQuQN6nrt",
+	"6I9HZvuJqtNymlWnWak5KXkqf1ZE7w7F3Zi6meDXVRRx4ZpCYNGKBXqT46CQh2rAtXDVOqOmxlOcdYDQ",
+	"tmoaYrh2NkXFvSpPb4xrIEkgZWVM0qgXDFgOjRZ5f6w/nqq+XRm7HckS5eykZGfEWr71qT/Uz6QpRUDm",
+	"McnVZYF6o6IYdC3SyLz5WpWlMcdoD58ViXgQGDsIfPbHoAsVN18iD+/ebp6BskBKACfIWYheJQBiVyOU",
+	"9m0L88M2ww1ojqMFLIe45vnye7kTjmOZIwzvXQi7aB+SOlE/lluekSVywgXhcQE8iGVZfk1pHREBeYUw",
+	"sEw8HXTcb+xtTgZJCAddN0SD7E5RBycfRlL2CMqeE7IuPdFDpvN9ouNUB2uyJbyewGiPXkU/5qQsGrdi",
+	"Pp25H0coVBrufSetVo9hywwS/rMeGYUKkswZVUOTK8O6DLMWvYOoOMrfDoNeMabe9bC76RarRfsSC+1E",
+	"Ebb82z6U6S04i67nzNeqVjCY5Oi4lGOa0BztRZ9rp7xlTriGcukFNsxRrVsBa7OCo/WDLYVSdP4QAg/K",
+	"117wEjYnamu9g3oyVMuB5vmDCoTR4/IvCcojXgF6a9v1/H/iqKiHLjdP10avw4l4a/KV+WEozJzLnWwM",
+	"K/Smd8EVvBIVr4wvgJPsE4h2c0XrZcx2J3pOo9bk8MF6X+J3QZc5t+XiDBbPxsbehBKsr7kALGFFMl+y",
+	"gDJEZGWF2snBmReBajXC9YkUh+FNMYGxt1CAftGSGCPM2h0dC4ITKwj+GgwpxqblJZX9JJEQsU6KS1Bz",
+	"VtChT67BPe7Tt0iQzNu+6EPwIuhaVOc436g1O77TRj8I9ZgaxipcAWuh2nHmbf8s9JiKQQjiPwA0IdgK",
+	"euFa+ATUJgivYIfZCMSB14zua4kmvS/Y4hrSC2qi/Zi3YFDIuaSoivzipPd1lTlgQwX6pAt2vgzJQLk9",
+	"KA+Lt5vw4Jo6aYa3II/3M2JWr0pOVf4tkbTBHAkrCDL0vS54vx4+kV/TDZ8wkRpfmD71xPyOwkIovwGH",
+	"3pou6xdxO4YMn1PmZ2iXJ1mGH5ejk6/Ja3Jz8tfnvT8Y8lWEOzpuvXXyI05iN5lmqRWrhgvFpGdOPuT/",
+	"THopU7yLYmj+j1PtW9Te5HqapNU8bn+inu3HvfZOpz/xEMzu1xpOyWn6XmaOCvm2wLPHLdlgn/5cCfrh",
+	"E4OCgZ72M6RYheuRftYjRCmK9n6JY6FGNUCwrHBV5Nn0xQtjjczTLE1YtmtsUmNbk/ud2KIsG43NtG0e",
+	"56acCsERPmV+bnV3X5pNTxjJmvPsyj1oGZVifv4p3naDZ75AZUXUMQu5CzgLxQCwncXMsSGh4uf3VoGs",
+	"S8YSzoDrHis7hlL2tlQ7FG4kvG9nJ6zg39C8a/u25zvcOlbpxCd2sF7uKTX9k5JT4OVowK1kmFAnWzgd",
+	"lxElpNNrsqLE+9Ml41uRIfKWtXpnXUh5xpxQNnoYnn1KkcAUiZiiUk2iOEkJ0OL7wzWCkSM31CrWeEOk",
+	"4PGIwlB15FjBTyk/H5DrijmbZIeS4jaSqQHc09tNhT566xYsFPxczaroW8Iu3WfsMogDoGKwdhubwlsI",
+	"JQS/RTFLoGz5ZektXOmxQG1P4krAysgeqdcnO/8un5cX7Lz/8TQW8I4z6zIELQg6Ef2UBcmBpavbShGu",
+	"35ukz5EJ2UvW+fJ5Gc0OOrOSSFVO+kRuIea2xhbrAeRKdNEMx5hsp6AcS97NIxMXD+Efy5kptzHvGtVV",
+	"7JMyFGWWhSsI3f61hHAbPptIT7yVOB3P9an2jL8wepP0hPHNeRW5ttyWG2fYnk6PuMSSybOXU3R0mkdW",
+	"s78f9DWhf7IqzXXqn0UEjFWCwxV4azfgNBZ5v1Ud5IUnV2r7oqvXHlD1oKHiUkm3dBqtuu2nxcL+AwHu",
+	"qYNY+FXQZdn8CLGhtHDBYFbsB8mk+Z5Sqx0+IROCstXxR4NwVR/qEqRmCQcZGi5GEOsF8zwYqrR1DXAP",
+	"Lc/9V6fCgHveBHHAFsEY0vrv2PrDRNWOQ1+/4SLgLeLo2GbJPTUEs4pTnxKJ+jEObGihsxMxzJVAl2jn",
+	"IXHFhCXTkaiCiaqso/40rFiLoj+Y6jgIdqiFiAAP2pN+Tz4FJQQVDKBXVbwb0xms/OBpmqz+o6iQy9MS",
+	"I86E5lS7QkhtGz2okvw4xrAOe8vriuqw12fJBnlzxo6Dk6wTKCwp8YlBhiiXPur9OfLc+O+P3nyXKTZ2",
+	"9jp2C1lhjDH6+Omzj3MwRgro+Gk6/uVXftWMmejUIPvkYiM9XPefEfimHymoilES9IvUVRQTE+gTMCdN",
+	"aqzKN0r30UHRwmyovqLiSB2wlYcRTIyhCSnttPW43ydcEhxXi+gDKb2vXhKN4bRPK5z2gRTfyVqz7dtN",
+	"v2b7Tv72z5j+nVMwyU2TNck+kCpe0vUkjg3CgShYI0dm6/KEJeXHUMkX2cJ7aiIzw6JV+y5bcq22EJQP",
+	"H4LMefQIqYt1rEbA25i4pk9fBN24HIUP91muKv4OaLjdxIrJ+7ZXg0PRvpT4lcVzWsN1MW8p8T5GcPgk",
+	"eSP8yOsS5ZLGcMWCSk619PN2EzNxV/Q9qwG2DZPa5m1fvWhiba7Dr/O1uU53TsxWZ6VTOb5kQL5LK/KG",
+	"uFeIooqTEZkZKDpIuD6+cU6JoyWGG5RQKk13EIzpVDpezV9Gdn7fsT3Hu9zxlwozn9+BdIPLrdpHzrL4",
+	"5A484N3Xs/9V575Td1vY+5N+VSgWOl69MFNY8v3WzORk3a3Y9SW37c+8W363XEjmM9zw3GqnAn/oRmjP",
+	"TE7ardoEw5+ZqLiNwqM7Ym7J/DMmmlmD4VgMCq+fSNBQGCpJUvB9sMlxuqV7MVwvJlzOiL9OvxoEz4Pd",
+	"oMvqLqgkkb3oBnmKte8yNM3eidGNr9mNAl1wg69iet0+x9WM3icw8TXv+0/M6dmFyTCyd/Aqe0mjczMl",
+	"/v5o8Mu+b1eWjOMbgHb3sPXHVriW2A9aQFi6hO8/eqmK75t8638pHfrhyu5huB9bpapxDb5GotO5Zrgf",
+	"tNmIzzBnnFdzk0ogBTYMpF/ptH23YX1Qc+pV/WkT1XgypUUl/QpmNKBPNokW6RgrSRWPinlKUxMoBN3w",
+	"iWgmEOEthE+i10QljZpXxOJIQjcNn8F/tRsQiSUd0D7oWXQ2AOQLz+k+bWwsoBsNeL1Wd9q+29SPiLhs",
+	"qJHgieS1j2w4gL2IMZS8xLd828S9u+HXJIOZjrjFnl4LXsIbKFQk1TUpjEth4F74BEiQD83lG7PWR86y",
+	"9p0/MAAQGI0AMHjkOtwQoXdWQ4XqcTTsLxy77i8VHt159P8PANxRPFNjMAIA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
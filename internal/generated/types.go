@@ -4,70 +4,1123 @@
 package generated
 
 import (
+	"encoding/json"
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
+	ApiKeyAuthScopes = "ApiKeyAuth.Scopes"
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for APIKeyScope.
+const (
+	TasksRead  APIKeyScope = "tasks:read"
+	TasksWrite APIKeyScope = "tasks:write"
+)
+
+// Defines values for ActivityItemType.
+const (
+	ActivityItemTypeAssigned    ActivityItemType = "assigned"
+	ActivityItemTypeComment     ActivityItemType = "comment"
+	ActivityItemTypeCompleted   ActivityItemType = "completed"
+	ActivityItemTypeCreated     ActivityItemType = "created"
+	ActivityItemTypeUnassigned  ActivityItemType = "unassigned"
+	ActivityItemTypeUncompleted ActivityItemType = "uncompleted"
+	ActivityItemTypeUpdated     ActivityItemType = "updated"
+)
+
+// Defines values for CustomFieldType.
+const (
+	CustomFieldTypeDate        CustomFieldType = "date"
+	CustomFieldTypeMultiSelect CustomFieldType = "multi_select"
+	CustomFieldTypeNumber      CustomFieldType = "number"
+	CustomFieldTypeSelect      CustomFieldType = "select"
+	CustomFieldTypeText        CustomFieldType = "text"
+	CustomFieldTypeUser        CustomFieldType = "user"
+)
+
+// Defines values for HealthCheckStatus.
+const (
+	HealthCheckStatusError HealthCheckStatus = "error"
+	HealthCheckStatusOk    HealthCheckStatus = "ok"
+)
+
+// Defines values for HealthStatusDatabase.
+const (
+	Connected    HealthStatusDatabase = "connected"
+	Disconnected HealthStatusDatabase = "disconnected"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	HealthStatusStatusError HealthStatusStatus = "error"
+	HealthStatusStatusOk    HealthStatusStatus = "ok"
+)
+
+// Defines values for ProjectRole.
+const (
+	Commenter ProjectRole = "commenter"
+	Editor    ProjectRole = "editor"
+	Owner     ProjectRole = "owner"
+	Viewer    ProjectRole = "viewer"
+)
+
+// Defines values for StatsPeriod.
+const (
+	StatsPeriodDay  StatsPeriod = "day"
+	StatsPeriodWeek StatsPeriod = "week"
+)
+
+// Defines values for GetReportsTimesheetParamsGroupBy.
+const (
+	GetReportsTimesheetParamsGroupByDay     GetReportsTimesheetParamsGroupBy = "day"
+	GetReportsTimesheetParamsGroupByProject GetReportsTimesheetParamsGroupBy = "project"
+	GetReportsTimesheetParamsGroupByUser    GetReportsTimesheetParamsGroupBy = "user"
+)
+
+// Defines values for GetReportsTimesheetParamsFormat.
+const (
+	Csv  GetReportsTimesheetParamsFormat = "csv"
+	Json GetReportsTimesheetParamsFormat = "json"
+)
+
+// Defines values for GetStatsParamsPeriod.
+const (
+	GetStatsParamsPeriodDay  GetStatsParamsPeriod = "day"
+	GetStatsParamsPeriodWeek GetStatsParamsPeriod = "week"
+)
+
+// APIKey API ключ без секрета
+type APIKey struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExpiresAt Срок действия; null - бессрочный ключ
+	ExpiresAt  *time.Time `json:"expires_at"`
+	Id         int        `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	LastUsedIp *string    `json:"last_used_ip"`
+	Name       string     `json:"name"`
+
+	// Prefix Первые символы ключа, чтобы его можно было узнать
+	Prefix    string        `json:"prefix"`
+	RevokedAt *time.Time    `json:"revoked_at"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyList defines model for APIKeyList.
+type APIKeyList struct {
+	ApiKeys []APIKey `json:"api_keys"`
+}
+
+// APIKeyScope Право ключа: tasks:read - GET запросы, tasks:write - изменяющие запросы
+type APIKeyScope string
+
+// APIKeySecret Ключ вместе с секретом; секрет показывается один раз
+type APIKeySecret struct {
+	// ApiKey API ключ без секрета
+	ApiKey APIKey `json:"api_key"`
+
+	// Key Секрет ключа для заголовка X-API-Key или Authorization Bearer
+	Key string `json:"key"`
+}
+
+// ActivityItem defines model for ActivityItem.
+type ActivityItem struct {
+	// ActorId Автор комментария или события; null - анонимный клиент
+	ActorId *int `json:"actor_id"`
+
+	// Body Текст комментария; null для событий
+	Body      *string   `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Идентификатор комментария или события
+	Id int `json:"id"`
+
+	// SubjectId Назначенный или снятый исполнитель для событий assigned и unassigned; иначе null
+	SubjectId *int `json:"subject_id"`
+
+	// Type comment - комментарий, иначе тип события задачи
+	Type ActivityItemType `json:"type"`
+}
+
+// ActivityItemType comment - комментарий, иначе тип события задачи
+type ActivityItemType string
+
+// ActivityList defines model for ActivityList.
+type ActivityList struct {
+	Items  []ActivityItem `json:"items"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+	Total  int            `json:"total"`
+}
+
+// Attachment defines model for Attachment.
+type Attachment struct {
+	// Checksum SHA-256 содержимого в hex
+	Checksum string `json:"checksum"`
+
+	// ContentType Тип, определенный по содержимому
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	Filename    string    `json:"filename"`
+	Id          int       `json:"id"`
+
+	// Size Размер в байтах
+	Size   int64 `json:"size"`
+	TaskId int   `json:"task_id"`
+
+	// UploadedBy Пользователь, загрузивший файл; null - анонимный клиент
+	UploadedBy *int `json:"uploaded_by"`
+}
+
+// AttachmentList defines model for AttachmentList.
+type AttachmentList struct {
+	Attachments []Attachment `json:"attachments"`
+}
+
+// AttachmentUpload defines model for AttachmentUpload.
+type AttachmentUpload struct {
+	// File Содержимое файла; имя берется из filename части
+	File openapi_types.File `json:"file"`
+}
+
+// Burndown defines model for Burndown.
+type Burndown struct {
+	Days        []BurndownDay `json:"days"`
+	MilestoneId int           `json:"milestone_id"`
+}
+
+// BurndownDay defines model for BurndownDay.
+type BurndownDay struct {
+	// Completed Задачи, выполненные за день
+	Completed int                `json:"completed"`
+	Date      openapi_types.Date `json:"date"`
+
+	// Ideal Остаток по идеальной линии
+	Ideal float64 `json:"ideal"`
+
+	// Remaining Невыполненные задачи на конец дня
+	Remaining int `json:"remaining"`
+
+	// RemainingMinutes Оценка невыполненных задач на конец дня
+	RemainingMinutes int `json:"remaining_minutes"`
+}
+
+// Checklist defines model for Checklist.
+type Checklist struct {
+	Items    []ChecklistItem   `json:"items"`
+	Progress ChecklistProgress `json:"progress"`
+}
+
+// ChecklistItem defines model for ChecklistItem.
+type ChecklistItem struct {
+	Checked bool `json:"checked"`
+	Id      int  `json:"id"`
+
+	// Position Номер пункта в чек-листе, начиная с 1
+	Position int    `json:"position"`
+	Text     string `json:"text"`
+}
+
+// ChecklistProgress defines model for ChecklistProgress.
+type ChecklistProgress struct {
+	// Checked Отмечено пунктов
+	Checked int `json:"checked"`
+
+	// Total Всего пунктов
+	Total int `json:"total"`
+}
+
+// Comment defines model for Comment.
+type Comment struct {
+	AuthorId int `json:"author_id"`
+
+	// Body Текст в Markdown
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// EditedAt Время последней правки; null - комментарий не правился
+	EditedAt *time.Time `json:"edited_at"`
+	Id       int        `json:"id"`
+
+	// Mentions Упомянутые пользователи, которым видна задача
+	Mentions []int `json:"mentions"`
+	TaskId   int   `json:"task_id"`
+}
+
+// CommentList defines model for CommentList.
+type CommentList struct {
+	Comments []Comment `json:"comments"`
+
+	// Limit Лимит записей
+	Limit int `json:"limit"`
+
+	// Offset Смещение
+	Offset int `json:"offset"`
+
+	// Total Общее количество комментариев задачи
+	Total int `json:"total"`
+}
+
+// CommentRequest defines model for CommentRequest.
+type CommentRequest struct {
+	// Body Текст в Markdown
+	Body string `json:"body"`
+}
+
+// CommentRevision defines model for CommentRevision.
+type CommentRevision struct {
+	// Body Текст комментария до правки
+	Body string `json:"body"`
+
+	// ReplacedAt Когда текст был заменен
+	ReplacedAt time.Time `json:"replaced_at"`
+}
+
+// CommentRevisionList defines model for CommentRevisionList.
+type CommentRevisionList struct {
+	Revisions []CommentRevision `json:"revisions"`
+}
+
+// CompletionStreaks defines model for CompletionStreaks.
+type CompletionStreaks struct {
+	// CurrentDays Текущая серия; не прервана, если задачи выполнены сегодня или вчера
+	CurrentDays int `json:"current_days"`
+	LongestDays int `json:"longest_days"`
+}
+
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	// ExpiresAt Срок действия; без него ключ бессрочный
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Name Название ключа, например имя бота
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+}
+
+// CreateChecklistItemRequest defines model for CreateChecklistItemRequest.
+type CreateChecklistItemRequest struct {
+	Checked *bool  `json:"checked,omitempty"`
+	Text    string `json:"text"`
+}
+
+// CreateCustomFieldRequest defines model for CreateCustomFieldRequest.
+type CreateCustomFieldRequest struct {
+	// Key Ключ поля, уникальный в проекте
+	Key  string `json:"key"`
+	Name string `json:"name"`
+
+	// Options Варианты значений; обязательны для select и multi_select, у других типов их нет
+	Options  *[]string `json:"options,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+
+	// Type Тип поля и значения в custom_fields задачи -
+	// text - строка до 1000 символов, number - число, date - дата YYYY-MM-DD,
+	// select - один из вариантов, multi_select - массив вариантов,
+	// user - идентификатор участника проекта
+	Type CustomFieldType `json:"type"`
+}
+
+// CreateDependencyRequest defines model for CreateDependencyRequest.
+type CreateDependencyRequest struct {
+	// BlockerId Задача, которая должна быть выполнена раньше
+	BlockerId int `json:"blocker_id"`
+}
+
+// CreateInvitationRequest defines model for CreateInvitationRequest.
+type CreateInvitationRequest struct {
+	// Role Роль в проекте:
+	// owner - все права, включая управление участниками и удаление проекта;
+	// editor - чтение, комментарии, создание и изменение задач;
+	// commenter - чтение и комментарии;
+	// viewer - только чтение
+	Role   ProjectRole `json:"role"`
+	UserId int         `json:"user_id"`
+}
+
+// CreateMilestoneRequest defines model for CreateMilestoneRequest.
+type CreateMilestoneRequest struct {
+	Description *string `json:"description,omitempty"`
+
+	// EndDate Последний день вехи, не раньше start_date
+	EndDate   openapi_types.Date `json:"end_date"`
+	Name      string             `json:"name"`
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// CreateProjectRequest defines model for CreateProjectRequest.
+type CreateProjectRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateReminderRequest defines model for CreateReminderRequest.
+type CreateReminderRequest struct {
+	// Email Отправить письмо на этот адрес (если сервер настроен на отправку почты)
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// OffsetMinutes За сколько минут до срока задачи напомнить
+	OffsetMinutes *int `json:"offset_minutes,omitempty"`
+
+	// RemindAt Момент напоминания в будущем
+	RemindAt *time.Time `json:"remind_at,omitempty"`
+}
+
 // CreateTaskRequest defines model for CreateTaskRequest.
 type CreateTaskRequest struct {
+	// AssigneeIds Исполнители - участники проекта задачи, для личной задачи только ее владелец
+	AssigneeIds *[]int `json:"assignee_ids,omitempty"`
+
+	// CustomFields Значения пользовательских полей проекта по ключу поля; обязательные поля нужно заполнить
+	CustomFields *map[string]interface{} `json:"custom_fields,omitempty"`
+
 	// Description Описание задачи
 	Description string `json:"description"`
 
+	// DueAt Срок задачи
+	DueAt *time.Time `json:"due_at,omitempty"`
+
+	// EstimateMinutes Оценка в минутах; 0 - без оценки
+	EstimateMinutes *int `json:"estimate_minutes,omitempty"`
+
+	// MilestoneId Веха проекта задачи
+	MilestoneId *int `json:"milestone_id,omitempty"`
+
 	// Name Название задачи
 	Name string `json:"name"`
+
+	// ParentId Создать подзадачу этой задачи; подзадача создается в проекте родительской
+	ParentId *int `json:"parent_id,omitempty"`
+
+	// ProjectId Создать задачу в проекте (нужна роль owner или editor)
+	ProjectId *int `json:"project_id,omitempty"`
+
+	// Tags Метки задачи
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// CreateTemplateRequest defines model for CreateTemplateRequest.
+type CreateTemplateRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+
+	// ProjectId Шаблон проекта; не указан - личный шаблон
+	ProjectId *int `json:"project_id,omitempty"`
+
+	// Tasks Корневые задачи; всего в дереве не больше 200 задач и 5 уровней
+	Tasks []TemplateTask `json:"tasks"`
+}
+
+// CreateTimeEntryRequest defines model for CreateTimeEntryRequest.
+type CreateTimeEntryRequest struct {
+	DurationMinutes int     `json:"duration_minutes"`
+	Note            *string `json:"note,omitempty"`
+
+	// StartedAt Начало интервала; не позже текущего момента
+	StartedAt *time.Time `json:"started_at,omitempty"`
+}
+
+// CustomField defines model for CustomField.
+type CustomField struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int       `json:"id"`
+
+	// Key Ключ поля в custom_fields задачи
+	Key  string `json:"key"`
+	Name string `json:"name"`
+
+	// Options Варианты значений select и multi_select
+	Options   []string `json:"options"`
+	ProjectId int      `json:"project_id"`
+
+	// Required Значение обязательно в задачах проекта
+	Required bool `json:"required"`
+
+	// Type Тип поля и значения в custom_fields задачи -
+	// text - строка до 1000 символов, number - число, date - дата YYYY-MM-DD,
+	// select - один из вариантов, multi_select - массив вариантов,
+	// user - идентификатор участника проекта
+	Type CustomFieldType `json:"type"`
 }
 
-// Error defines model for Error.
+// CustomFieldList defines model for CustomFieldList.
+type CustomFieldList struct {
+	Fields []CustomField `json:"fields"`
+}
+
+// CustomFieldType Тип поля и значения в custom_fields задачи -
+// text - строка до 1000 символов, number - число, date - дата YYYY-MM-DD,
+// select - один из вариантов, multi_select - массив вариантов,
+// user - идентификатор участника проекта
+type CustomFieldType string
+
+// Dependency defines model for Dependency.
+type Dependency struct {
+	// BlockerId Блокирующая задача
+	BlockerId int       `json:"blocker_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// TaskId Заблокированная задача
+	TaskId int `json:"task_id"`
+}
+
+// Error Описание ошибки в формате RFC 7807 (application/problem+json)
 type Error struct {
 	// Code Код ошибки
 	Code string `json:"code"`
 
-	// Details Дополнительные детали ошибки
+	// Details Дополнительные детали ошибки (для ошибок валидации - нарушения по полям)
 	Details *map[string]interface{} `json:"details,omitempty"`
 
 	// Error Тип ошибки
 	Error string `json:"error"`
 
+	// Instance URI запроса, в котором возникла проблема
+	Instance *string `json:"instance,omitempty"`
+
 	// Message Описание ошибки
 	Message string `json:"message"`
+
+	// Status HTTP статус ответа
+	Status *int `json:"status,omitempty"`
+
+	// Title Краткое описание типа проблемы
+	Title *string `json:"title,omitempty"`
+
+	// Type URI типа проблемы
+	Type *string `json:"type,omitempty"`
+}
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	// DurationMs Длительность проверки в миллисекундах
+	DurationMs *float32 `json:"duration_ms,omitempty"`
+
+	// Error Причина провала проверки
+	Error  *string           `json:"error,omitempty"`
+	Status HealthCheckStatus `json:"status"`
+}
+
+// HealthCheckStatus defines model for HealthCheck.Status.
+type HealthCheckStatus string
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	// Checks Результаты отдельных проверок по имени
+	Checks   *map[string]HealthCheck `json:"checks,omitempty"`
+	Database *HealthStatusDatabase   `json:"database,omitempty"`
+	Status   HealthStatusStatus      `json:"status"`
+
+	// Timestamp Время выполнения проверок
+	Timestamp time.Time `json:"timestamp"`
+
+	// Version Версия API
+	Version string `json:"version"`
+}
+
+// HealthStatusDatabase defines model for HealthStatus.Database.
+type HealthStatusDatabase string
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
+// InstantiateTemplateRequest defines model for InstantiateTemplateRequest.
+type InstantiateTemplateRequest struct {
+	// StartAt Начало, от которого отсчитываются сроки задач; по умолчанию - момент запроса
+	StartAt *time.Time `json:"start_at,omitempty"`
+
+	// Variables Значения переменных шаблона
+	Variables *map[string]string `json:"variables,omitempty"`
+}
+
+// Invitation defines model for Invitation.
+type Invitation struct {
+	CreatedAt time.Time `json:"created_at"`
+	Id        int       `json:"id"`
+	InvitedBy int       `json:"invited_by"`
+	ProjectId int       `json:"project_id"`
+
+	// Role Роль в проекте:
+	// owner - все права, включая управление участниками и удаление проекта;
+	// editor - чтение, комментарии, создание и изменение задач;
+	// commenter - чтение и комментарии;
+	// viewer - только чтение
+	Role ProjectRole `json:"role"`
+
+	// UserId Приглашенный пользователь
+	UserId int `json:"user_id"`
+}
+
+// InvitationList defines model for InvitationList.
+type InvitationList struct {
+	Invitations []Invitation `json:"invitations"`
+}
+
+// Milestone defines model for Milestone.
+type Milestone struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+
+	// EndDate Последний день вехи
+	EndDate openapi_types.Date `json:"end_date"`
+	Id      int                `json:"id"`
+	Name    string             `json:"name"`
+
+	// Progress Задачи вехи - всего и выполнено, в штуках и в минутах оценки
+	Progress  MilestoneProgress `json:"progress"`
+	ProjectId int               `json:"project_id"`
+
+	// StartDate Первый день вехи
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// MilestoneList defines model for MilestoneList.
+type MilestoneList struct {
+	Milestones []Milestone `json:"milestones"`
+}
+
+// MilestoneProgress Задачи вехи - всего и выполнено, в штуках и в минутах оценки
+type MilestoneProgress struct {
+	Completed                int `json:"completed"`
+	CompletedEstimateMinutes int `json:"completed_estimate_minutes"`
+	EstimateMinutes          int `json:"estimate_minutes"`
+	Total                    int `json:"total"`
+}
+
+// MoveTaskRequest Соседи задачи после перемещения; нужен хотя бы один, оба должны быть в списке задачи
+type MoveTaskRequest struct {
+	// AfterId Задача, после которой встает перемещаемая
+	AfterId *int `json:"after_id,omitempty"`
+
+	// BeforeId Задача, перед которой встает перемещаемая
+	BeforeId *int `json:"before_id,omitempty"`
+}
+
+// PlanStep defines model for PlanStep.
+type PlanStep struct {
+	// BlockedBy Блокирующие задачи, в том числе из других проектов
+	BlockedBy []int `json:"blocked_by"`
+
+	// Stage Этап плана; 0 - задача не ждет других задач проекта
+	Stage int  `json:"stage"`
+	Task  Task `json:"task"`
+}
+
+// Project defines model for Project.
+type Project struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// CreatedBy Пользователь, создавший проект
+	CreatedBy int    `json:"created_by"`
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+
+	// Role Роль в проекте:
+	// owner - все права, включая управление участниками и удаление проекта;
+	// editor - чтение, комментарии, создание и изменение задач;
+	// commenter - чтение и комментарии;
+	// viewer - только чтение
+	Role ProjectRole `json:"role"`
+}
+
+// ProjectList defines model for ProjectList.
+type ProjectList struct {
+	Projects []Project `json:"projects"`
+}
+
+// ProjectMember defines model for ProjectMember.
+type ProjectMember struct {
+	CreatedAt time.Time `json:"created_at"`
+	ProjectId int       `json:"project_id"`
+
+	// Role Роль в проекте:
+	// owner - все права, включая управление участниками и удаление проекта;
+	// editor - чтение, комментарии, создание и изменение задач;
+	// commenter - чтение и комментарии;
+	// viewer - только чтение
+	Role   ProjectRole `json:"role"`
+	UserId int         `json:"user_id"`
+}
+
+// ProjectMemberList defines model for ProjectMemberList.
+type ProjectMemberList struct {
+	Members []ProjectMember `json:"members"`
+}
+
+// ProjectPlan defines model for ProjectPlan.
+type ProjectPlan struct {
+	Steps []PlanStep `json:"steps"`
+}
+
+// ProjectRole Роль в проекте:
+// owner - все права, включая управление участниками и удаление проекта;
+// editor - чтение, комментарии, создание и изменение задач;
+// commenter - чтение и комментарии;
+// viewer - только чтение
+type ProjectRole string
+
+// ProjectStats defines model for ProjectStats.
+type ProjectStats struct {
+	Completed int `json:"completed"`
+
+	// Overdue Невыполненные задачи со сроком в прошлом
+	Overdue int `json:"overdue"`
+
+	// ProjectId Проект; null - задачи вне проектов
+	ProjectId *int `json:"project_id"`
+	Total     int  `json:"total"`
+}
+
+// ProjectTime defines model for ProjectTime.
+type ProjectTime struct {
+	// EstimateMinutes Сумма оценок задач проекта (каждая задача учитывается один раз)
+	EstimateMinutes int64      `json:"estimate_minutes"`
+	Tasks           []TaskTime `json:"tasks"`
+
+	// TrackedSeconds Учтенное время по всем задачам проекта
+	TrackedSeconds int64 `json:"tracked_seconds"`
+}
+
+// Reminder defines model for Reminder.
+type Reminder struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Email Адрес для письма; пустой - письмо не отправляется
+	Email string `json:"email"`
+
+	// FireAt Когда напоминание сработает; null - у задачи нет срока
+	FireAt *time.Time `json:"fire_at"`
+	Id     int        `json:"id"`
+
+	// OffsetMinutes За сколько минут до срока задачи напомнить; null - напоминание к remind_at
+	OffsetMinutes *int `json:"offset_minutes"`
+
+	// RemindAt Момент напоминания; null - напоминание относительно срока задачи
+	RemindAt *time.Time `json:"remind_at"`
+
+	// SentAt Последняя отправка
+	SentAt *time.Time `json:"sent_at"`
+	TaskId int        `json:"task_id"`
+}
+
+// ReminderList defines model for ReminderList.
+type ReminderList struct {
+	Reminders []Reminder `json:"reminders"`
+}
+
+// ReorderChecklistRequest defines model for ReorderChecklistRequest.
+type ReorderChecklistRequest struct {
+	// ItemIds Все пункты чек-листа в новом порядке
+	ItemIds []int `json:"item_ids"`
+}
+
+// StartTimerRequest defines model for StartTimerRequest.
+type StartTimerRequest struct {
+	Note *string `json:"note,omitempty"`
+}
+
+// Stats defines model for Stats.
+type Stats struct {
+	// AverageCompletionSeconds Среднее время от создания до выполнения задач, выполненных за период; null - таких нет
+	AverageCompletionSeconds *float64       `json:"average_completion_seconds"`
+	ByProject                []ProjectStats `json:"by_project"`
+
+	// ByTag До 50 самых частых меток
+	ByTag   []TagStats         `json:"by_tag"`
+	From    openapi_types.Date `json:"from"`
+	Period  StatsPeriod        `json:"period"`
+	Series  []StatsPoint       `json:"series"`
+	Streaks CompletionStreaks  `json:"streaks"`
+	To      openapi_types.Date `json:"to"`
+	Totals  StatsTotals        `json:"totals"`
+}
+
+// StatsPeriod defines model for Stats.Period.
+type StatsPeriod string
+
+// StatsPoint defines model for StatsPoint.
+type StatsPoint struct {
+	Completed int `json:"completed"`
+	Created   int `json:"created"`
+
+	// Period Первый день периода
+	Period openapi_types.Date `json:"period"`
+}
+
+// StatsTotals defines model for StatsTotals.
+type StatsTotals struct {
+	Completed int `json:"completed"`
+
+	// Overdue Невыполненные задачи со сроком в прошлом
+	Overdue int `json:"overdue"`
+	Pending int `json:"pending"`
+	Total   int `json:"total"`
+}
+
+// TagStats defines model for TagStats.
+type TagStats struct {
+	Completed int `json:"completed"`
+
+	// Overdue Невыполненные задачи со сроком в прошлом
+	Overdue int    `json:"overdue"`
+	Tag     string `json:"tag"`
+	Total   int    `json:"total"`
 }
 
 // Task defines model for Task.
 type Task struct {
+	// AssigneeIds Исполнители задачи в порядке назначения
+	AssigneeIds []int `json:"assignee_ids"`
+
+	// Blocked У задачи есть невыполненные блокирующие задачи
+	Blocked   bool              `json:"blocked"`
+	Checklist ChecklistProgress `json:"checklist"`
+
 	// Completed Статус выполнения задачи
 	Completed bool `json:"completed"`
 
+	// CompletedAt Когда задача выполнена; null - не выполнена
+	CompletedAt *time.Time `json:"completed_at"`
+
 	// CreatedAt Дата и время создания
 	CreatedAt time.Time `json:"created_at"`
 
+	// CustomFields Значения пользовательских полей проекта по ключу поля; у задач вне проектов пусто
+	CustomFields map[string]interface{} `json:"custom_fields"`
+
 	// Description Описание задачи
 	Description string `json:"description"`
 
+	// DueAt Срок задачи; null - без срока
+	DueAt *time.Time `json:"due_at"`
+
+	// EstimateMinutes Оценка задачи в минутах; null - не оценена
+	EstimateMinutes *int `json:"estimate_minutes"`
+
 	// Id Уникальный идентификатор задачи
 	Id int `json:"id"`
 
+	// MilestoneId Веха задачи; null - задача вне вех
+	MilestoneId *int `json:"milestone_id"`
+
 	// Name Название задачи
 	Name string `json:"name"`
 
+	// ParentId Родительская задача; null - задача верхнего уровня
+	ParentId *int `json:"parent_id"`
+
+	// Position Ранг задачи в ее списке; меньше - выше
+	Position float64 `json:"position"`
+
+	// ProjectId Проект задачи; null - личная задача
+	ProjectId *int `json:"project_id"`
+
+	// Tags Метки задачи
+	Tags []string `json:"tags"`
+
 	// UpdatedAt Дата и время последнего обновления
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
+// TaskDependencies defines model for TaskDependencies.
+type TaskDependencies struct {
+	// BlockedBy Задачи, которые блокируют эту задачу
+	BlockedBy []Task `json:"blocked_by"`
+
+	// Blocking Задачи, которые ждут эту задачу
+	Blocking []Task `json:"blocking"`
+}
+
+// TaskList defines model for TaskList.
+type TaskList struct {
+	// Limit Лимит записей
+	Limit int `json:"limit"`
+
+	// Offset Смещение
+	Offset int    `json:"offset"`
+	Tasks  []Task `json:"tasks"`
+
+	// Total Общее количество задач, подходящих под фильтр
+	Total int `json:"total"`
+}
+
+// TaskTime defines model for TaskTime.
+type TaskTime struct {
+	// EstimateMinutes Оценка задачи; null - не оценена
+	EstimateMinutes *int `json:"estimate_minutes"`
+	TaskId          int  `json:"task_id"`
+
+	// TotalEstimateMinutes Сумма оценок задачи и всех ее подзадач (любой глубины)
+	TotalEstimateMinutes int64 `json:"total_estimate_minutes"`
+
+	// TotalTrackedSeconds Учтенное время задачи и всех ее подзадач (любой глубины)
+	TotalTrackedSeconds int64 `json:"total_tracked_seconds"`
+
+	// TrackedSeconds Учтенное время всех пользователей
+	TrackedSeconds int64 `json:"tracked_seconds"`
+}
+
+// Template defines model for Template.
+type Template struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Description string    `json:"description"`
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+
+	// OwnerId Автор шаблона
+	OwnerId int `json:"owner_id"`
+
+	// ProjectId Проект шаблона; null - личный шаблон
+	ProjectId *int `json:"project_id"`
+
+	// Tasks Корневые задачи шаблона
+	Tasks     []TemplateTask `json:"tasks"`
+	UpdatedAt time.Time      `json:"updated_at"`
+
+	// Variables Переменные шаблона по алфавиту
+	Variables []string `json:"variables"`
+}
+
+// TemplateInstance defines model for TemplateInstance.
+type TemplateInstance struct {
+	// Tasks Созданные задачи в порядке шаблона (родитель перед подзадачами)
+	Tasks []Task `json:"tasks"`
+}
+
+// TemplateList defines model for TemplateList.
+type TemplateList struct {
+	Templates []Template `json:"templates"`
+}
+
+// TemplateTask Задача шаблона; в name и description можно использовать переменные {{name}}
+type TemplateTask struct {
+	Description *string `json:"description,omitempty"`
+
+	// DueOffsetMinutes Срок задачи через столько минут после начала (start_at); не указан - без срока
+	DueOffsetMinutes *int   `json:"due_offset_minutes,omitempty"`
+	Name             string `json:"name"`
+
+	// Subtasks Подзадачи
+	Subtasks *[]TemplateTask `json:"subtasks,omitempty"`
+
+	// Tags Метки задачи
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// TimeEntry defines model for TimeEntry.
+type TimeEntry struct {
+	// DurationSeconds Длительность; у идущего таймера - до текущего момента
+	DurationSeconds int64 `json:"duration_seconds"`
+
+	// EndedAt null - таймер идет
+	EndedAt   *time.Time `json:"ended_at"`
+	Id        int        `json:"id"`
+	Note      string     `json:"note"`
+	StartedAt time.Time  `json:"started_at"`
+	TaskId    int        `json:"task_id"`
+	UserId    int        `json:"user_id"`
+}
+
+// TimeEntryList defines model for TimeEntryList.
+type TimeEntryList struct {
+	Entries []TimeEntry `json:"entries"`
+	Totals  TaskTime    `json:"totals"`
+}
+
+// Timesheet defines model for Timesheet.
+type Timesheet struct {
+	Rows         []TimesheetRow `json:"rows"`
+	TotalSeconds int64          `json:"total_seconds"`
+}
+
+// TimesheetRow Строка табеля; столбцы вне группировки - null
+type TimesheetRow struct {
+	Day *openapi_types.Date `json:"day"`
+
+	// ProjectId null - задачи вне проектов (или проект не в группировке)
+	ProjectId *int  `json:"project_id"`
+	Seconds   int64 `json:"seconds"`
+	UserId    *int  `json:"user_id"`
+}
+
+// UpdateChecklistItemRequest defines model for UpdateChecklistItemRequest.
+type UpdateChecklistItemRequest struct {
+	Checked *bool   `json:"checked,omitempty"`
+	Text    *string `json:"text,omitempty"`
+}
+
+// UpdateCustomFieldRequest defines model for UpdateCustomFieldRequest.
+type UpdateCustomFieldRequest struct {
+	Name *string `json:"name,omitempty"`
+
+	// Options Варианты значений; новый полный список
+	Options  *[]string `json:"options,omitempty"`
+	Required *bool     `json:"required,omitempty"`
+}
+
+// UpdateMilestoneRequest defines model for UpdateMilestoneRequest.
+type UpdateMilestoneRequest struct {
+	Description *string             `json:"description,omitempty"`
+	EndDate     *openapi_types.Date `json:"end_date,omitempty"`
+	Name        *string             `json:"name,omitempty"`
+	StartDate   *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// UpdateProjectMemberRequest defines model for UpdateProjectMemberRequest.
+type UpdateProjectMemberRequest struct {
+	// Role Роль в проекте:
+	// owner - все права, включая управление участниками и удаление проекта;
+	// editor - чтение, комментарии, создание и изменение задач;
+	// commenter - чтение и комментарии;
+	// viewer - только чтение
+	Role ProjectRole `json:"role"`
+}
+
 // UpdateTaskRequest defines model for UpdateTaskRequest.
 type UpdateTaskRequest struct {
+	// AssigneeIds Новый список исполнителей; не указан - исполнители не меняются, [] - снять всех
+	AssigneeIds *[]int `json:"assignee_ids,omitempty"`
+
 	// Completed Статус выполнения задачи
 	Completed bool `json:"completed"`
 
+	// CustomFields Меняет только переданные поля, null снимает значение; не указан - поля не меняются
+	CustomFields *map[string]interface{} `json:"custom_fields,omitempty"`
+
 	// Description Описание задачи
 	Description string `json:"description"`
 
+	// DueAt Срок задачи; null снимает срок, не указан - не меняется
+	DueAt json.RawMessage `json:"due_at,omitempty"`
+
+	// EstimateMinutes Оценка в минутах; 0 снимает оценку, не указана - не меняется
+	EstimateMinutes *int `json:"estimate_minutes,omitempty"`
+
+	// MilestoneId Веха проекта задачи; null убирает задачу из вехи, не указана - не меняется
+	MilestoneId json.RawMessage `json:"milestone_id,omitempty"`
+
 	// Name Название задачи
 	Name string `json:"name"`
+
+	// Tags Новый список меток; не указан - метки не меняются
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// UpdateTemplateRequest defines model for UpdateTemplateRequest.
+type UpdateTemplateRequest struct {
+	Description string         `json:"description"`
+	Name        string         `json:"name"`
+	Tasks       []TemplateTask `json:"tasks"`
+}
+
+// BadRequest Описание ошибки в формате RFC 7807 (application/problem+json)
+type BadRequest = Error
+
+// Conflict Описание ошибки в формате RFC 7807 (application/problem+json)
+type Conflict = Error
+
+// Forbidden Описание ошибки в формате RFC 7807 (application/problem+json)
+type Forbidden = Error
+
+// GatewayTimeout Описание ошибки в формате RFC 7807 (application/problem+json)
+type GatewayTimeout = Error
+
+// InternalError Описание ошибки в формате RFC 7807 (application/problem+json)
+type InternalError = Error
+
+// NotFound Описание ошибки в формате RFC 7807 (application/problem+json)
+type NotFound = Error
+
+// PayloadTooLarge Описание ошибки в формате RFC 7807 (application/problem+json)
+type PayloadTooLarge = Error
+
+// ServiceUnavailable Описание ошибки в формате RFC 7807 (application/problem+json)
+type ServiceUnavailable = Error
+
+// TooManyRequests Описание ошибки в формате RFC 7807 (application/problem+json)
+type TooManyRequests = Error
+
+// Unauthorized Описание ошибки в формате RFC 7807 (application/problem+json)
+type Unauthorized = Error
+
+// UnsupportedMediaType Описание ошибки в формате RFC 7807 (application/problem+json)
+type UnsupportedMediaType = Error
+
+// GetMeTasksParams defines parameters for GetMeTasks.
+type GetMeTasksParams struct {
+	// Completed Фильтр по статусу выполнения
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty"`
+
+	// Sort Порядок задач - created_at (новые первыми), position (ручной порядок, см. /tasks/{id}/move)
+	// или field.<ключ> / -field.<ключ> (по значению пользовательского поля; задачи без значения последними)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Field Отбор по пользовательским полям в виде ключ:значение; повтор параметра - все условия сразу.
+	// Для multi_select задача подходит, если среди выбранных вариантов есть значение
+	Field *[]string `form:"field,omitempty" json:"field,omitempty"`
+
+	// Limit Максимальное количество записей
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для пагинации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetMilestonesIdTasksParams defines parameters for GetMilestonesIdTasks.
+type GetMilestonesIdTasksParams struct {
+	// Limit Максимальное количество записей
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для пагинации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetReportsTimesheetParams defines parameters for GetReportsTimesheet.
+type GetReportsTimesheetParams struct {
+	// From Первый день периода
+	From openapi_types.Date `form:"from" json:"from"`
+
+	// To Последний день периода (включительно), не дальше 366 дней от from
+	To openapi_types.Date `form:"to" json:"to"`
+
+	// GroupBy Столбцы группировки
+	GroupBy *[]GetReportsTimesheetParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+
+	// ProjectId Только задачи проекта
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// UserId Только записи пользователя
+	UserId *int                             `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Format *GetReportsTimesheetParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetReportsTimesheetParamsGroupBy defines parameters for GetReportsTimesheet.
+type GetReportsTimesheetParamsGroupBy string
+
+// GetReportsTimesheetParamsFormat defines parameters for GetReportsTimesheet.
+type GetReportsTimesheetParamsFormat string
+
+// GetStatsParams defines parameters for GetStats.
+type GetStatsParams struct {
+	// ProjectId Только задачи проекта
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// From Первый день ряда; по умолчанию 30 дней или 12 недель до to
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Последний день ряда (включительно), не дальше 366 дней от from; по умолчанию сегодня
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// Period Шаг ряда; недели начинаются с понедельника
+	Period *GetStatsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
 }
 
+// GetStatsParamsPeriod defines parameters for GetStats.
+type GetStatsParamsPeriod string
+
 // GetTasksParams defines parameters for GetTasks.
 type GetTasksParams struct {
 	// Completed Фильтр по статусу выполнения
@@ -78,6 +1131,14 @@ type GetTasksParams struct {
 
 	// Offset Смещение для пагинации
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Sort Порядок задач - created_at (новые первыми), position (ручной порядок, см. /tasks/{id}/move)
+	// или field.<ключ> / -field.<ключ> (по значению пользовательского поля; задачи без значения последними)
+	Sort *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Field Отбор по пользовательским полям в виде ключ:значение; повтор параметра - все условия сразу.
+	// Для multi_select задача подходит, если среди выбранных вариантов есть значение
+	Field *[]string `form:"field,omitempty" json:"field,omitempty"`
 }
 
 // GetTasksCompletedParams defines parameters for GetTasksCompleted.
@@ -98,8 +1159,110 @@ type GetTasksPendingParams struct {
 	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// GetTasksIdActivityParams defines parameters for GetTasksIdActivity.
+type GetTasksIdActivityParams struct {
+	// Limit Максимальное количество записей
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для пагинации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PostTasksIdAttachmentsParams defines parameters for PostTasksIdAttachments.
+type PostTasksIdAttachmentsParams struct {
+	// XChecksumSha256 Ожидаемый SHA-256 содержимого файла в hex
+	XChecksumSha256 *string `json:"X-Checksum-Sha256,omitempty"`
+}
+
+// GetTasksIdCommentsParams defines parameters for GetTasksIdComments.
+type GetTasksIdCommentsParams struct {
+	// Limit Максимальное количество записей
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Смещение для пагинации
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// PatchTasksIdCompleteParams defines parameters for PatchTasksIdComplete.
+type PatchTasksIdCompleteParams struct {
+	// Force Выполнить задачу, даже если блокирующие задачи не выполнены
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetTemplatesParams defines parameters for GetTemplates.
+type GetTemplatesParams struct {
+	// ProjectId Только шаблоны этого проекта
+	ProjectId *int `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody = CreateAPIKeyRequest
+
+// PatchMilestonesIdJSONRequestBody defines body for PatchMilestonesId for application/json ContentType.
+type PatchMilestonesIdJSONRequestBody = UpdateMilestoneRequest
+
+// PostProjectsJSONRequestBody defines body for PostProjects for application/json ContentType.
+type PostProjectsJSONRequestBody = CreateProjectRequest
+
+// PostProjectsIdFieldsJSONRequestBody defines body for PostProjectsIdFields for application/json ContentType.
+type PostProjectsIdFieldsJSONRequestBody = CreateCustomFieldRequest
+
+// PatchProjectsIdFieldsFieldIdJSONRequestBody defines body for PatchProjectsIdFieldsFieldId for application/json ContentType.
+type PatchProjectsIdFieldsFieldIdJSONRequestBody = UpdateCustomFieldRequest
+
+// PostProjectsIdInvitationsJSONRequestBody defines body for PostProjectsIdInvitations for application/json ContentType.
+type PostProjectsIdInvitationsJSONRequestBody = CreateInvitationRequest
+
+// PutProjectsIdMembersUserIdJSONRequestBody defines body for PutProjectsIdMembersUserId for application/json ContentType.
+type PutProjectsIdMembersUserIdJSONRequestBody = UpdateProjectMemberRequest
+
+// PostProjectsIdMilestonesJSONRequestBody defines body for PostProjectsIdMilestones for application/json ContentType.
+type PostProjectsIdMilestonesJSONRequestBody = CreateMilestoneRequest
+
 // PostTasksJSONRequestBody defines body for PostTasks for application/json ContentType.
 type PostTasksJSONRequestBody = CreateTaskRequest
 
 // PutTasksIdJSONRequestBody defines body for PutTasksId for application/json ContentType.
 type PutTasksIdJSONRequestBody = UpdateTaskRequest
+
+// PostTasksIdAttachmentsMultipartRequestBody defines body for PostTasksIdAttachments for multipart/form-data ContentType.
+type PostTasksIdAttachmentsMultipartRequestBody = AttachmentUpload
+
+// PostTasksIdChecklistJSONRequestBody defines body for PostTasksIdChecklist for application/json ContentType.
+type PostTasksIdChecklistJSONRequestBody = CreateChecklistItemRequest
+
+// PutTasksIdChecklistOrderJSONRequestBody defines body for PutTasksIdChecklistOrder for application/json ContentType.
+type PutTasksIdChecklistOrderJSONRequestBody = ReorderChecklistRequest
+
+// PatchTasksIdChecklistItemIdJSONRequestBody defines body for PatchTasksIdChecklistItemId for application/json ContentType.
+type PatchTasksIdChecklistItemIdJSONRequestBody = UpdateChecklistItemRequest
+
+// PostTasksIdCommentsJSONRequestBody defines body for PostTasksIdComments for application/json ContentType.
+type PostTasksIdCommentsJSONRequestBody = CommentRequest
+
+// PutTasksIdCommentsCommentIdJSONRequestBody defines body for PutTasksIdCommentsCommentId for application/json ContentType.
+type PutTasksIdCommentsCommentIdJSONRequestBody = CommentRequest
+
+// PostTasksIdDependenciesJSONRequestBody defines body for PostTasksIdDependencies for application/json ContentType.
+type PostTasksIdDependenciesJSONRequestBody = CreateDependencyRequest
+
+// PostTasksIdMoveJSONRequestBody defines body for PostTasksIdMove for application/json ContentType.
+type PostTasksIdMoveJSONRequestBody = MoveTaskRequest
+
+// PostTasksIdRemindersJSONRequestBody defines body for PostTasksIdReminders for application/json ContentType.
+type PostTasksIdRemindersJSONRequestBody = CreateReminderRequest
+
+// PostTasksIdTimeEntriesJSONRequestBody defines body for PostTasksIdTimeEntries for application/json ContentType.
+type PostTasksIdTimeEntriesJSONRequestBody = CreateTimeEntryRequest
+
+// PostTasksIdTimeEntriesStartJSONRequestBody defines body for PostTasksIdTimeEntriesStart for application/json ContentType.
+type PostTasksIdTimeEntriesStartJSONRequestBody = StartTimerRequest
+
+// PostTemplatesJSONRequestBody defines body for PostTemplates for application/json ContentType.
+type PostTemplatesJSONRequestBody = CreateTemplateRequest
+
+// PutTemplatesIdJSONRequestBody defines body for PutTemplatesId for application/json ContentType.
+type PutTemplatesIdJSONRequestBody = UpdateTemplateRequest
+
+// PostTemplatesIdInstantiateJSONRequestBody defines body for PostTemplatesIdInstantiate for application/json ContentType.
+type PostTemplatesIdInstantiateJSONRequestBody = InstantiateTemplateRequest
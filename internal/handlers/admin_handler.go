@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"crypto/subtle"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/logging"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminHandler служебные эндпоинты, не входящие в публичную спецификацию API
+type AdminHandler struct {
+	logs *logging.Controller
+}
+
+func NewAdminHandler(logs *logging.Controller) *AdminHandler {
+	return &AdminHandler{
+		logs: logs,
+	}
+}
+
+// LogSettings уровень и формат логов
+type LogSettings struct {
+	Level  string `json:"level,omitempty"`
+	Format string `json:"format,omitempty"`
+}
+
+// RegisterAdminRoutes регистрирует /admin/* под проверкой токена из ADMIN_TOKEN
+func RegisterAdminRoutes(e *echo.Echo, h *AdminHandler, token string) {
+	admin := e.Group("/admin", adminAuth(token))
+	admin.GET("/logging", h.GetLogging)
+	admin.PUT("/logging", h.PutLogging)
+}
+
+// GetLogging текущие настройки логирования
+func (h *AdminHandler) GetLogging(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, LogSettings{
+		Level:  h.logs.Level(),
+		Format: h.logs.Format(),
+	})
+}
+
+// PutLogging меняет уровень и/или формат логов без перезапуска
+func (h *AdminHandler) PutLogging(ctx echo.Context) error {
+	var req LogSettings
+	if err := ctx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body").SetInternal(err)
+	}
+
+	violations := make(map[string]string)
+	if req.Level != "" {
+		if err := h.logs.SetLevel(req.Level); err != nil {
+			violations["level"] = err.Error()
+		}
+	}
+	if req.Format != "" {
+		if err := h.logs.SetFormat(req.Format); err != nil {
+			violations["format"] = err.Error()
+		}
+	}
+	if len(violations) > 0 {
+		return &apperrors.ValidationError{Fields: violations}
+	}
+
+	slog.InfoContext(ctx.Request().Context(), "logging settings changed",
+		slog.String("level", h.logs.Level()),
+		slog.String("format", h.logs.Format()),
+	)
+	return h.GetLogging(ctx)
+}
+
+func adminAuth(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			provided, ok := strings.CutPrefix(c.Request().Header.Get(echo.HeaderAuthorization), "Bearer ")
+			if !ok || subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid admin token")
+			}
+			return next(c)
+		}
+	}
+}
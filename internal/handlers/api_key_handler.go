@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+type APIKeyHandler struct {
+	service service.APIKeyService
+}
+
+func NewAPIKeyHandler(svc service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		service: svc,
+	}
+}
+
+// GetApiKeys получить API ключи текущего пользователя
+func (h *APIKeyHandler) GetApiKeys(ctx echo.Context) error {
+	keys, err := h.service.ListAPIKeys(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	apiKeys := make([]generated.APIKey, len(keys))
+	for i, key := range keys {
+		apiKeys[i] = convertToAPIKey(*key)
+	}
+	return ctx.JSON(http.StatusOK, generated.APIKeyList{ApiKeys: apiKeys})
+}
+
+// PostApiKeys создать API ключ
+func (h *APIKeyHandler) PostApiKeys(ctx echo.Context) error {
+	var req generated.CreateAPIKeyRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	scopes := make([]string, len(req.Scopes))
+	for i, scope := range req.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	key, secret, err := h.service.CreateAPIKey(ctx.Request().Context(), req.Name, scopes, req.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, generated.APIKeySecret{ApiKey: convertToAPIKey(*key), Key: secret})
+}
+
+// DeleteApiKeysId отозвать API ключ
+func (h *APIKeyHandler) DeleteApiKeysId(ctx echo.Context, id int) error {
+	if err := h.service.RevokeAPIKey(ctx.Request().Context(), int32(id)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// PostApiKeysIdRotate перевыпустить секрет API ключа
+func (h *APIKeyHandler) PostApiKeysIdRotate(ctx echo.Context, id int) error {
+	key, secret, err := h.service.RotateAPIKey(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, generated.APIKeySecret{ApiKey: convertToAPIKey(*key), Key: secret})
+}
+
+// convertToAPIKey конвертирует модель БД в API модель; хеш ключа наружу не отдается
+func convertToAPIKey(key db.ApiKey) generated.APIKey {
+	scopes := make([]generated.APIKeyScope, len(key.Scopes))
+	for i, scope := range key.Scopes {
+		scopes[i] = generated.APIKeyScope(scope)
+	}
+
+	var lastUsedIP *string
+	if key.LastUsedIp.Valid {
+		lastUsedIP = &key.LastUsedIp.String
+	}
+
+	return generated.APIKey{
+		Id:         int(key.ID),
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  timePtr(key.ExpiresAt),
+		RevokedAt:  timePtr(key.RevokedAt),
+		LastUsedAt: timePtr(key.LastUsedAt),
+		LastUsedIp: lastUsedIP,
+		CreatedAt:  key.CreatedAt.Time,
+	}
+}
+
+// timePtr необязательное время из pgx в формате API
+func timePtr(t pgtype.Timestamptz) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
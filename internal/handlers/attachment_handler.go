@@ -0,0 +1,129 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+// headerChecksum SHA-256 содержимого в hex: ожидаемый при загрузке и фактический при скачивании
+const headerChecksum = "X-Checksum-Sha256"
+
+type AttachmentHandler struct {
+	service service.AttachmentService
+}
+
+func NewAttachmentHandler(svc service.AttachmentService) *AttachmentHandler {
+	return &AttachmentHandler{
+		service: svc,
+	}
+}
+
+// GetTasksIdAttachments получить вложения задачи
+func (h *AttachmentHandler) GetTasksIdAttachments(ctx echo.Context, id int) error {
+	attachments, err := h.service.ListAttachments(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	list := generated.AttachmentList{Attachments: make([]generated.Attachment, len(attachments))}
+	for i, attachment := range attachments {
+		list.Attachments[i] = convertToAttachment(*attachment)
+	}
+	return ctx.JSON(http.StatusOK, list)
+}
+
+// PostTasksIdAttachments загрузить вложение. Тело читается потоком по частям формы,
+// без ctx.FormFile, который складывает файл в память или во временный файл.
+func (h *AttachmentHandler) PostTasksIdAttachments(ctx echo.Context, id int, params generated.PostTasksIdAttachmentsParams) error {
+	reader, err := ctx.Request().MultipartReader()
+	if err != nil {
+		return apperrors.NewValidation("body", "must be multipart/form-data")
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return apperrors.NewValidation("file", "is required")
+		}
+		if err != nil {
+			return apperrors.NewValidation("body", "malformed multipart body: "+err.Error())
+		}
+		if part.FormName() != "file" {
+			part.Close()
+			continue
+		}
+
+		checksum := ""
+		if params.XChecksumSha256 != nil {
+			checksum = *params.XChecksumSha256
+		}
+		attachment, err := h.service.Upload(ctx.Request().Context(), int32(id), part.FileName(), part, checksum)
+		part.Close()
+		if err != nil {
+			return err
+		}
+
+		return ctx.JSON(http.StatusCreated, convertToAttachment(*attachment))
+	}
+}
+
+// GetTasksIdAttachmentsAttachmentId получить описание вложения
+func (h *AttachmentHandler) GetTasksIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error {
+	attachment, err := h.service.GetAttachment(ctx.Request().Context(), int32(id), int32(attachmentId))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToAttachment(*attachment))
+}
+
+// DeleteTasksIdAttachmentsAttachmentId удалить вложение
+func (h *AttachmentHandler) DeleteTasksIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error {
+	if err := h.service.DeleteAttachment(ctx.Request().Context(), int32(id), int32(attachmentId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetTasksIdAttachmentsAttachmentIdContent скачать содержимое вложения.
+// Range, If-Range и If-None-Match обрабатывает http.ServeContent.
+func (h *AttachmentHandler) GetTasksIdAttachmentsAttachmentIdContent(ctx echo.Context, id int, attachmentId int) error {
+	attachment, blob, err := h.service.Open(ctx.Request().Context(), int32(id), int32(attachmentId))
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentType, attachment.ContentType)
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	header.Set("ETag", `"`+attachment.Checksum+`"`)
+	header.Set(headerChecksum, attachment.Checksum)
+	header.Set("X-Content-Type-Options", "nosniff")
+
+	http.ServeContent(ctx.Response(), ctx.Request(), attachment.Filename, attachment.CreatedAt.Time, blob)
+	return nil
+}
+
+func convertToAttachment(attachment db.TaskAttachment) generated.Attachment {
+	return generated.Attachment{
+		Id:          int(attachment.ID),
+		TaskId:      int(attachment.TaskID),
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		UploadedBy:  intPtr(attachment.UploadedBy.Int32, attachment.UploadedBy.Valid),
+		CreatedAt:   attachment.CreatedAt.Time,
+	}
+}
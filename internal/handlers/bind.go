@@ -0,0 +1,17 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// bindBody заполняет dst из JSON тела запроса. Тело уже проверено по спецификации
+// в validation.Middleware, поэтому здесь оно только разбирается.
+func bindBody(ctx echo.Context, dst any) error {
+	if err := json.NewDecoder(ctx.Request().Body).Decode(dst); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body").SetInternal(err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ChecklistHandler struct {
+	service service.ChecklistService
+}
+
+func NewChecklistHandler(svc service.ChecklistService) *ChecklistHandler {
+	return &ChecklistHandler{
+		service: svc,
+	}
+}
+
+// GetTasksIdChecklist получить чек-лист задачи
+func (h *ChecklistHandler) GetTasksIdChecklist(ctx echo.Context, id int) error {
+	items, err := h.service.ListItems(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToChecklist(items))
+}
+
+// PostTasksIdChecklist добавить пункт чек-листа
+func (h *ChecklistHandler) PostTasksIdChecklist(ctx echo.Context, id int) error {
+	var req generated.CreateChecklistItemRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	checked := req.Checked != nil && *req.Checked
+	item, err := h.service.CreateItem(ctx.Request().Context(), int32(id), req.Text, checked)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToChecklistItem(*item))
+}
+
+// PutTasksIdChecklistOrder изменить порядок пунктов чек-листа
+func (h *ChecklistHandler) PutTasksIdChecklistOrder(ctx echo.Context, id int) error {
+	var req generated.ReorderChecklistRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	itemIDs := make([]int32, len(req.ItemIds))
+	for i, itemID := range req.ItemIds {
+		itemIDs[i] = int32(itemID)
+	}
+	items, err := h.service.ReorderItems(ctx.Request().Context(), int32(id), itemIDs)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToChecklist(items))
+}
+
+// PatchTasksIdChecklistItemId изменить пункт чек-листа
+func (h *ChecklistHandler) PatchTasksIdChecklistItemId(ctx echo.Context, id int, itemId int) error {
+	var req generated.UpdateChecklistItemRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	item, err := h.service.UpdateItem(ctx.Request().Context(), int32(id), int32(itemId), req.Text, req.Checked)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToChecklistItem(*item))
+}
+
+// DeleteTasksIdChecklistItemId удалить пункт чек-листа
+func (h *ChecklistHandler) DeleteTasksIdChecklistItemId(ctx echo.Context, id int, itemId int) error {
+	if err := h.service.DeleteItem(ctx.Request().Context(), int32(id), int32(itemId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// PostTasksIdChecklistItemIdConvert превратить пункт чек-листа в задачу
+func (h *ChecklistHandler) PostTasksIdChecklistItemIdConvert(ctx echo.Context, id int, itemId int) error {
+	task, err := h.service.ConvertItem(ctx.Request().Context(), int32(id), int32(itemId))
+	if err != nil {
+		return err
+	}
+
+	// у только что созданной задачи нет ни зависимостей, ни чек-листа
+	return ctx.JSON(http.StatusCreated, convertToAPITask(*task, service.TaskDetails{}))
+}
+
+func convertToChecklist(items []*db.TaskChecklistItem) generated.Checklist {
+	checklist := generated.Checklist{Items: make([]generated.ChecklistItem, len(items))}
+	for i, item := range items {
+		checklist.Items[i] = convertToChecklistItem(*item)
+		if item.Checked {
+			checklist.Progress.Checked++
+		}
+	}
+	checklist.Progress.Total = len(items)
+	return checklist
+}
+
+func convertToChecklistItem(item db.TaskChecklistItem) generated.ChecklistItem {
+	return generated.ChecklistItem{
+		Id:       int(item.ID),
+		Text:     item.Text,
+		Checked:  item.Checked,
+		Position: int(item.Position),
+	}
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CommentHandler struct {
+	service service.CommentService
+}
+
+func NewCommentHandler(svc service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		service: svc,
+	}
+}
+
+// GetTasksIdComments получить комментарии задачи
+func (h *CommentHandler) GetTasksIdComments(ctx echo.Context, id int, params generated.GetTasksIdCommentsParams) error {
+	limit, offset := page(params.Limit, params.Offset)
+	comments, total, err := h.service.ListComments(ctx.Request().Context(), int32(id), limit, offset)
+	if err != nil {
+		return err
+	}
+
+	list := generated.CommentList{
+		Comments: make([]generated.Comment, len(comments)),
+		Total:    int(total),
+		Limit:    int(limit),
+		Offset:   int(offset),
+	}
+	for i, comment := range comments {
+		list.Comments[i] = convertToComment(*comment)
+	}
+	return ctx.JSON(http.StatusOK, list)
+}
+
+// PostTasksIdComments добавить комментарий к задаче
+func (h *CommentHandler) PostTasksIdComments(ctx echo.Context, id int) error {
+	var req generated.CommentRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	comment, err := h.service.CreateComment(ctx.Request().Context(), int32(id), req.Body)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToComment(*comment))
+}
+
+// GetTasksIdCommentsCommentId получить комментарий
+func (h *CommentHandler) GetTasksIdCommentsCommentId(ctx echo.Context, id int, commentId int) error {
+	comment, err := h.service.GetComment(ctx.Request().Context(), int32(id), int32(commentId))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToComment(*comment))
+}
+
+// PutTasksIdCommentsCommentId изменить свой комментарий
+func (h *CommentHandler) PutTasksIdCommentsCommentId(ctx echo.Context, id int, commentId int) error {
+	var req generated.CommentRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	comment, err := h.service.UpdateComment(ctx.Request().Context(), int32(id), int32(commentId), req.Body)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToComment(*comment))
+}
+
+// DeleteTasksIdCommentsCommentId удалить свой комментарий
+func (h *CommentHandler) DeleteTasksIdCommentsCommentId(ctx echo.Context, id int, commentId int) error {
+	if err := h.service.DeleteComment(ctx.Request().Context(), int32(id), int32(commentId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetTasksIdCommentsCommentIdHistory получить историю правок комментария
+func (h *CommentHandler) GetTasksIdCommentsCommentIdHistory(ctx echo.Context, id int, commentId int) error {
+	revisions, err := h.service.ListRevisions(ctx.Request().Context(), int32(id), int32(commentId))
+	if err != nil {
+		return err
+	}
+
+	list := generated.CommentRevisionList{Revisions: make([]generated.CommentRevision, len(revisions))}
+	for i, revision := range revisions {
+		list.Revisions[i] = generated.CommentRevision{
+			Body:       revision.Body,
+			ReplacedAt: revision.CreatedAt.Time,
+		}
+	}
+	return ctx.JSON(http.StatusOK, list)
+}
+
+// GetTasksIdActivity получить ленту активности задачи
+func (h *CommentHandler) GetTasksIdActivity(ctx echo.Context, id int, params generated.GetTasksIdActivityParams) error {
+	limit, offset := page(params.Limit, params.Offset)
+	items, total, err := h.service.ListActivity(ctx.Request().Context(), int32(id), limit, offset)
+	if err != nil {
+		return err
+	}
+
+	list := generated.ActivityList{
+		Items:  make([]generated.ActivityItem, len(items)),
+		Total:  int(total),
+		Limit:  int(limit),
+		Offset: int(offset),
+	}
+	for i, item := range items {
+		list.Items[i] = generated.ActivityItem{
+			Type:      generated.ActivityItemType(item.Kind),
+			Id:        int(item.ID),
+			ActorId:   intPtr(item.ActorID.Int32, item.ActorID.Valid),
+			SubjectId: intPtr(item.SubjectID.Int32, item.SubjectID.Valid),
+			CreatedAt: item.CreatedAt.Time,
+		}
+		if item.Body.Valid {
+			list.Items[i].Body = &item.Body.String
+		}
+	}
+	return ctx.JSON(http.StatusOK, list)
+}
+
+// page лимит и смещение из параметров запроса со значениями по умолчанию, как у списка задач
+func page(limit, offset *int) (int32, int32) {
+	pageLimit, pageOffset := int32(50), int32(0)
+	if limit != nil {
+		pageLimit = int32(*limit)
+	}
+	if offset != nil {
+		pageOffset = int32(*offset)
+	}
+	return pageLimit, pageOffset
+}
+
+// intPtr необязательный идентификатор в формате API
+func intPtr(value int32, valid bool) *int {
+	if !valid {
+		return nil
+	}
+	v := int(value)
+	return &v
+}
+
+func convertToComment(comment db.TaskComment) generated.Comment {
+	mentions := make([]int, len(comment.Mentions))
+	for i, id := range comment.Mentions {
+		mentions[i] = int(id)
+	}
+
+	return generated.Comment{
+		Id:        int(comment.ID),
+		TaskId:    int(comment.TaskID),
+		AuthorId:  int(comment.AuthorID),
+		Body:      comment.Body,
+		Mentions:  mentions,
+		CreatedAt: comment.CreatedAt.Time,
+		EditedAt:  timePtr(comment.EditedAt),
+	}
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CustomFieldHandler struct {
+	service service.CustomFieldService
+}
+
+func NewCustomFieldHandler(svc service.CustomFieldService) *CustomFieldHandler {
+	return &CustomFieldHandler{
+		service: svc,
+	}
+}
+
+// GetProjectsIdFields получить пользовательские поля проекта
+func (h *CustomFieldHandler) GetProjectsIdFields(ctx echo.Context, id int) error {
+	fields, err := h.service.ListFields(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	result := make([]generated.CustomField, len(fields))
+	for i, field := range fields {
+		result[i] = convertToCustomField(*field)
+	}
+	return ctx.JSON(http.StatusOK, generated.CustomFieldList{Fields: result})
+}
+
+// PostProjectsIdFields добавить поле в схему проекта
+func (h *CustomFieldHandler) PostProjectsIdFields(ctx echo.Context, id int) error {
+	var req generated.CreateCustomFieldRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	var options []string
+	if req.Options != nil {
+		options = *req.Options
+	}
+	required := req.Required != nil && *req.Required
+	field, err := h.service.CreateField(ctx.Request().Context(), int32(id), req.Key, req.Name, string(req.Type), options, required)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToCustomField(*field))
+}
+
+// PatchProjectsIdFieldsFieldId изменить поле проекта
+func (h *CustomFieldHandler) PatchProjectsIdFieldsFieldId(ctx echo.Context, id int, fieldId int) error {
+	var req generated.UpdateCustomFieldRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	var options []string
+	if req.Options != nil {
+		// не nil даже для []: nil значит, что варианты не меняются
+		options = append([]string{}, *req.Options...)
+	}
+	field, err := h.service.UpdateField(ctx.Request().Context(), int32(id), int32(fieldId), req.Name, options, req.Required)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToCustomField(*field))
+}
+
+// DeleteProjectsIdFieldsFieldId удалить поле проекта вместе с его значениями в задачах
+func (h *CustomFieldHandler) DeleteProjectsIdFieldsFieldId(ctx echo.Context, id int, fieldId int) error {
+	if err := h.service.DeleteField(ctx.Request().Context(), int32(id), int32(fieldId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func convertToCustomField(field db.ProjectCustomField) generated.CustomField {
+	return generated.CustomField{
+		Id:        int(field.ID),
+		ProjectId: int(field.ProjectID),
+		Key:       field.Key,
+		Name:      field.Name,
+		Type:      generated.CustomFieldType(field.Type),
+		Options:   field.Options,
+		Required:  field.Required,
+		CreatedAt: field.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"net/http"
+	"slices"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type DependencyHandler struct {
+	service service.DependencyService
+	tasks   service.TaskService
+}
+
+func NewDependencyHandler(svc service.DependencyService, tasks service.TaskService) *DependencyHandler {
+	return &DependencyHandler{
+		service: svc,
+		tasks:   tasks,
+	}
+}
+
+// GetTasksIdDependencies получить зависимости задачи
+func (h *DependencyHandler) GetTasksIdDependencies(ctx echo.Context, id int) error {
+	blockers, dependents, err := h.service.ListDependencies(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	details, err := h.tasks.Details(ctx.Request().Context(), slices.Concat(blockers, dependents))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TaskDependencies{
+		BlockedBy: convertToAPITasks(blockers, details),
+		Blocking:  convertToAPITasks(dependents, details),
+	})
+}
+
+// PostTasksIdDependencies добавить блокирующую задачу
+func (h *DependencyHandler) PostTasksIdDependencies(ctx echo.Context, id int) error {
+	var req generated.CreateDependencyRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	dependency, err := h.service.AddDependency(ctx.Request().Context(), int32(id), int32(req.BlockerId))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, generated.Dependency{
+		TaskId:    int(dependency.TaskID),
+		BlockerId: int(dependency.BlockerID),
+		CreatedAt: dependency.CreatedAt.Time,
+	})
+}
+
+// DeleteTasksIdDependenciesBlockerId удалить блокирующую задачу
+func (h *DependencyHandler) DeleteTasksIdDependenciesBlockerId(ctx echo.Context, id int, blockerId int) error {
+	if err := h.service.RemoveDependency(ctx.Request().Context(), int32(id), int32(blockerId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetProjectsIdPlan получить задачи проекта в порядке выполнения
+func (h *DependencyHandler) GetProjectsIdPlan(ctx echo.Context, id int) error {
+	steps, err := h.service.ProjectPlan(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	tasks := make([]*db.Task, len(steps))
+	for i, step := range steps {
+		tasks[i] = step.Task
+	}
+	details, err := h.tasks.Details(ctx.Request().Context(), tasks)
+	if err != nil {
+		return err
+	}
+
+	plan := generated.ProjectPlan{Steps: make([]generated.PlanStep, len(steps))}
+	for i, step := range steps {
+		blockedBy := make([]int, len(step.BlockedBy))
+		for j, blockerID := range step.BlockedBy {
+			blockedBy[j] = int(blockerID)
+		}
+		plan.Steps[i] = generated.PlanStep{
+			Task:      convertToAPITask(*step.Task, details[step.Task.ID]),
+			Stage:     step.Stage,
+			BlockedBy: blockedBy,
+		}
+	}
+	return ctx.JSON(http.StatusOK, plan)
+}
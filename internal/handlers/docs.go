@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"GreatProject/api"
+
+	"github.com/labstack/echo/v4"
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+// swaggerInitializer настраивает Swagger UI на встроенную спецификацию вместо petstore
+const swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: "/openapi.yml",
+    dom_id: '#swagger-ui',
+    deepLinking: true,
+    presets: [
+      SwaggerUIBundle.presets.apis,
+      SwaggerUIStandalonePreset
+    ],
+    plugins: [
+      SwaggerUIBundle.plugins.DownloadUrl
+    ],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+// RegisterDocsRoutes отдает спецификацию по /openapi.yml и Swagger UI по /swagger.
+// Статика Swagger UI встроена в бинарник, внешние CDN не нужны.
+func RegisterDocsRoutes(e *echo.Echo) {
+	e.GET("/openapi.yml", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, "application/yaml", api.OpenAPISpec)
+	})
+
+	e.GET("/swagger", func(c echo.Context) error {
+		return c.Redirect(http.StatusMovedPermanently, "/swagger/")
+	})
+	e.GET("/swagger/swagger-initializer.js", func(c echo.Context) error {
+		return c.Blob(http.StatusOK, "application/javascript", []byte(swaggerInitializer))
+	})
+	e.StaticFS("/swagger/", swaggerFiles.FS)
+}
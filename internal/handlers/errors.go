@@ -0,0 +1,167 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/middleware"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ProblemContentType тип содержимого ответов об ошибках (RFC 7807)
+const ProblemContentType = "application/problem+json"
+
+// HTTPErrorHandler единая точка преобразования ошибок в ответы application/problem+json.
+// Подключается через e.HTTPErrorHandler.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status, problem := toProblem(err)
+	if status == http.StatusInternalServerError {
+		slog.ErrorContext(c.Request().Context(), "request failed",
+			slog.String("method", c.Request().Method),
+			slog.String("uri", c.Request().URL.Path),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	instance := c.Request().URL.Path
+	problem.Instance = &instance
+
+	if status == middleware.StatusClientClosedRequest || c.Request().Method == http.MethodHead {
+		err = c.NoContent(status)
+	} else {
+		err = writeProblem(c, status, problem)
+	}
+	if err != nil {
+		slog.ErrorContext(c.Request().Context(), "failed to write error response", slog.String("error", err.Error()))
+	}
+}
+
+// toProblem сопоставляет ошибку с HTTP статусом и телом ответа
+func toProblem(err error) (int, generated.Error) {
+	var validationErr *apperrors.ValidationError
+	var notFoundErr *apperrors.NotFoundError
+	var conflictErr *apperrors.ConflictError
+	var blockedErr *apperrors.BlockedError
+	var quotaErr *apperrors.QuotaExceededError
+	var forbiddenErr *apperrors.ForbiddenError
+	var tooLargeErr *apperrors.TooLargeError
+	var unsupportedTypeErr *apperrors.UnsupportedTypeError
+	var httpErr *echo.HTTPError
+
+	switch {
+	case errors.As(err, &validationErr):
+		details := make(map[string]interface{}, len(validationErr.Fields))
+		for field, message := range validationErr.Fields {
+			details[field] = message
+		}
+		problem := newProblem(http.StatusBadRequest, "VALIDATION_ERROR", "Validation failed")
+		problem.Details = &details
+		return http.StatusBadRequest, problem
+	case errors.As(err, &notFoundErr):
+		code := strings.ToUpper(notFoundErr.Resource) + "_NOT_FOUND"
+		return http.StatusNotFound, newProblem(http.StatusNotFound, code, notFoundErr.Error())
+	case errors.As(err, &conflictErr):
+		problem := newProblem(http.StatusConflict, "CONFLICT", conflictErr.Message)
+		if conflictErr.Constraint != "" {
+			problem.Details = &map[string]interface{}{"constraint": conflictErr.Constraint}
+		}
+		return http.StatusConflict, problem
+	case errors.As(err, &blockedErr):
+		problem := newProblem(http.StatusConflict, "TASK_BLOCKED", blockedErr.Error())
+		problem.Details = &map[string]interface{}{"blocked_by": blockedErr.Blockers}
+		return http.StatusConflict, problem
+	case errors.As(err, &quotaErr):
+		problem := newProblem(http.StatusForbidden, "QUOTA_EXCEEDED", quotaErr.Error())
+		problem.Details = &map[string]interface{}{"resource": quotaErr.Resource, "limit": quotaErr.Limit}
+		return http.StatusForbidden, problem
+	case errors.As(err, &forbiddenErr):
+		problem := newProblem(http.StatusForbidden, "FORBIDDEN", forbiddenErr.Message)
+		if forbiddenErr.Permission != "" {
+			problem.Details = &map[string]interface{}{"permission": forbiddenErr.Permission}
+		}
+		return http.StatusForbidden, problem
+	case errors.As(err, &tooLargeErr):
+		problem := newProblem(http.StatusRequestEntityTooLarge, "PAYLOAD_TOO_LARGE", tooLargeErr.Error())
+		problem.Details = &map[string]interface{}{"limit": tooLargeErr.Limit}
+		return http.StatusRequestEntityTooLarge, problem
+	case errors.As(err, &unsupportedTypeErr):
+		problem := newProblem(http.StatusUnsupportedMediaType, "UNSUPPORTED_MEDIA_TYPE", unsupportedTypeErr.Error())
+		problem.Details = &map[string]interface{}{"content_type": unsupportedTypeErr.ContentType}
+		return http.StatusUnsupportedMediaType, problem
+	case errors.Is(err, apperrors.ErrUnauthenticated):
+		return http.StatusUnauthorized, newProblem(http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required")
+	case errors.Is(err, apperrors.ErrUnavailable):
+		return http.StatusServiceUnavailable, newProblem(http.StatusServiceUnavailable, "SERVICE_UNAVAILABLE", "Service temporarily unavailable")
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout, newProblem(http.StatusGatewayTimeout, "TIMEOUT", "Request timed out")
+	case errors.Is(err, context.Canceled):
+		return middleware.StatusClientClosedRequest, newProblem(middleware.StatusClientClosedRequest, "CLIENT_CLOSED_REQUEST", "Client closed request")
+	case errors.As(err, &httpErr):
+		message := http.StatusText(httpErr.Code)
+		if m, ok := httpErr.Message.(string); ok {
+			message = m
+		}
+		return httpErr.Code, newProblem(httpErr.Code, httpErrorCode(httpErr.Code), message)
+	}
+
+	return http.StatusInternalServerError, newProblem(http.StatusInternalServerError, "INTERNAL_ERROR", "Internal server error")
+}
+
+func newProblem(status int, code, message string) generated.Error {
+	title := http.StatusText(status)
+	if title == "" {
+		title = "Error"
+	}
+	problemType := "about:blank"
+
+	return generated.Error{
+		Type:    &problemType,
+		Title:   &title,
+		Status:  &status,
+		Error:   title,
+		Message: message,
+		Code:    code,
+	}
+}
+
+func writeProblem(c echo.Context, status int, problem generated.Error) error {
+	// c.JSON не перезаписывает уже установленный Content-Type
+	c.Response().Header().Set(echo.HeaderContentType, ProblemContentType)
+	return c.JSON(status, problem)
+}
+
+func httpErrorCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "INVALID_REQUEST"
+	case http.StatusUnauthorized:
+		return "UNAUTHORIZED"
+	case http.StatusForbidden:
+		return "FORBIDDEN"
+	case http.StatusNotFound:
+		return "NOT_FOUND"
+	case http.StatusMethodNotAllowed:
+		return "METHOD_NOT_ALLOWED"
+	case http.StatusRequestEntityTooLarge:
+		return "PAYLOAD_TOO_LARGE"
+	case http.StatusUnsupportedMediaType:
+		return "UNSUPPORTED_MEDIA_TYPE"
+	case http.StatusTooManyRequests:
+		return "RATE_LIMITED"
+	case http.StatusServiceUnavailable:
+		return "SERVICE_UNAVAILABLE"
+	case http.StatusGatewayTimeout:
+		return "TIMEOUT"
+	}
+	return "INTERNAL_ERROR"
+}
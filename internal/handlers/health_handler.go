@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+
+	"GreatProject/internal/generated"
+	"GreatProject/internal/health"
+
+	"github.com/labstack/echo/v4"
+)
+
+// DatabaseCheckName имя проверки БД, по которой заполняется поле database в /health
+const DatabaseCheckName = "database"
+
+type HealthHandler struct {
+	health  *health.Service
+	version string
+}
+
+func NewHealthHandler(health *health.Service, version string) *HealthHandler {
+	return &HealthHandler{
+		health:  health,
+		version: version,
+	}
+}
+
+// GetHealth сводный статус сервиса (кэшируется)
+func (h *HealthHandler) GetHealth(ctx echo.Context) error {
+	return h.respond(ctx, h.health.Cached(ctx.Request().Context()))
+}
+
+// GetLivez проверка живости
+func (h *HealthHandler) GetLivez(ctx echo.Context) error {
+	return h.respond(ctx, h.health.Live(ctx.Request().Context()))
+}
+
+// GetReadyz проверка готовности
+func (h *HealthHandler) GetReadyz(ctx echo.Context) error {
+	return h.respond(ctx, h.health.Ready(ctx.Request().Context()))
+}
+
+func (h *HealthHandler) respond(ctx echo.Context, report health.Report) error {
+	status := http.StatusOK
+	if !report.Healthy() {
+		status = http.StatusServiceUnavailable
+	}
+	// Пробы не должны кэшироваться прокси между балансировщиком и сервисом
+	ctx.Response().Header().Set("Cache-Control", "no-store")
+	return ctx.JSON(status, h.convertToAPIHealth(report))
+}
+
+// convertToAPIHealth конвертирует отчет проверок в API модель
+func (h *HealthHandler) convertToAPIHealth(report health.Report) generated.HealthStatus {
+	checks := make(map[string]generated.HealthCheck, len(report.Checks))
+	for name, result := range report.Checks {
+		durationMs := float32(result.Duration.Microseconds()) / 1000
+		check := generated.HealthCheck{
+			Status:     generated.HealthCheckStatus(result.Status),
+			DurationMs: &durationMs,
+		}
+		if result.Error != "" {
+			check.Error = &result.Error
+		}
+		checks[name] = check
+	}
+
+	apiHealth := generated.HealthStatus{
+		Status:    generated.HealthStatusStatus(report.Status),
+		Timestamp: report.CheckedAt,
+		Version:   h.version,
+		Checks:    &checks,
+	}
+	if result, ok := report.Checks[DatabaseCheckName]; ok {
+		database := generated.Connected
+		if result.Status != health.StatusOK {
+			database = generated.Disconnected
+		}
+		apiHealth.Database = &database
+	}
+	return apiHealth
+}
@@ -0,0 +1,182 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type MilestoneHandler struct {
+	service service.MilestoneService
+	tasks   service.TaskService
+}
+
+func NewMilestoneHandler(svc service.MilestoneService, tasks service.TaskService) *MilestoneHandler {
+	return &MilestoneHandler{
+		service: svc,
+		tasks:   tasks,
+	}
+}
+
+// GetProjectsIdMilestones получить вехи проекта
+func (h *MilestoneHandler) GetProjectsIdMilestones(ctx echo.Context, id int) error {
+	milestones, err := h.service.ListMilestones(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+	progress, err := h.service.Progress(ctx.Request().Context(), milestones)
+	if err != nil {
+		return err
+	}
+
+	result := make([]generated.Milestone, len(milestones))
+	for i, milestone := range milestones {
+		result[i] = convertToMilestone(milestone, progress[milestone.ID])
+	}
+	return ctx.JSON(http.StatusOK, generated.MilestoneList{Milestones: result})
+}
+
+// PostProjectsIdMilestones создать веху
+func (h *MilestoneHandler) PostProjectsIdMilestones(ctx echo.Context, id int) error {
+	var req generated.CreateMilestoneRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	milestone, err := h.service.CreateMilestone(ctx.Request().Context(), int32(id), service.MilestoneInput{
+		Name:        req.Name,
+		Description: description,
+		Start:       req.StartDate.Time,
+		End:         req.EndDate.Time,
+	})
+	if err != nil {
+		return err
+	}
+
+	// у новой вехи еще нет задач
+	return ctx.JSON(http.StatusCreated, convertToMilestone(milestone, service.MilestoneProgress{}))
+}
+
+// GetMilestonesId получить веху
+func (h *MilestoneHandler) GetMilestonesId(ctx echo.Context, id int) error {
+	milestone, err := h.service.GetMilestone(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return h.milestoneResponse(ctx, milestone)
+}
+
+// PatchMilestonesId изменить веху
+func (h *MilestoneHandler) PatchMilestonesId(ctx echo.Context, id int) error {
+	var req generated.UpdateMilestoneRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	input := service.MilestoneUpdate{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if req.StartDate != nil {
+		input.Start = &req.StartDate.Time
+	}
+	if req.EndDate != nil {
+		input.End = &req.EndDate.Time
+	}
+	milestone, err := h.service.UpdateMilestone(ctx.Request().Context(), int32(id), input)
+	if err != nil {
+		return err
+	}
+
+	return h.milestoneResponse(ctx, milestone)
+}
+
+// DeleteMilestonesId удалить веху
+func (h *MilestoneHandler) DeleteMilestonesId(ctx echo.Context, id int) error {
+	if err := h.service.DeleteMilestone(ctx.Request().Context(), int32(id)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetMilestonesIdTasks получить задачи вехи
+func (h *MilestoneHandler) GetMilestonesIdTasks(ctx echo.Context, id int, params generated.GetMilestonesIdTasksParams) error {
+	limit, offset := page(params.Limit, params.Offset)
+	tasks, total, err := h.service.MilestoneTasks(ctx.Request().Context(), int32(id), limit, offset)
+	if err != nil {
+		return err
+	}
+
+	details, err := h.tasks.Details(ctx.Request().Context(), tasks)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TaskList{
+		Tasks:  convertToAPITasks(tasks, details),
+		Total:  int(total),
+		Limit:  int(limit),
+		Offset: int(offset),
+	})
+}
+
+// GetMilestonesIdBurndown получить диаграмму сгорания вехи
+func (h *MilestoneHandler) GetMilestonesIdBurndown(ctx echo.Context, id int) error {
+	milestone, days, err := h.service.Burndown(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	result := make([]generated.BurndownDay, len(days))
+	for i, day := range days {
+		result[i] = generated.BurndownDay{
+			Date:             openapi_types.Date{Time: day.Date},
+			Remaining:        int(day.Remaining),
+			Completed:        int(day.Completed),
+			RemainingMinutes: int(day.RemainingMinutes),
+			Ideal:            day.Ideal,
+		}
+	}
+	return ctx.JSON(http.StatusOK, generated.Burndown{
+		MilestoneId: int(milestone.ID),
+		Days:        result,
+	})
+}
+
+// milestoneResponse отдает веху вместе с прогрессом ее задач
+func (h *MilestoneHandler) milestoneResponse(ctx echo.Context, milestone *db.Milestone) error {
+	progress, err := h.service.Progress(ctx.Request().Context(), []*db.Milestone{milestone})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, convertToMilestone(milestone, progress[milestone.ID]))
+}
+
+func convertToMilestone(milestone *db.Milestone, progress service.MilestoneProgress) generated.Milestone {
+	return generated.Milestone{
+		Id:          int(milestone.ID),
+		ProjectId:   int(milestone.ProjectID),
+		Name:        milestone.Name,
+		Description: milestone.Description,
+		StartDate:   openapi_types.Date{Time: milestone.StartDate.Time},
+		EndDate:     openapi_types.Date{Time: milestone.EndDate.Time},
+		CreatedAt:   milestone.CreatedAt.Time,
+		Progress: generated.MilestoneProgress{
+			Total:                    int(progress.Total),
+			Completed:                int(progress.Completed),
+			EstimateMinutes:          int(progress.EstimateMinutes),
+			CompletedEstimateMinutes: int(progress.CompletedEstimateMinutes),
+		},
+	}
+}
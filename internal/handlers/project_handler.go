@@ -0,0 +1,205 @@
+package handlers
+
+import (
+	"net/http"
+
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ProjectHandler struct {
+	service service.ProjectService
+}
+
+func NewProjectHandler(svc service.ProjectService) *ProjectHandler {
+	return &ProjectHandler{
+		service: svc,
+	}
+}
+
+// GetProjects получить проекты текущего пользователя
+func (h *ProjectHandler) GetProjects(ctx echo.Context) error {
+	rows, err := h.service.ListProjects(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	projects := make([]generated.Project, len(rows))
+	for i, row := range rows {
+		projects[i] = convertToProject(db.Project{
+			ID:        row.ID,
+			Name:      row.Name,
+			CreatedBy: row.CreatedBy,
+			CreatedAt: row.CreatedAt,
+		}, auth.Role(row.Role))
+	}
+	return ctx.JSON(http.StatusOK, generated.ProjectList{Projects: projects})
+}
+
+// PostProjects создать проект
+func (h *ProjectHandler) PostProjects(ctx echo.Context) error {
+	var req generated.CreateProjectRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	project, err := h.service.CreateProject(ctx.Request().Context(), req.Name)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToProject(*project, auth.RoleOwner))
+}
+
+// GetProjectsId получить проект
+func (h *ProjectHandler) GetProjectsId(ctx echo.Context, id int) error {
+	project, role, err := h.service.GetProject(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToProject(*project, role))
+}
+
+// DeleteProjectsId удалить проект вместе с задачами
+func (h *ProjectHandler) DeleteProjectsId(ctx echo.Context, id int) error {
+	if err := h.service.DeleteProject(ctx.Request().Context(), int32(id)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetProjectsIdMembers получить участников проекта
+func (h *ProjectHandler) GetProjectsIdMembers(ctx echo.Context, id int) error {
+	rows, err := h.service.ListMembers(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	members := make([]generated.ProjectMember, len(rows))
+	for i, member := range rows {
+		members[i] = convertToProjectMember(*member)
+	}
+	return ctx.JSON(http.StatusOK, generated.ProjectMemberList{Members: members})
+}
+
+// PutProjectsIdMembersUserId изменить роль участника
+func (h *ProjectHandler) PutProjectsIdMembersUserId(ctx echo.Context, id int, userId int) error {
+	var req generated.UpdateProjectMemberRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	member, err := h.service.UpdateMemberRole(ctx.Request().Context(), int32(id), int32(userId), auth.Role(req.Role))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToProjectMember(*member))
+}
+
+// DeleteProjectsIdMembersUserId исключить участника или выйти из проекта
+func (h *ProjectHandler) DeleteProjectsIdMembersUserId(ctx echo.Context, id int, userId int) error {
+	if err := h.service.RemoveMember(ctx.Request().Context(), int32(id), int32(userId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetProjectsIdInvitations получить приглашения проекта
+func (h *ProjectHandler) GetProjectsIdInvitations(ctx echo.Context, id int) error {
+	invitations, err := h.service.ListProjectInvitations(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToInvitationList(invitations))
+}
+
+// PostProjectsIdInvitations пригласить пользователя в проект
+func (h *ProjectHandler) PostProjectsIdInvitations(ctx echo.Context, id int) error {
+	var req generated.CreateInvitationRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	invitation, err := h.service.InviteMember(ctx.Request().Context(), int32(id), int32(req.UserId), auth.Role(req.Role))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToInvitation(*invitation))
+}
+
+// GetInvitations получить приглашения текущего пользователя
+func (h *ProjectHandler) GetInvitations(ctx echo.Context) error {
+	invitations, err := h.service.ListInvitations(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToInvitationList(invitations))
+}
+
+// DeleteInvitationsId отклонить или отозвать приглашение
+func (h *ProjectHandler) DeleteInvitationsId(ctx echo.Context, id int) error {
+	if err := h.service.DeleteInvitation(ctx.Request().Context(), int32(id)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// PostInvitationsIdAccept принять приглашение
+func (h *ProjectHandler) PostInvitationsIdAccept(ctx echo.Context, id int) error {
+	member, err := h.service.AcceptInvitation(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToProjectMember(*member))
+}
+
+func convertToProject(project db.Project, role auth.Role) generated.Project {
+	return generated.Project{
+		Id:        int(project.ID),
+		Name:      project.Name,
+		CreatedBy: int(project.CreatedBy),
+		Role:      generated.ProjectRole(role),
+		CreatedAt: project.CreatedAt.Time,
+	}
+}
+
+func convertToProjectMember(member db.ProjectMember) generated.ProjectMember {
+	return generated.ProjectMember{
+		ProjectId: int(member.ProjectID),
+		UserId:    int(member.UserID),
+		Role:      generated.ProjectRole(member.Role),
+		CreatedAt: member.CreatedAt.Time,
+	}
+}
+
+func convertToInvitation(invitation db.ProjectInvitation) generated.Invitation {
+	return generated.Invitation{
+		Id:        int(invitation.ID),
+		ProjectId: int(invitation.ProjectID),
+		UserId:    int(invitation.UserID),
+		Role:      generated.ProjectRole(invitation.Role),
+		InvitedBy: int(invitation.InvitedBy),
+		CreatedAt: invitation.CreatedAt.Time,
+	}
+}
+
+func convertToInvitationList(invitations []*db.ProjectInvitation) generated.InvitationList {
+	list := make([]generated.Invitation, len(invitations))
+	for i, invitation := range invitations {
+		list[i] = convertToInvitation(*invitation)
+	}
+	return generated.InvitationList{Invitations: list}
+}
@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ReminderHandler struct {
+	service service.ReminderService
+}
+
+func NewReminderHandler(svc service.ReminderService) *ReminderHandler {
+	return &ReminderHandler{
+		service: svc,
+	}
+}
+
+// GetTasksIdReminders получить свои напоминания о задаче
+func (h *ReminderHandler) GetTasksIdReminders(ctx echo.Context, id int) error {
+	reminders, err := h.service.ListReminders(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	result := make([]generated.Reminder, len(reminders))
+	for i, reminder := range reminders {
+		result[i] = convertToReminder(*reminder)
+	}
+	return ctx.JSON(http.StatusOK, generated.ReminderList{Reminders: result})
+}
+
+// PostTasksIdReminders поставить напоминание о задаче
+func (h *ReminderHandler) PostTasksIdReminders(ctx echo.Context, id int) error {
+	var req generated.CreateReminderRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	var offset *int32
+	if req.OffsetMinutes != nil {
+		minutes := int32(*req.OffsetMinutes)
+		offset = &minutes
+	}
+	email := ""
+	if req.Email != nil {
+		email = string(*req.Email)
+	}
+	reminder, err := h.service.CreateReminder(ctx.Request().Context(), int32(id), req.RemindAt, offset, email)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToReminder(*reminder))
+}
+
+// DeleteTasksIdRemindersReminderId удалить свое напоминание
+func (h *ReminderHandler) DeleteTasksIdRemindersReminderId(ctx echo.Context, id int, reminderId int) error {
+	if err := h.service.DeleteReminder(ctx.Request().Context(), int32(id), int32(reminderId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+func convertToReminder(reminder db.ListRemindersRow) generated.Reminder {
+	var offset *int
+	if reminder.OffsetMinutes.Valid {
+		minutes := int(reminder.OffsetMinutes.Int32)
+		offset = &minutes
+	}
+
+	return generated.Reminder{
+		Id:            int(reminder.ID),
+		TaskId:        int(reminder.TaskID),
+		RemindAt:      timePtr(reminder.RemindAt),
+		OffsetMinutes: offset,
+		FireAt:        timePtr(reminder.FireAt),
+		Email:         reminder.Email,
+		SentAt:        timePtr(reminder.SentAt),
+		CreatedAt:     reminder.CreatedAt.Time,
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import "GreatProject/internal/generated"
+
+// Server реализация generated.ServerInterface, собранная из обработчиков по ресурсам
+type Server struct {
+	*TaskHandler
+	*CommentHandler
+	*AttachmentHandler
+	*DependencyHandler
+	*ChecklistHandler
+	*CustomFieldHandler
+	*TimeEntryHandler
+	*ReminderHandler
+	*TemplateHandler
+	*MilestoneHandler
+	*StatsHandler
+	*ProjectHandler
+	*APIKeyHandler
+	*HealthHandler
+}
+
+func NewServer(tasks *TaskHandler, comments *CommentHandler, attachments *AttachmentHandler, dependencies *DependencyHandler, checklists *ChecklistHandler, customFields *CustomFieldHandler, timeEntries *TimeEntryHandler, reminders *ReminderHandler, templates *TemplateHandler, milestones *MilestoneHandler, stats *StatsHandler, projects *ProjectHandler, apiKeys *APIKeyHandler, health *HealthHandler) *Server {
+	return &Server{
+		TaskHandler:        tasks,
+		CommentHandler:     comments,
+		AttachmentHandler:  attachments,
+		DependencyHandler:  dependencies,
+		ChecklistHandler:   checklists,
+		CustomFieldHandler: customFields,
+		TimeEntryHandler:   timeEntries,
+		ReminderHandler:    reminders,
+		TemplateHandler:    templates,
+		MilestoneHandler:   milestones,
+		StatsHandler:       stats,
+		ProjectHandler:     projects,
+		APIKeyHandler:      apiKeys,
+		HealthHandler:      health,
+	}
+}
+
+var _ generated.ServerInterface = (*Server)(nil)
@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"net/http"
+
+	"GreatProject/internal/generated"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type StatsHandler struct {
+	service service.StatsService
+}
+
+func NewStatsHandler(svc service.StatsService) *StatsHandler {
+	return &StatsHandler{
+		service: svc,
+	}
+}
+
+// GetStats получить статистику задач
+func (h *StatsHandler) GetStats(ctx echo.Context, params generated.GetStatsParams) error {
+	var query service.StatsQuery
+	if params.ProjectId != nil {
+		query.ProjectID = int32(*params.ProjectId)
+	}
+	if params.From != nil {
+		query.From = params.From.Time
+	}
+	if params.To != nil {
+		query.To = params.To.Time
+	}
+	if params.Period != nil {
+		query.Period = repository.StatsPeriod(*params.Period)
+	}
+	stats, err := h.service.Stats(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	projects := make([]generated.ProjectStats, len(stats.Projects))
+	for i, row := range stats.Projects {
+		projects[i] = generated.ProjectStats{
+			ProjectId: intPtr(row.ProjectID.Int32, row.ProjectID.Valid),
+			Total:     int(row.Total),
+			Completed: int(row.Completed),
+			Overdue:   int(row.Overdue),
+		}
+	}
+	tags := make([]generated.TagStats, len(stats.Tags))
+	for i, row := range stats.Tags {
+		tags[i] = generated.TagStats{
+			Tag:       row.Tag,
+			Total:     int(row.Total),
+			Completed: int(row.Completed),
+			Overdue:   int(row.Overdue),
+		}
+	}
+	series := make([]generated.StatsPoint, len(stats.Series))
+	for i, row := range stats.Series {
+		series[i] = generated.StatsPoint{
+			Period:    openapi_types.Date{Time: row.Period.Time},
+			Created:   int(row.Created),
+			Completed: int(row.Completed),
+		}
+	}
+	var average *float64
+	if stats.Totals.AverageCompletionSeconds.Valid {
+		average = &stats.Totals.AverageCompletionSeconds.Float64
+	}
+
+	return ctx.JSON(http.StatusOK, generated.Stats{
+		From:   openapi_types.Date{Time: stats.From},
+		To:     openapi_types.Date{Time: stats.To},
+		Period: generated.StatsPeriod(stats.Period),
+		Totals: generated.StatsTotals{
+			Total:     int(stats.Totals.Total),
+			Completed: int(stats.Totals.Completed),
+			Pending:   int(stats.Totals.Total - stats.Totals.Completed),
+			Overdue:   int(stats.Totals.Overdue),
+		},
+		ByProject:                projects,
+		ByTag:                    tags,
+		Series:                   series,
+		AverageCompletionSeconds: average,
+		Streaks: generated.CompletionStreaks{
+			CurrentDays: int(stats.Streaks.CurrentDays),
+			LongestDays: int(stats.Streaks.LongestDays),
+		},
+	})
+}
@@ -1,12 +1,14 @@
 package handlers
 
 import (
-	"context"
-	"errors"
+	"encoding/json"
 	"net/http"
+	"strings"
+	"time"
 
 	db "GreatProject/internal/database"
 	"GreatProject/internal/generated"
+	"GreatProject/internal/repository"
 	"GreatProject/internal/service"
 
 	"github.com/labstack/echo/v4"
@@ -22,14 +24,6 @@ func NewTaskHandler(svc service.TaskService) *TaskHandler {
 	}
 }
 
-// GetHealth проверка здоровья сервиса
-func (h *TaskHandler) GetHealth(ctx echo.Context) error {
-	return ctx.JSON(http.StatusOK, map[string]string{
-		"status":  "ok",
-		"service": "todo-api",
-	})
-}
-
 // GetTasks получить все задачи
 func (h *TaskHandler) GetTasks(ctx echo.Context, params generated.GetTasksParams) error {
 	limit := int32(50)
@@ -42,55 +36,62 @@ func (h *TaskHandler) GetTasks(ctx echo.Context, params generated.GetTasksParams
 		offset = int32(*params.Offset)
 	}
 
-	tasks, err := h.service.GetAllTasks(context.Background(), limit, offset)
-	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, generated.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch tasks",
-		})
-	}
+	filter := taskFilter(params.Sort, params.Field)
 
-	// Конвертируем в формат API
-	apiTasks := make([]generated.Task, len(tasks))
-	for i, task := range tasks {
-		apiTasks[i] = h.convertToAPITask(*task)
+	var tasks []*db.Task
+	var total int64
+	var err error
+	if params.Completed != nil {
+		tasks, err = h.service.GetTasksByStatus(ctx.Request().Context(), *params.Completed, filter, limit, offset)
+		if err == nil {
+			total, err = h.service.CountTasksByStatus(ctx.Request().Context(), *params.Completed, filter)
+		}
+	} else {
+		tasks, err = h.service.GetAllTasks(ctx.Request().Context(), filter, limit, offset)
+		if err == nil {
+			total, err = h.service.CountTasks(ctx.Request().Context(), filter)
+		}
+	}
+	if err != nil {
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, apiTasks)
+	return h.taskListResponse(ctx, tasks, total, limit, offset)
 }
 
 // PostTasks создать новую задачу
 func (h *TaskHandler) PostTasks(ctx echo.Context) error {
 	var req generated.CreateTaskRequest
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, generated.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-		})
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	projectID := int32(0)
+	if req.ProjectId != nil {
+		projectID = int32(*req.ProjectId)
+	}
+	var milestone *int32
+	if req.MilestoneId != nil {
+		id := int32(*req.MilestoneId)
+		milestone = &id
 	}
 
-	// Создаем задачу через сервис (валидация внутри)
-	task, err := h.service.CreateTask(context.Background(), req.Name, req.Description)
+	task, err := h.service.CreateTask(ctx.Request().Context(), projectID, service.TaskInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		AssigneeIDs:     assigneeIDs(req.AssigneeIds),
+		CustomFields:    customFields(req.CustomFields),
+		EstimateMinutes: estimateMinutes(req.EstimateMinutes),
+		DueAt:           req.DueAt,
+		Tags:            tags(req.Tags),
+		ParentID:        parentID(req.ParentId),
+		MilestoneID:     milestone,
+	})
 	if err != nil {
-		if errors.Is(err, service.ErrEmptyTaskName) {
-			return ctx.JSON(http.StatusBadRequest, generated.Error{
-				Code:    "VALIDATION_ERROR",
-				Message: "Task name is required",
-			})
-		}
-		if errors.Is(err, service.ErrInvalidTaskData) {
-			return ctx.JSON(http.StatusBadRequest, generated.Error{
-				Code:    "VALIDATION_ERROR",
-				Message: "Task name is too long",
-			})
-		}
-		return ctx.JSON(http.StatusInternalServerError, generated.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to create task",
-		})
+		return err
 	}
 
-	return ctx.JSON(http.StatusCreated, h.convertToAPITask(*task))
+	return h.taskResponse(ctx, http.StatusCreated, task)
 }
 
 // GetTasksCompleted получить выполненные задачи
@@ -105,20 +106,17 @@ func (h *TaskHandler) GetTasksCompleted(ctx echo.Context, params generated.GetTa
 		offset = int32(*params.Offset)
 	}
 
-	tasks, err := h.service.GetCompletedTasks(context.Background(), limit, offset)
+	tasks, err := h.service.GetCompletedTasks(ctx.Request().Context(), limit, offset)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, generated.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch completed tasks",
-		})
+		return err
 	}
 
-	apiTasks := make([]generated.Task, len(tasks))
-	for i, task := range tasks {
-		apiTasks[i] = h.convertToAPITask(*task)
+	total, err := h.service.CountTasksByStatus(ctx.Request().Context(), true, repository.TaskFilter{})
+	if err != nil {
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, apiTasks)
+	return h.taskListResponse(ctx, tasks, total, limit, offset)
 }
 
 // GetTasksPending получить невыполненные задачи
@@ -133,104 +131,158 @@ func (h *TaskHandler) GetTasksPending(ctx echo.Context, params generated.GetTask
 		offset = int32(*params.Offset)
 	}
 
-	tasks, err := h.service.GetPendingTasks(context.Background(), limit, offset)
+	tasks, err := h.service.GetPendingTasks(ctx.Request().Context(), limit, offset)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, generated.Error{
-			Code:    "INTERNAL_ERROR",
-			Message: "Failed to fetch pending tasks",
-		})
+		return err
 	}
 
-	apiTasks := make([]generated.Task, len(tasks))
-	for i, task := range tasks {
-		apiTasks[i] = h.convertToAPITask(*task)
+	total, err := h.service.CountTasksByStatus(ctx.Request().Context(), false, repository.TaskFilter{})
+	if err != nil {
+		return err
+	}
+
+	return h.taskListResponse(ctx, tasks, total, limit, offset)
+}
+
+// GetMeTasks получить задачи, назначенные на текущего пользователя
+func (h *TaskHandler) GetMeTasks(ctx echo.Context, params generated.GetMeTasksParams) error {
+	limit, offset := page(params.Limit, params.Offset)
+	filter := taskFilter(params.Sort, params.Field)
+
+	tasks, err := h.service.GetAssignedTasks(ctx.Request().Context(), params.Completed, filter, limit, offset)
+	if err != nil {
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, apiTasks)
+	total, err := h.service.CountAssignedTasks(ctx.Request().Context(), params.Completed, filter)
+	if err != nil {
+		return err
+	}
+
+	return h.taskListResponse(ctx, tasks, total, limit, offset)
 }
 
 // GetTasksId получить задачу по ID
 func (h *TaskHandler) GetTasksId(ctx echo.Context, id int) error {
-	task, err := h.service.GetTaskByID(context.Background(), int32(id))
+	task, err := h.service.GetTaskByID(ctx.Request().Context(), int32(id))
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, generated.Error{
-			Code:    "TASK_NOT_FOUND",
-			Message: "Task not found",
-		})
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, h.convertToAPITask(*task))
+	return h.taskResponse(ctx, http.StatusOK, task)
 }
 
 // PutTasksId обновить задачу
 func (h *TaskHandler) PutTasksId(ctx echo.Context, id int) error {
 	var req generated.UpdateTaskRequest
-	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, generated.Error{
-			Code:    "INVALID_REQUEST",
-			Message: "Invalid request body",
-		})
+	if err := bindBody(ctx, &req); err != nil {
+		return err
 	}
 
-	// Обновляем задачу через сервис (валидация внутри)
-	task, err := h.service.UpdateTask(context.Background(), int32(id), req.Name, req.Description, req.Completed)
+	dueAt, err := dueAt(req.DueAt)
 	if err != nil {
-		if errors.Is(err, service.ErrEmptyTaskName) || errors.Is(err, service.ErrInvalidTaskData) {
-			return ctx.JSON(http.StatusBadRequest, generated.Error{
-				Code:    "VALIDATION_ERROR",
-				Message: err.Error(),
-			})
-		}
-		return ctx.JSON(http.StatusNotFound, generated.Error{
-			Code:    "TASK_NOT_FOUND",
-			Message: "Task not found",
-		})
+		return err
+	}
+	milestoneID, err := milestoneID(req.MilestoneId)
+	if err != nil {
+		return err
+	}
+	task, err := h.service.UpdateTask(ctx.Request().Context(), int32(id), service.TaskInput{
+		Name:            req.Name,
+		Description:     req.Description,
+		Completed:       req.Completed,
+		AssigneeIDs:     assigneeIDs(req.AssigneeIds),
+		CustomFields:    customFields(req.CustomFields),
+		EstimateMinutes: estimateMinutes(req.EstimateMinutes),
+		DueAt:           dueAt,
+		Tags:            tags(req.Tags),
+		MilestoneID:     milestoneID,
+	})
+	if err != nil {
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, h.convertToAPITask(*task))
+	return h.taskResponse(ctx, http.StatusOK, task)
 }
 
 // DeleteTasksId удалить задачу
 func (h *TaskHandler) DeleteTasksId(ctx echo.Context, id int) error {
-	err := h.service.DeleteTask(context.Background(), int32(id))
-	if err != nil {
-		return ctx.JSON(http.StatusNotFound, generated.Error{
-			Code:    "TASK_NOT_FOUND",
-			Message: "Task not found",
-		})
+	if err := h.service.DeleteTask(ctx.Request().Context(), int32(id)); err != nil {
+		return err
 	}
 
 	return ctx.NoContent(http.StatusNoContent)
 }
 
 // PatchTasksIdComplete отметить задачу выполненной
-func (h *TaskHandler) PatchTasksIdComplete(ctx echo.Context, id int) error {
-	task, err := h.service.CompleteTask(context.Background(), int32(id))
+func (h *TaskHandler) PatchTasksIdComplete(ctx echo.Context, id int, params generated.PatchTasksIdCompleteParams) error {
+	force := params.Force != nil && *params.Force
+	task, err := h.service.CompleteTask(ctx.Request().Context(), int32(id), force)
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, generated.Error{
-			Code:    "TASK_NOT_FOUND",
-			Message: "Task not found",
-		})
+		return err
 	}
 
-	return ctx.JSON(http.StatusOK, h.convertToAPITask(*task))
+	return h.taskResponse(ctx, http.StatusOK, task)
 }
 
 // PatchTasksIdUncomplete снять отметку выполнения с задачи
 func (h *TaskHandler) PatchTasksIdUncomplete(ctx echo.Context, id int) error {
-	task, err := h.service.UncompleteTask(context.Background(), int32(id))
+	task, err := h.service.UncompleteTask(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return h.taskResponse(ctx, http.StatusOK, task)
+}
+
+// PostTasksIdMove переместить задачу в ее списке
+func (h *TaskHandler) PostTasksIdMove(ctx echo.Context, id int) error {
+	var req generated.MoveTaskRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	beforeID, afterID := int32(0), int32(0)
+	if req.BeforeId != nil {
+		beforeID = int32(*req.BeforeId)
+	}
+	if req.AfterId != nil {
+		afterID = int32(*req.AfterId)
+	}
+	task, err := h.service.MoveTask(ctx.Request().Context(), int32(id), beforeID, afterID)
+	if err != nil {
+		return err
+	}
+
+	return h.taskResponse(ctx, http.StatusOK, task)
+}
+
+// taskResponse отдает задачу вместе с ее вычисляемыми свойствами
+func (h *TaskHandler) taskResponse(ctx echo.Context, status int, task *db.Task) error {
+	details, err := h.service.Details(ctx.Request().Context(), []*db.Task{task})
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, generated.Error{
-			Code:    "TASK_NOT_FOUND",
-			Message: "Task not found",
-		})
+		return err
 	}
+	return ctx.JSON(status, convertToAPITask(*task, details[task.ID]))
+}
 
-	return ctx.JSON(http.StatusOK, h.convertToAPITask(*task))
+// taskListResponse отдает страницу задач в формате API
+func (h *TaskHandler) taskListResponse(ctx echo.Context, tasks []*db.Task, total int64, limit, offset int32) error {
+	details, err := h.service.Details(ctx.Request().Context(), tasks)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, generated.TaskList{
+		Tasks:  convertToAPITasks(tasks, details),
+		Total:  int(total),
+		Limit:  int(limit),
+		Offset: int(offset),
+	})
 }
 
 // convertToAPITask конвертирует модель БД в API модель
-func (h *TaskHandler) convertToAPITask(task db.Task) generated.Task {
+func convertToAPITask(task db.Task, details service.TaskDetails) generated.Task {
 	description := ""
 	if task.Description.Valid {
 		description = task.Description.String
@@ -241,12 +293,160 @@ func (h *TaskHandler) convertToAPITask(task db.Task) generated.Task {
 		completed = task.Completed.Bool
 	}
 
+	assigneeIDs := make([]int, len(details.AssigneeIDs))
+	for i, id := range details.AssigneeIDs {
+		assigneeIDs[i] = int(id)
+	}
+
+	// custom_fields - JSONB, поэтому всегда корректный JSON-объект
+	fields := map[string]any{}
+	_ = json.Unmarshal(task.CustomFields, &fields)
+
+	var projectID *int
+	if task.ProjectID.Valid {
+		id := int(task.ProjectID.Int32)
+		projectID = &id
+	}
+
+	var parentID *int
+	if task.ParentID.Valid {
+		id := int(task.ParentID.Int32)
+		parentID = &id
+	}
+
+	var estimate *int
+	if task.EstimateMinutes.Valid {
+		minutes := int(task.EstimateMinutes.Int32)
+		estimate = &minutes
+	}
+
 	return generated.Task{
-		Id:          int(task.ID),
-		Name:        task.Name,
-		Description: description,
-		Completed:   completed,
-		CreatedAt:   task.CreatedAt,
-		UpdatedAt:   task.UpdatedAt,
+		Id:              int(task.ID),
+		Name:            task.Name,
+		Description:     description,
+		Completed:       completed,
+		CreatedAt:       task.CreatedAt,
+		UpdatedAt:       task.UpdatedAt,
+		ProjectId:       projectID,
+		Position:        task.Position,
+		AssigneeIds:     assigneeIDs,
+		CustomFields:    fields,
+		EstimateMinutes: estimate,
+		DueAt:           timePtr(task.DueAt),
+		ParentId:        parentID,
+		Tags:            task.Tags,
+		MilestoneId:     intPtr(task.MilestoneID.Int32, task.MilestoneID.Valid),
+		CompletedAt:     timePtr(task.CompletedAt),
+		Blocked:         details.Blocked,
+		Checklist: generated.ChecklistProgress{
+			Total:   int(details.ChecklistTotal),
+			Checked: int(details.ChecklistChecked),
+		},
+	}
+}
+
+func convertToAPITasks(tasks []*db.Task, details map[int32]service.TaskDetails) []generated.Task {
+	apiTasks := make([]generated.Task, len(tasks))
+	for i, task := range tasks {
+		apiTasks[i] = convertToAPITask(*task, details[task.ID])
+	}
+	return apiTasks
+}
+
+// assigneeIDs исполнители из запроса; nil - поле не передано
+func assigneeIDs(ids *[]int) []int32 {
+	if ids == nil {
+		return nil
+	}
+	result := make([]int32, len(*ids))
+	for i, id := range *ids {
+		result[i] = int32(id)
+	}
+	return result
+}
+
+// customFields значения пользовательских полей из запроса; nil - поле не передано
+func customFields(values *map[string]any) map[string]any {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+// tags метки из запроса; nil - поле не передано
+func tags(values *[]string) []string {
+	if values == nil {
+		return nil
+	}
+	return *values
+}
+
+// parentID родительская задача из запроса; 0 - не указана
+func parentID(id *int) int32 {
+	if id == nil {
+		return 0
+	}
+	return int32(*id)
+}
+
+// estimateMinutes оценка из запроса; nil - поле не передано
+func estimateMinutes(minutes *int) *int32 {
+	if minutes == nil {
+		return nil
+	}
+	value := int32(*minutes)
+	return &value
+}
+
+// dueAt срок из запроса на изменение: nil - поле не передано, нулевое время - null (снять срок).
+// Формат значения проверен по спецификации
+func dueAt(raw json.RawMessage) (*time.Time, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var due *time.Time
+	if err := json.Unmarshal(raw, &due); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid request body").SetInternal(err)
+	}
+	if due == nil {
+		return &time.Time{}, nil
+	}
+	return due, nil
+}
+
+// milestoneID веха из запроса на изменение: nil - поле не передано, 0 - null (убрать из вехи).
+// Формат значения проверен по спецификации
+func milestoneID(raw json.RawMessage) (*int32, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var id *int32
+	if err := json.Unmarshal(raw, &id); err != nil {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "Invalid request body").SetInternal(err)
+	}
+	if id == nil {
+		return new(int32), nil
+	}
+	return id, nil
+}
+
+// taskFilter порядок и отбор списка задач из параметров sort и field; их формат проверен по спецификации
+func taskFilter(sort *string, fields *[]string) repository.TaskFilter {
+	filter := repository.TaskFilter{Sort: repository.SortCreated}
+	if sort != nil {
+		if key, ok := strings.CutPrefix(*sort, "field."); ok {
+			filter.Sort, filter.SortField = repository.SortField, key
+		} else if key, ok := strings.CutPrefix(*sort, "-field."); ok {
+			filter.Sort, filter.SortField = repository.SortFieldDesc, key
+		} else {
+			filter.Sort = repository.TaskSort(*sort)
+		}
+	}
+	if fields != nil {
+		for _, field := range *fields {
+			key, value, _ := strings.Cut(field, ":")
+			filter.Fields = append(filter.Fields, repository.FieldFilter{Key: key, Value: value})
+		}
 	}
+	return filter
 }
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+)
+
+type TemplateHandler struct {
+	service service.TemplateService
+}
+
+func NewTemplateHandler(svc service.TemplateService) *TemplateHandler {
+	return &TemplateHandler{
+		service: svc,
+	}
+}
+
+// GetTemplates получить шаблоны задач
+func (h *TemplateHandler) GetTemplates(ctx echo.Context, params generated.GetTemplatesParams) error {
+	projectID := int32(0)
+	if params.ProjectId != nil {
+		projectID = int32(*params.ProjectId)
+	}
+	templates, err := h.service.ListTemplates(ctx.Request().Context(), projectID)
+	if err != nil {
+		return err
+	}
+
+	result := make([]generated.Template, len(templates))
+	for i, template := range templates {
+		if result[i], err = convertToTemplate(template); err != nil {
+			return err
+		}
+	}
+	return ctx.JSON(http.StatusOK, generated.TemplateList{Templates: result})
+}
+
+// PostTemplates создать шаблон
+func (h *TemplateHandler) PostTemplates(ctx echo.Context) error {
+	var req generated.CreateTemplateRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	projectID := int32(0)
+	if req.ProjectId != nil {
+		projectID = int32(*req.ProjectId)
+	}
+	description := ""
+	if req.Description != nil {
+		description = *req.Description
+	}
+	template, err := h.service.CreateTemplate(ctx.Request().Context(), projectID, service.TemplateInput{
+		Name:        req.Name,
+		Description: description,
+		Tasks:       templateTasks(req.Tasks),
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.templateResponse(ctx, http.StatusCreated, template)
+}
+
+// GetTemplatesId получить шаблон
+func (h *TemplateHandler) GetTemplatesId(ctx echo.Context, id int) error {
+	template, err := h.service.GetTemplate(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return h.templateResponse(ctx, http.StatusOK, template)
+}
+
+// PutTemplatesId изменить шаблон
+func (h *TemplateHandler) PutTemplatesId(ctx echo.Context, id int) error {
+	var req generated.UpdateTemplateRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	template, err := h.service.UpdateTemplate(ctx.Request().Context(), int32(id), service.TemplateInput{
+		Name:        req.Name,
+		Description: req.Description,
+		Tasks:       templateTasks(req.Tasks),
+	})
+	if err != nil {
+		return err
+	}
+
+	return h.templateResponse(ctx, http.StatusOK, template)
+}
+
+// DeleteTemplatesId удалить шаблон
+func (h *TemplateHandler) DeleteTemplatesId(ctx echo.Context, id int) error {
+	if err := h.service.DeleteTemplate(ctx.Request().Context(), int32(id)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// PostTemplatesIdInstantiate создать задачи по шаблону
+func (h *TemplateHandler) PostTemplatesIdInstantiate(ctx echo.Context, id int) error {
+	var req generated.InstantiateTemplateRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	var variables map[string]string
+	if req.Variables != nil {
+		variables = *req.Variables
+	}
+	startAt := time.Now()
+	if req.StartAt != nil {
+		startAt = *req.StartAt
+	}
+	tasks, err := h.service.Instantiate(ctx.Request().Context(), int32(id), variables, startAt)
+	if err != nil {
+		return err
+	}
+
+	// у только что созданных задач нет ни исполнителей, ни зависимостей, ни чек-листов
+	return ctx.JSON(http.StatusCreated, generated.TemplateInstance{
+		Tasks: convertToAPITasks(tasks, nil),
+	})
+}
+
+func (h *TemplateHandler) templateResponse(ctx echo.Context, status int, template *db.TaskTemplate) error {
+	result, err := convertToTemplate(template)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(status, result)
+}
+
+func convertToTemplate(template *db.TaskTemplate) (generated.Template, error) {
+	tasks, err := service.ParseTemplate(template)
+	if err != nil {
+		return generated.Template{}, err
+	}
+
+	var projectID *int
+	if template.ProjectID.Valid {
+		id := int(template.ProjectID.Int32)
+		projectID = &id
+	}
+
+	return generated.Template{
+		Id:          int(template.ID),
+		ProjectId:   projectID,
+		OwnerId:     int(template.OwnerID),
+		Name:        template.Name,
+		Description: template.Description,
+		Tasks:       convertToTemplateTasks(tasks),
+		Variables:   service.TemplateVariables(tasks),
+		CreatedAt:   template.CreatedAt.Time,
+		UpdatedAt:   template.UpdatedAt.Time,
+	}, nil
+}
+
+func convertToTemplateTasks(tasks []service.TemplateTask) []generated.TemplateTask {
+	result := make([]generated.TemplateTask, len(tasks))
+	for i, task := range tasks {
+		result[i] = generated.TemplateTask{Name: task.Name}
+		if task.Description != "" {
+			result[i].Description = &task.Description
+		}
+		if len(task.Tags) > 0 {
+			result[i].Tags = &task.Tags
+		}
+		if task.DueOffsetMinutes != nil {
+			minutes := int(*task.DueOffsetMinutes)
+			result[i].DueOffsetMinutes = &minutes
+		}
+		if len(task.Subtasks) > 0 {
+			subtasks := convertToTemplateTasks(task.Subtasks)
+			result[i].Subtasks = &subtasks
+		}
+	}
+	return result
+}
+
+// templateTasks дерево задач шаблона из запроса
+func templateTasks(tasks []generated.TemplateTask) []service.TemplateTask {
+	result := make([]service.TemplateTask, len(tasks))
+	for i, task := range tasks {
+		result[i] = service.TemplateTask{Name: task.Name, Tags: tags(task.Tags)}
+		if task.DueOffsetMinutes != nil {
+			minutes := int32(*task.DueOffsetMinutes)
+			result[i].DueOffsetMinutes = &minutes
+		}
+		if task.Description != nil {
+			result[i].Description = *task.Description
+		}
+		if task.Subtasks != nil {
+			result[i].Subtasks = templateTasks(*task.Subtasks)
+		}
+	}
+	return result
+}
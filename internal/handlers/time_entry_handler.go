@@ -0,0 +1,277 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/generated"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/service"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type TimeEntryHandler struct {
+	service service.TimeService
+}
+
+func NewTimeEntryHandler(svc service.TimeService) *TimeEntryHandler {
+	return &TimeEntryHandler{
+		service: svc,
+	}
+}
+
+// GetTasksIdTimeEntries получить записи времени задачи и итог
+func (h *TimeEntryHandler) GetTasksIdTimeEntries(ctx echo.Context, id int) error {
+	entries, totals, err := h.service.ListEntries(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	result := make([]generated.TimeEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = convertToTimeEntry(*entry, now)
+	}
+	return ctx.JSON(http.StatusOK, generated.TimeEntryList{
+		Entries: result,
+		Totals:  convertToTaskTime(*totals),
+	})
+}
+
+// PostTasksIdTimeEntries учесть время вручную
+func (h *TimeEntryHandler) PostTasksIdTimeEntries(ctx echo.Context, id int) error {
+	var req generated.CreateTimeEntryRequest
+	if err := bindBody(ctx, &req); err != nil {
+		return err
+	}
+
+	note := ""
+	if req.Note != nil {
+		note = *req.Note
+	}
+	duration := time.Duration(req.DurationMinutes) * time.Minute
+	entry, err := h.service.LogTime(ctx.Request().Context(), int32(id), duration, req.StartedAt, note)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToTimeEntry(*entry, time.Now()))
+}
+
+// PostTasksIdTimeEntriesStart запустить таймер на задаче
+func (h *TimeEntryHandler) PostTasksIdTimeEntriesStart(ctx echo.Context, id int) error {
+	// тело необязательно
+	var req generated.StartTimerRequest
+	if ctx.Request().ContentLength != 0 {
+		if err := bindBody(ctx, &req); err != nil {
+			return err
+		}
+	}
+
+	note := ""
+	if req.Note != nil {
+		note = *req.Note
+	}
+	entry, err := h.service.StartTimer(ctx.Request().Context(), int32(id), note)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusCreated, convertToTimeEntry(*entry, time.Now()))
+}
+
+// PostTasksIdTimeEntriesStop остановить свой таймер на задаче
+func (h *TimeEntryHandler) PostTasksIdTimeEntriesStop(ctx echo.Context, id int) error {
+	entry, err := h.service.StopTimer(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToTimeEntry(*entry, time.Now()))
+}
+
+// DeleteTasksIdTimeEntriesEntryId удалить свою запись времени
+func (h *TimeEntryHandler) DeleteTasksIdTimeEntriesEntryId(ctx echo.Context, id int, entryId int) error {
+	if err := h.service.DeleteEntry(ctx.Request().Context(), int32(id), int32(entryId)); err != nil {
+		return err
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetMeTimer получить свой идущий таймер
+func (h *TimeEntryHandler) GetMeTimer(ctx echo.Context) error {
+	entry, err := h.service.RunningTimer(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, convertToTimeEntry(*entry, time.Now()))
+}
+
+// GetProjectsIdTime получить оценки и учтенное время задач проекта
+func (h *TimeEntryHandler) GetProjectsIdTime(ctx echo.Context, id int) error {
+	totals, err := h.service.ProjectTime(ctx.Request().Context(), int32(id))
+	if err != nil {
+		return err
+	}
+
+	result := generated.ProjectTime{Tasks: make([]generated.TaskTime, len(totals))}
+	for i, total := range totals {
+		result.Tasks[i] = convertToTaskTime(*total)
+		result.EstimateMinutes += int64(total.EstimateMinutes)
+		result.TrackedSeconds += total.TrackedSeconds
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// GetReportsTimesheet получить табель в JSON или CSV
+func (h *TimeEntryHandler) GetReportsTimesheet(ctx echo.Context, params generated.GetReportsTimesheetParams) error {
+	groupBy := []generated.GetReportsTimesheetParamsGroupBy{generated.GetReportsTimesheetParamsGroupByDay}
+	if params.GroupBy != nil {
+		groupBy = *params.GroupBy
+	}
+	query := repository.TimesheetQuery{
+		From:      params.From.Time,
+		To:        params.To.Time.AddDate(0, 0, 1),
+		ByDay:     slices.Contains(groupBy, generated.GetReportsTimesheetParamsGroupByDay),
+		ByUser:    slices.Contains(groupBy, generated.GetReportsTimesheetParamsGroupByUser),
+		ByProject: slices.Contains(groupBy, generated.GetReportsTimesheetParamsGroupByProject),
+	}
+	if params.ProjectId != nil {
+		query.ProjectID = int32(*params.ProjectId)
+	}
+	if params.UserId != nil {
+		query.MemberID = int32(*params.UserId)
+	}
+
+	rows, err := h.service.Timesheet(ctx.Request().Context(), query)
+	if err != nil {
+		return err
+	}
+
+	if params.Format != nil && *params.Format == generated.Csv {
+		return writeTimesheetCSV(ctx, query, rows)
+	}
+
+	result := generated.Timesheet{Rows: make([]generated.TimesheetRow, len(rows))}
+	for i, row := range rows {
+		result.Rows[i] = convertToTimesheetRow(*row)
+		result.TotalSeconds += row.Seconds
+	}
+	return ctx.JSON(http.StatusOK, result)
+}
+
+// writeTimesheetCSV табель в CSV: столбцы группировки, затем секунды и часы
+func writeTimesheetCSV(ctx echo.Context, query repository.TimesheetQuery, rows []*db.TimesheetRow) error {
+	var header []string
+	if query.ByDay {
+		header = append(header, "day")
+	}
+	if query.ByUser {
+		header = append(header, "user_id")
+	}
+	if query.ByProject {
+		header = append(header, "project_id")
+	}
+	header = append(header, "seconds", "hours")
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+	response.Header().Set(echo.HeaderContentDisposition, `attachment; filename="timesheet.csv"`)
+	response.WriteHeader(http.StatusOK)
+
+	writer := csv.NewWriter(response)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		var record []string
+		if query.ByDay {
+			record = append(record, row.Day.Time.Format(time.DateOnly))
+		}
+		if query.ByUser {
+			record = append(record, strconv.Itoa(int(row.UserID.Int32)))
+		}
+		if query.ByProject {
+			// пустая ячейка - задачи вне проектов
+			project := ""
+			if row.ProjectID.Valid {
+				project = strconv.Itoa(int(row.ProjectID.Int32))
+			}
+			record = append(record, project)
+		}
+		record = append(record, strconv.FormatInt(row.Seconds, 10), fmt.Sprintf("%.2f", float64(row.Seconds)/3600))
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// convertToTimeEntry длительность идущего таймера считается до now
+func convertToTimeEntry(entry db.TaskTimeEntry, now time.Time) generated.TimeEntry {
+	end := now
+	var endedAt *time.Time
+	if entry.EndedAt.Valid {
+		end = entry.EndedAt.Time
+		endedAt = &entry.EndedAt.Time
+	}
+
+	return generated.TimeEntry{
+		Id:              int(entry.ID),
+		TaskId:          int(entry.TaskID),
+		UserId:          int(entry.UserID),
+		StartedAt:       entry.StartedAt.Time,
+		EndedAt:         endedAt,
+		DurationSeconds: int64(end.Sub(entry.StartedAt.Time).Seconds()),
+		Note:            entry.Note,
+	}
+}
+
+func convertToTaskTime(total service.TaskTime) generated.TaskTime {
+	var estimate *int
+	if total.EstimateMinutes != 0 {
+		minutes := int(total.EstimateMinutes)
+		estimate = &minutes
+	}
+
+	return generated.TaskTime{
+		TaskId:               int(total.TaskID),
+		EstimateMinutes:      estimate,
+		TrackedSeconds:       total.TrackedSeconds,
+		TotalEstimateMinutes: total.TotalEstimateMinutes,
+		TotalTrackedSeconds:  total.TotalTrackedSeconds,
+	}
+}
+
+func convertToTimesheetRow(row db.TimesheetRow) generated.TimesheetRow {
+	var day *openapi_types.Date
+	if row.Day.Valid {
+		day = &openapi_types.Date{Time: row.Day.Time}
+	}
+	var userID, projectID *int
+	if row.UserID.Valid {
+		id := int(row.UserID.Int32)
+		userID = &id
+	}
+	if row.ProjectID.Valid {
+		id := int(row.ProjectID.Int32)
+		projectID = &id
+	}
+
+	return generated.TimesheetRow{
+		Day:       day,
+		UserId:    userID,
+		ProjectId: projectID,
+		Seconds:   row.Seconds,
+	}
+}
@@ -0,0 +1,54 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Pinger источник проверки соединения с БД (*pgxpool.Pool)
+type Pinger interface {
+	Ping(ctx context.Context) error
+}
+
+// SchemaVersioner источник версии примененных миграций (db.Querier)
+type SchemaVersioner interface {
+	GetSchemaVersion(ctx context.Context) (int32, error)
+}
+
+// DatabaseCheck проверяет, что БД отвечает на ping
+func DatabaseCheck(db Pinger) Checker {
+	return CheckerFunc(db.Ping)
+}
+
+// SchemaCheck проверяет, что в БД применены миграции той версии, под которую собран код
+func SchemaCheck(source SchemaVersioner, expected int32) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		version, err := source.GetSchemaVersion(ctx)
+		if err != nil {
+			return err
+		}
+		if version != expected {
+			return fmt.Errorf("schema version %d, expected %d", version, expected)
+		}
+		return nil
+	})
+}
+
+// PoolCheck считает сервис не готовым, если занято не меньше maxUtilization (0..1] соединений пула:
+// новые запросы будут ждать соединение и упираться в таймауты
+func PoolCheck(pool *pgxpool.Pool, maxUtilization float64) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		stat := pool.Stat()
+		if stat.MaxConns() == 0 {
+			return nil
+		}
+
+		utilization := float64(stat.AcquiredConns()) / float64(stat.MaxConns())
+		if utilization >= maxUtilization {
+			return fmt.Errorf("connection pool saturated: %d of %d connections in use", stat.AcquiredConns(), stat.MaxConns())
+		}
+		return nil
+	})
+}
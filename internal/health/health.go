@@ -0,0 +1,172 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Статусы проверок и отчета
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// ErrShuttingDown сервис завершает работу и больше не принимает трафик
+var ErrShuttingDown = errors.New("server is shutting down")
+
+// Checker проверка одной зависимости; nil означает, что зависимость в порядке
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc позволяет использовать функцию как Checker
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// CheckResult результат одной проверки
+type CheckResult struct {
+	Status   string
+	Error    string
+	Duration time.Duration
+}
+
+// Report результат набора проверок
+type Report struct {
+	Status    string
+	Checks    map[string]CheckResult
+	CheckedAt time.Time
+}
+
+// Healthy все проверки прошли
+func (r Report) Healthy() bool {
+	return r.Status == StatusOK
+}
+
+// Config настройки проверок
+type Config struct {
+	// Timeout ограничение на каждую проверку
+	Timeout time.Duration
+	// CacheTTL сколько переиспользуется отчет /health, чтобы частые запросы не нагружали зависимости
+	CacheTTL time.Duration
+}
+
+type namedChecker struct {
+	name    string
+	checker Checker
+}
+
+// Service реестр проверок живости и готовности
+type Service struct {
+	cfg       Config
+	liveness  []namedChecker
+	readiness []namedChecker
+	draining  atomic.Bool
+
+	mu       sync.Mutex
+	cached   Report
+	cachedAt time.Time
+}
+
+func New(cfg Config) *Service {
+	return &Service{
+		cfg: cfg,
+	}
+}
+
+// AddLivenessCheck проверка для /livez: провал означает, что процесс нужно перезапустить
+func (s *Service) AddLivenessCheck(name string, checker Checker) {
+	s.liveness = append(s.liveness, namedChecker{name: name, checker: checker})
+}
+
+// AddReadinessCheck проверка для /readyz: провал означает, что на сервис не нужно слать трафик
+func (s *Service) AddReadinessCheck(name string, checker Checker) {
+	s.readiness = append(s.readiness, namedChecker{name: name, checker: checker})
+}
+
+// Shutdown переводит готовность в failing, чтобы балансировщик снял сервис до остановки сервера
+func (s *Service) Shutdown() {
+	s.draining.Store(true)
+}
+
+// Live выполняет проверки живости
+func (s *Service) Live(ctx context.Context) Report {
+	return s.run(ctx, s.liveness)
+}
+
+// Ready выполняет проверки готовности; во время graceful shutdown всегда failing
+func (s *Service) Ready(ctx context.Context) Report {
+	report := s.run(ctx, s.readiness)
+	if s.draining.Load() {
+		report.Status = StatusError
+		report.Checks["shutdown"] = CheckResult{Status: StatusError, Error: ErrShuttingDown.Error()}
+	}
+	return report
+}
+
+// Cached отчет готовности, переиспользуемый в течение CacheTTL
+func (s *Service) Cached(ctx context.Context) Report {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.cachedAt.IsZero() && time.Since(s.cachedAt) < s.cfg.CacheTTL && !s.draining.Load() {
+		return s.cached
+	}
+
+	s.cached = s.Ready(ctx)
+	s.cachedAt = time.Now()
+	return s.cached
+}
+
+// run выполняет проверки параллельно, каждую со своим таймаутом
+func (s *Service) run(ctx context.Context, checkers []namedChecker) Report {
+	report := Report{
+		Status:    StatusOK,
+		Checks:    make(map[string]CheckResult, len(checkers)),
+		CheckedAt: time.Now().UTC(),
+	}
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	for _, c := range checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result := s.check(ctx, c.checker)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[c.name] = result
+			if result.Status != StatusOK {
+				report.Status = StatusError
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (s *Service) check(ctx context.Context, checker Checker) CheckResult {
+	if s.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.cfg.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	err := checker.Check(ctx)
+	result := CheckResult{Status: StatusOK, Duration: time.Since(start)}
+	if err != nil {
+		result.Status = StatusError
+		result.Error = err.Error()
+	}
+	return result
+}
@@ -0,0 +1,157 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Поддерживаемые форматы логов
+const (
+	FormatJSON = "json"
+	FormatText = "text"
+)
+
+// Controller позволяет менять уровень и формат логов во время работы сервиса.
+// Все логгеры, созданные от Handler(), подхватывают изменения сразу.
+type Controller struct {
+	out   io.Writer
+	level *slog.LevelVar
+
+	mu     sync.RWMutex
+	format string
+	base   slog.Handler
+}
+
+// New создает контроллер и проверяет начальные уровень и формат
+func New(out io.Writer, level, format string) (*Controller, error) {
+	c := &Controller{
+		out:   out,
+		level: new(slog.LevelVar),
+	}
+	if err := c.SetLevel(level); err != nil {
+		return nil, err
+	}
+	if err := c.SetFormat(format); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Handler корневой slog.Handler, который добавляет request_id из контекста
+func (c *Controller) Handler() slog.Handler {
+	return &dynamicHandler{controller: c}
+}
+
+func (c *Controller) Level() string {
+	return strings.ToLower(c.level.Level().String())
+}
+
+func (c *Controller) SetLevel(level string) error {
+	var parsed slog.Level
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("unknown log level %q: expected debug, info, warn or error", level)
+	}
+	c.level.Set(parsed)
+	return nil
+}
+
+func (c *Controller) Format() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.format
+}
+
+func (c *Controller) SetFormat(format string) error {
+	options := &slog.HandlerOptions{Level: c.level}
+
+	var base slog.Handler
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		base = slog.NewJSONHandler(c.out, options)
+	case FormatText:
+		base = slog.NewTextHandler(c.out, options)
+	default:
+		return fmt.Errorf("unknown log format %q: expected json or text", format)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.format = strings.ToLower(format)
+	c.base = base
+	return nil
+}
+
+func (c *Controller) baseHandler() slog.Handler {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.base
+}
+
+// dynamicHandler перенаправляет записи в текущий базовый handler контроллера.
+// Атрибуты и группы запоминаются и применяются к актуальному handler при каждой записи.
+type dynamicHandler struct {
+	controller *Controller
+	ops        []func(slog.Handler) slog.Handler
+}
+
+func (h *dynamicHandler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.controller.level.Level()
+}
+
+func (h *dynamicHandler) Handle(ctx context.Context, record slog.Record) error {
+	handler := h.controller.baseHandler()
+	for _, op := range h.ops {
+		handler = op(handler)
+	}
+
+	if requestID := RequestID(ctx); requestID != "" {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	if ctx != nil {
+		// Связывает запись лога с трассой запроса
+		if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+			record.AddAttrs(slog.String("trace_id", span.TraceID().String()))
+		}
+	}
+	return handler.Handle(ctx, record)
+}
+
+func (h *dynamicHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler {
+		return handler.WithAttrs(attrs)
+	})
+}
+
+func (h *dynamicHandler) WithGroup(name string) slog.Handler {
+	return h.with(func(handler slog.Handler) slog.Handler {
+		return handler.WithGroup(name)
+	})
+}
+
+func (h *dynamicHandler) with(op func(slog.Handler) slog.Handler) slog.Handler {
+	ops := make([]func(slog.Handler) slog.Handler, len(h.ops), len(h.ops)+1)
+	copy(ops, h.ops)
+	return &dynamicHandler{controller: h.controller, ops: append(ops, op)}
+}
+
+type requestIDKey struct{}
+
+// WithRequestID сохраняет идентификатор запроса в контексте
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID возвращает идентификатор запроса из контекста или пустую строку
+func RequestID(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
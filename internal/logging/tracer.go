@@ -0,0 +1,105 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// QueryTracer логирует длительность SQL запросов (pgx.QueryTracer и pgx.BatchTracer).
+// Обычные запросы пишутся на уровне debug, медленные и упавшие - на warn.
+type QueryTracer struct {
+	logger        *slog.Logger
+	slowThreshold time.Duration
+}
+
+func NewQueryTracer(logger *slog.Logger, slowThreshold time.Duration) *QueryTracer {
+	return &QueryTracer{
+		logger:        logger,
+		slowThreshold: slowThreshold,
+	}
+}
+
+type queryStartKey struct{}
+
+type queryStart struct {
+	sql     string
+	started time.Time
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, queryStart{sql: data.SQL, started: time.Now()})
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	start, ok := ctx.Value(queryStartKey{}).(queryStart)
+	if !ok {
+		return
+	}
+	t.log(ctx, start.sql, time.Since(start.started), data.CommandTag, data.Err)
+}
+
+type batchStartKey struct{}
+
+// batchStart момент, с которого отсчитывается длительность следующего запроса пакета
+type batchStart struct {
+	last time.Time
+}
+
+func (t *QueryTracer) TraceBatchStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceBatchStartData) context.Context {
+	return context.WithValue(ctx, batchStartKey{}, &batchStart{last: time.Now()})
+}
+
+// TraceBatchQuery запросы пакета выполняются друг за другом, поэтому длительность запроса -
+// время с окончания предыдущего
+func (t *QueryTracer) TraceBatchQuery(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchQueryData) {
+	start, ok := ctx.Value(batchStartKey{}).(*batchStart)
+	if !ok {
+		return
+	}
+	now := time.Now()
+	t.log(ctx, data.SQL, now.Sub(start.last), data.CommandTag, data.Err)
+	start.last = now
+}
+
+func (t *QueryTracer) TraceBatchEnd(context.Context, *pgx.Conn, pgx.TraceBatchEndData) {}
+
+func (t *QueryTracer) log(ctx context.Context, sql string, duration time.Duration, tag pgconn.CommandTag, err error) {
+	level := slog.LevelDebug
+	if err != nil || (t.slowThreshold > 0 && duration >= t.slowThreshold) {
+		level = slog.LevelWarn
+	}
+	if !t.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("query", QueryName(sql)),
+		slog.Float64("duration_ms", float64(duration.Microseconds())/1000),
+		slog.Int64("rows", tag.RowsAffected()),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	t.logger.LogAttrs(ctx, level, "sql query", attrs...)
+}
+
+// QueryName достает имя запроса из комментария sqlc ("-- name: GetTask :one"),
+// для остальных запросов возвращает первую строку SQL
+func QueryName(sql string) string {
+	sql = strings.TrimSpace(sql)
+	if name, ok := strings.CutPrefix(sql, "-- name: "); ok {
+		if fields := strings.Fields(name); len(fields) > 0 {
+			return fields[0]
+		}
+	}
+
+	if line, _, found := strings.Cut(sql, "\n"); found {
+		return strings.TrimSpace(line)
+	}
+	return sql
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedRoute метка для запросов без шаблона маршрута (ограничивает кардинальность)
+const unmatchedRoute = "unmatched"
+
+// Middleware считает запросы и их длительность по шаблону маршрута ("/tasks/:id"), а не по URI
+func Middleware(registerer prometheus.Registerer) echo.MiddlewareFunc {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by route and status code.",
+	}, []string{"method", "route", "status"})
+
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route"})
+
+	inFlight := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: "http",
+		Name:      "requests_in_flight",
+		Help:      "Number of HTTP requests being served.",
+	})
+
+	registerer.MustRegister(requests, duration, inFlight)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Статус ответа определяется HTTPErrorHandler, поэтому ошибку обрабатываем до подсчета
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			method := c.Request().Method
+
+			requests.WithLabelValues(method, route, strconv.Itoa(c.Response().Status)).Inc()
+			duration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
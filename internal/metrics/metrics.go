@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace префикс всех метрик сервиса
+const Namespace = "todo"
+
+// NewRegistry реестр метрик со стандартными метриками Go runtime и процесса
+func NewRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return registry
+}
+
+// RegisterRoutes отдает метрики реестра по /metrics в текстовом формате Prometheus
+func RegisterRoutes(e *echo.Echo, registry *prometheus.Registry) {
+	e.GET("/metrics", echo.WrapHandler(promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+		Registry: registry,
+	})))
+}
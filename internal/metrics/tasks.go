@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"GreatProject/internal/tenant"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// taskCountTimeout ограничивает время подсчета задач при скрейпе
+const taskCountTimeout = 2 * time.Second
+
+// TaskCounter источник бизнес-метрик по задачам (repository.TaskRepository).
+// Считает все задачи всех рабочих пространств: сервис учитывает права клиента, а у скрейпа клиента нет.
+type TaskCounter interface {
+	CountByStatus(ctx context.Context, completed bool) (int64, error)
+}
+
+// taskCollector отдает число открытых и выполненных задач, запрашивая их в момент скрейпа
+type taskCollector struct {
+	counter TaskCounter
+	tasks   *prometheus.Desc
+}
+
+func NewTaskCollector(counter TaskCounter) prometheus.Collector {
+	return &taskCollector{
+		counter: counter,
+		tasks: prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", "tasks"),
+			"Number of tasks by status.",
+			[]string{"status"}, nil,
+		),
+	}
+}
+
+func (c *taskCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.tasks
+}
+
+func (c *taskCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(tenant.WithAllWorkspaces(context.Background()), taskCountTimeout)
+	defer cancel()
+
+	for status, completed := range map[string]bool{"open": false, "completed": true} {
+		count, err := c.counter.CountByStatus(ctx, completed)
+		if err != nil {
+			// Недоступность БД не должна ломать весь скрейп: метрика просто пропадает
+			slog.WarnContext(ctx, "task metrics unavailable", slog.String("status", status), slog.Any("error", err))
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.tasks, prometheus.GaugeValue, float64(count), status)
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// StatusClientClosedRequest нестандартный статус (nginx) для запросов, отменённых клиентом
+const StatusClientClosedRequest = 499
+
+// TimeoutConfig настройки таймаутов обработки запросов
+type TimeoutConfig struct {
+	// Default таймаут для маршрутов без отдельной настройки, 0 - без таймаута
+	Default time.Duration
+	// Routes таймауты по маршрутам в формате "METHOD /path", например "GET /tasks/:id"
+	Routes map[string]time.Duration
+}
+
+// Timeout ограничивает время обработки запроса дедлайном контекста.
+// Отмена запроса клиентом логируется как 499; ошибки контекста в ответы превращает HTTPErrorHandler.
+func Timeout(config TimeoutConfig) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			timeout := config.Default
+			if routeTimeout, ok := config.Routes[c.Request().Method+" "+c.Path()]; ok {
+				timeout = routeTimeout
+			}
+
+			if timeout > 0 {
+				ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+				defer cancel()
+				c.SetRequest(c.Request().WithContext(ctx))
+			}
+
+			err := next(c)
+
+			ctxErr := c.Request().Context().Err()
+			if errors.Is(ctxErr, context.Canceled) {
+				slog.WarnContext(c.Request().Context(), "client closed request",
+					slog.Int("status", StatusClientClosedRequest),
+					slog.String("method", c.Request().Method),
+					slog.String("uri", c.Request().URL.Path),
+				)
+			}
+
+			return err
+		}
+	}
+}
+
+// ParseRouteTimeouts разбирает строку вида "GET /tasks=5s,POST /tasks=10s"
+func ParseRouteTimeouts(value string) (map[string]time.Duration, error) {
+	routes := make(map[string]time.Duration)
+	if strings.TrimSpace(value) == "" {
+		return routes, nil
+	}
+
+	for _, item := range strings.Split(value, ",") {
+		route, rawTimeout, ok := strings.Cut(strings.TrimSpace(item), "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid route timeout %q: expected \"METHOD /path=duration\"", item)
+		}
+
+		timeout, err := time.ParseDuration(strings.TrimSpace(rawTimeout))
+		if err != nil {
+			return nil, fmt.Errorf("invalid route timeout %q: %w", item, err)
+		}
+
+		method, path, ok := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid route timeout %q: expected \"METHOD /path=duration\"", item)
+		}
+
+		routes[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = timeout
+	}
+
+	return routes, nil
+}
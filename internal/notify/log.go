@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+)
+
+type logNotifier struct{}
+
+// NewLogNotifier пишет уведомления в журнал сервиса; удобен для разработки
+func NewLogNotifier() Notifier {
+	return logNotifier{}
+}
+
+func (logNotifier) Notify(ctx context.Context, n Notification) error {
+	attrs := []any{
+		slog.Int("reminder_id", int(n.ReminderID)),
+		slog.Int("task_id", int(n.TaskID)),
+		slog.String("task_name", n.TaskName),
+		slog.Int("user_id", int(n.UserID)),
+		slog.Time("fire_at", n.FireAt),
+	}
+	if !n.DueAt.IsZero() {
+		attrs = append(attrs, slog.Time("due_at", n.DueAt))
+	}
+	slog.InfoContext(ctx, "task reminder", attrs...)
+	return nil
+}
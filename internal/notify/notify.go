@@ -0,0 +1,81 @@
+// Package notify доставка напоминаний о задачах: в журнал, на webhook и по почте (SMTP).
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Notification напоминание пользователю о задаче
+type Notification struct {
+	ReminderID  int32
+	TaskID      int32
+	TaskName    string
+	UserID      int32
+	WorkspaceID int32
+	// Email адрес получателя; пустой - по почте не отправляется
+	Email string
+	// DueAt срок задачи; нулевое время - срока нет
+	DueAt time.Time
+	// FireAt момент, на который назначено напоминание
+	FireAt time.Time
+	// Delivered каналы Multi, которые уже доставили уведомление в прошлых попытках; они пропускаются
+	Delivered []string
+}
+
+// Notifier канал доставки уведомлений. Доставка не идемпотентна: после ошибки
+// уведомление отправляется в канал повторно, поэтому получатель может увидеть его дважды.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// Channel канал доставки с именем, под которым Multi запоминает доставку
+type Channel struct {
+	Name string
+	Notifier
+}
+
+// DeliveryError часть каналов Multi не доставила уведомление. Delivered - каналы, которые
+// доставили его в этой или прошлых попытках: повтор с ними в Notification.Delivered их пропустит
+type DeliveryError struct {
+	Delivered []string
+	Err       error
+}
+
+func (e *DeliveryError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DeliveryError) Unwrap() error {
+	return e.Err
+}
+
+// Multi отправляет уведомление во все каналы, кроме уже доставивших его (Notification.Delivered);
+// если какой-то канал не доставил, возвращается *DeliveryError с объединенными ошибками каналов
+func Multi(channels ...Channel) Notifier {
+	return multi(channels)
+}
+
+type multi []Channel
+
+func (m multi) Notify(ctx context.Context, n Notification) error {
+	delivered := slices.Clone(n.Delivered)
+	var errs []error
+	for _, channel := range m {
+		if slices.Contains(n.Delivered, channel.Name) {
+			continue
+		}
+		if err := channel.Notify(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel.Name, err))
+			continue
+		}
+		delivered = append(delivered, channel.Name)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &DeliveryError{Delivered: delivered, Err: errors.Join(errs...)}
+}
@@ -0,0 +1,53 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// countingNotifier считает отправки и возвращает err
+type countingNotifier struct {
+	calls int
+	err   error
+}
+
+func (n *countingNotifier) Notify(context.Context, Notification) error {
+	n.calls++
+	return n.err
+}
+
+func TestMultiRetriesOnlyFailedChannels(t *testing.T) {
+	log, webhook := &countingNotifier{}, &countingNotifier{err: errors.New("502 Bad Gateway")}
+	notifier := Multi(Channel{Name: "log", Notifier: log}, Channel{Name: "webhook", Notifier: webhook})
+
+	err := notifier.Notify(context.Background(), Notification{TaskID: 1})
+	var partial *DeliveryError
+	if !errors.As(err, &partial) || !slices.Equal(partial.Delivered, []string{"log"}) {
+		t.Fatalf("Notify: err = %v, want DeliveryError with log delivered", err)
+	}
+	if !errors.Is(err, webhook.err) {
+		t.Fatalf("Notify: err = %v, want the webhook error", err)
+	}
+
+	// повтор с доставившими каналами не отправляет в них снова
+	webhook.err = nil
+	if err := notifier.Notify(context.Background(), Notification{TaskID: 1, Delivered: partial.Delivered}); err != nil {
+		t.Fatalf("retry: %v", err)
+	}
+	if log.calls != 1 || webhook.calls != 2 {
+		t.Fatalf("log sent %d times, webhook %d; want 1 and 2", log.calls, webhook.calls)
+	}
+}
+
+func TestMultiKeepsEarlierDeliveries(t *testing.T) {
+	log, smtp := &countingNotifier{}, &countingNotifier{err: errors.New("550 no such user")}
+	notifier := Multi(Channel{Name: "log", Notifier: log}, Channel{Name: "webhook", Notifier: &countingNotifier{}}, Channel{Name: "smtp", Notifier: smtp})
+
+	err := notifier.Notify(context.Background(), Notification{TaskID: 1, Delivered: []string{"webhook"}})
+	var partial *DeliveryError
+	if !errors.As(err, &partial) || !slices.Equal(partial.Delivered, []string{"webhook", "log"}) {
+		t.Fatalf("Notify: err = %v, want DeliveryError with webhook and log delivered", err)
+	}
+}
@@ -0,0 +1,120 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// smtpTimeout наибольшее время отправки одного письма, включая соединение
+const smtpTimeout = 30 * time.Second
+
+// SMTPConfig почтовый сервер и отправитель писем
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username и Password для AUTH PLAIN; пустой Username - без аутентификации
+	Username string
+	Password string
+	From     string
+}
+
+type smtpNotifier struct {
+	config SMTPConfig
+}
+
+// NewSMTPNotifier отправляет уведомления письмом на адрес напоминания; напоминания без адреса
+// пропускаются. Если сервер поддерживает STARTTLS, соединение шифруется.
+func NewSMTPNotifier(config SMTPConfig) Notifier {
+	return &smtpNotifier{config: config}
+}
+
+func (s *smtpNotifier) Notify(ctx context.Context, n Notification) error {
+	if n.Email == "" {
+		return nil
+	}
+	if err := s.send(ctx, n.Email, message(s.config.From, n)); err != nil {
+		return fmt.Errorf("smtp: %w", err)
+	}
+	return nil
+}
+
+func (s *smtpNotifier) send(ctx context.Context, to string, msg []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, smtpTimeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port)))
+	if err != nil {
+		return err
+	}
+	deadline, _ := ctx.Deadline()
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: s.config.Host}); err != nil {
+			return err
+		}
+	}
+	if s.config.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return err
+		}
+	}
+	if err := client.Mail(s.config.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// message письмо-напоминание в формате RFC 5322
+func message(from string, n Notification) []byte {
+	subject := fmt.Sprintf("Напоминание: %s", n.TaskName)
+	// перевод строки в названии задачи ломал бы строки письма
+	name := strings.Join(strings.Fields(n.TaskName), " ")
+	body := fmt.Sprintf("Задача #%d «%s»\r\n", n.TaskID, name)
+	if !n.DueAt.IsZero() {
+		body += fmt.Sprintf("Срок: %s\r\n", n.DueAt.UTC().Format(time.RFC1123))
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from)
+	fmt.Fprintf(&msg, "To: %s\r\n", n.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: 8bit\r\n\r\n")
+	msg.WriteString(body)
+	return msg.Bytes()
+}
@@ -0,0 +1,231 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"io"
+	"mime"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeSMTP почтовый сервер в процессе: принимает письма без STARTTLS и запоминает их.
+// rejectRcpt - отклонять получателей кодом 550
+type fakeSMTP struct {
+	listener   net.Listener
+	rejectRcpt bool
+
+	mu       sync.Mutex
+	conns    int
+	auth     string
+	messages []smtpMessage
+}
+
+type smtpMessage struct {
+	from string
+	to   []string
+	data string
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeSMTP{listener: listener}
+	t.Cleanup(func() { listener.Close() })
+	go s.serve()
+	return s
+}
+
+// config настройки отправителя для этого сервера
+func (s *fakeSMTP) config() SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return SMTPConfig{Host: "127.0.0.1", Port: addr.Port, From: "tasks@example.com"}
+}
+
+func (s *fakeSMTP) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.conns++
+		s.mu.Unlock()
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeSMTP) handle(conn net.Conn) {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(10 * time.Second))
+	r := bufio.NewReader(conn)
+	reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+
+	reply("220 fake ESMTP")
+	var msg smtpMessage
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250-fake")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			_, credentials, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(credentials)
+			s.mu.Lock()
+			s.auth = string(decoded)
+			s.mu.Unlock()
+			reply("235 authenticated")
+		case "MAIL":
+			msg = smtpMessage{from: addressArg(arg)}
+			reply("250 ok")
+		case "RCPT":
+			if s.rejectRcpt {
+				reply("550 no such user")
+				continue
+			}
+			msg.to = append(msg.to, addressArg(arg))
+			reply("250 ok")
+		case "DATA":
+			reply("354 end with .")
+			var data strings.Builder
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			msg.data = data.String()
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+// addressArg адрес из FROM:<a@b> или TO:<a@b>
+func addressArg(arg string) string {
+	_, addr, _ := strings.Cut(arg, "<")
+	addr, _, _ = strings.Cut(addr, ">")
+	return addr
+}
+
+func (s *fakeSMTP) received() ([]smtpMessage, int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]smtpMessage(nil), s.messages...), s.conns
+}
+
+func TestSMTPNotifierSendsReminder(t *testing.T) {
+	server := newFakeSMTP(t)
+	due := time.Date(2026, 3, 1, 15, 30, 0, 0, time.FixedZone("MSK", 3*3600))
+	n := Notification{ReminderID: 7, TaskID: 42, TaskName: "Сдать\nотчет", Email: "user@example.com", DueAt: due}
+
+	if err := NewSMTPNotifier(server.config()).Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+
+	messages, _ := server.received()
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+	got := messages[0]
+	if got.from != "tasks@example.com" || len(got.to) != 1 || got.to[0] != "user@example.com" {
+		t.Fatalf("envelope = %s -> %v, want tasks@example.com -> [user@example.com]", got.from, got.to)
+	}
+
+	parsed, err := mail.ReadMessage(strings.NewReader(got.data))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	if err != nil || subject != "Напоминание: Сдать\nотчет" {
+		t.Fatalf("subject = %q, %v; want the task name", subject, err)
+	}
+	if parsed.Header.Get("From") != "tasks@example.com" || parsed.Header.Get("To") != "user@example.com" {
+		t.Fatalf("headers From=%q To=%q", parsed.Header.Get("From"), parsed.Header.Get("To"))
+	}
+	if parsed.Header.Get("Content-Type") != "text/plain; charset=utf-8" {
+		t.Fatalf("Content-Type = %q", parsed.Header.Get("Content-Type"))
+	}
+	if _, err := parsed.Header.Date(); err != nil {
+		t.Fatalf("Date: %v", err)
+	}
+	body, _ := io.ReadAll(parsed.Body)
+	want := "Задача #42 «Сдать отчет»\r\nСрок: Sun, 01 Mar 2026 12:30:00 UTC\r\n"
+	if string(body) != want {
+		t.Fatalf("body = %q, want %q", body, want)
+	}
+}
+
+func TestSMTPNotifierAuthenticates(t *testing.T) {
+	server := newFakeSMTP(t)
+	config := server.config()
+	config.Username, config.Password = "robot", "secret"
+
+	n := Notification{TaskID: 1, TaskName: "Задача", Email: "user@example.com"}
+	if err := NewSMTPNotifier(config).Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.auth != "\x00robot\x00secret" {
+		t.Fatalf("AUTH PLAIN credentials = %q", server.auth)
+	}
+}
+
+func TestSMTPNotifierSkipsReminderWithoutEmail(t *testing.T) {
+	server := newFakeSMTP(t)
+
+	if err := NewSMTPNotifier(server.config()).Notify(context.Background(), Notification{TaskID: 1}); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if messages, conns := server.received(); len(messages) != 0 || conns != 0 {
+		t.Fatalf("got %d messages over %d connections, want none", len(messages), conns)
+	}
+}
+
+func TestSMTPNotifierReportsRejectedRecipient(t *testing.T) {
+	server := newFakeSMTP(t)
+	server.rejectRcpt = true
+
+	err := NewSMTPNotifier(server.config()).Notify(context.Background(), Notification{TaskID: 1, Email: "nobody@example.com"})
+	if err == nil || !strings.Contains(err.Error(), "550") {
+		t.Fatalf("Notify: err = %v, want 550 from the server", err)
+	}
+}
+
+func TestMessageWithoutDueDate(t *testing.T) {
+	msg := string(message("tasks@example.com", Notification{TaskID: 3, TaskName: "  Без   срока ", Email: "user@example.com"}))
+
+	_, body, ok := strings.Cut(msg, "\r\n\r\n")
+	if !ok {
+		t.Fatalf("message without header separator: %q", msg)
+	}
+	if want := "Задача #3 «Без срока»\r\n"; body != want {
+		t.Fatalf("body = %q, want %q", body, want)
+	}
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SignatureHeader заголовок с подписью тела запроса: sha256=<HMAC-SHA256 в hex>
+const SignatureHeader = "X-Signature-256"
+
+// webhookTimeout наибольшее время одного запроса к webhook
+const webhookTimeout = 10 * time.Second
+
+// WebhookConfig адрес webhook и ключ подписи
+type WebhookConfig struct {
+	URL string
+	// Secret ключ HMAC для SignatureHeader; пустой - запросы не подписываются
+	Secret string
+}
+
+type webhookNotifier struct {
+	config WebhookConfig
+	client *http.Client
+}
+
+// NewWebhookNotifier отправляет уведомления POST запросом с JSON телом; ответ не 2xx - ошибка
+func NewWebhookNotifier(config WebhookConfig) Notifier {
+	return &webhookNotifier{
+		config: config,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// webhookPayload тело запроса к webhook
+type webhookPayload struct {
+	Event       string     `json:"event"`
+	ReminderID  int32      `json:"reminder_id"`
+	TaskID      int32      `json:"task_id"`
+	TaskName    string     `json:"task_name"`
+	UserID      int32      `json:"user_id"`
+	WorkspaceID int32      `json:"workspace_id"`
+	Email       string     `json:"email,omitempty"`
+	DueAt       *time.Time `json:"due_at"`
+	FireAt      time.Time  `json:"fire_at"`
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n Notification) error {
+	payload := webhookPayload{
+		Event:       "task.reminder",
+		ReminderID:  n.ReminderID,
+		TaskID:      n.TaskID,
+		TaskName:    n.TaskName,
+		UserID:      n.UserID,
+		WorkspaceID: n.WorkspaceID,
+		Email:       n.Email,
+		FireAt:      n.FireAt,
+	}
+	if !n.DueAt.IsZero() {
+		payload.DueAt = &n.DueAt
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.config.Secret != "" {
+		mac := hmac.New(sha256.New, []byte(w.config.Secret))
+		mac.Write(body)
+		req.Header.Set(SignatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook: unexpected status %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// webhookRequest запрос, полученный тестовым webhook
+type webhookRequest struct {
+	header http.Header
+	body   []byte
+}
+
+func newWebhookServer(t *testing.T, status int) (*httptest.Server, *[]webhookRequest) {
+	t.Helper()
+	var requests []webhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, webhookRequest{header: r.Header.Clone(), body: body})
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestWebhookNotifierSignsPayload(t *testing.T) {
+	server, requests := newWebhookServer(t, http.StatusNoContent)
+	due := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	fire := due.Add(-time.Hour)
+	n := Notification{ReminderID: 7, TaskID: 42, TaskName: "Отчет", UserID: 5, WorkspaceID: 2, Email: "user@example.com", DueAt: due, FireAt: fire}
+
+	notifier := NewWebhookNotifier(WebhookConfig{URL: server.URL, Secret: "s3cret"})
+	if err := notifier.Notify(context.Background(), n); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	if len(*requests) != 1 {
+		t.Fatalf("got %d requests, want 1", len(*requests))
+	}
+	req := (*requests)[0]
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(req.body)
+	if want := "sha256=" + hex.EncodeToString(mac.Sum(nil)); req.header.Get(SignatureHeader) != want {
+		t.Fatalf("%s = %q, want %q", SignatureHeader, req.header.Get(SignatureHeader), want)
+	}
+	if req.header.Get("Content-Type") != "application/json" {
+		t.Fatalf("Content-Type = %q", req.header.Get("Content-Type"))
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	if payload.Event != "task.reminder" || payload.ReminderID != 7 || payload.TaskID != 42 || payload.TaskName != "Отчет" ||
+		payload.UserID != 5 || payload.WorkspaceID != 2 || payload.Email != "user@example.com" {
+		t.Fatalf("payload = %+v", payload)
+	}
+	if payload.DueAt == nil || !payload.DueAt.Equal(due) || !payload.FireAt.Equal(fire) {
+		t.Fatalf("payload due_at = %v, fire_at = %v; want %v and %v", payload.DueAt, payload.FireAt, due, fire)
+	}
+}
+
+func TestWebhookNotifierWithoutSecret(t *testing.T) {
+	server, requests := newWebhookServer(t, http.StatusOK)
+
+	if err := NewWebhookNotifier(WebhookConfig{URL: server.URL}).Notify(context.Background(), Notification{TaskID: 1}); err != nil {
+		t.Fatalf("Notify: %v", err)
+	}
+	req := (*requests)[0]
+	if req.header.Get(SignatureHeader) != "" {
+		t.Fatalf("unsigned request has %s = %q", SignatureHeader, req.header.Get(SignatureHeader))
+	}
+	// срока нет - due_at передается как null, email без адреса опускается
+	var payload map[string]any
+	if err := json.Unmarshal(req.body, &payload); err != nil {
+		t.Fatalf("payload: %v", err)
+	}
+	if due, ok := payload["due_at"]; !ok || due != nil {
+		t.Fatalf("due_at = %v (present %v), want null", due, ok)
+	}
+	if _, ok := payload["email"]; ok {
+		t.Fatalf("email present in %s", req.body)
+	}
+}
+
+func TestWebhookNotifierReportsErrorStatus(t *testing.T) {
+	server, _ := newWebhookServer(t, http.StatusBadGateway)
+
+	if err := NewWebhookNotifier(WebhookConfig{URL: server.URL}).Notify(context.Background(), Notification{TaskID: 1}); err == nil {
+		t.Fatal("Notify succeeded on 502, want error")
+	}
+}
@@ -0,0 +1,74 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// memoryStore корзины в памяти процесса; подходит для одного экземпляра сервиса
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+func NewMemoryStore() Store {
+	return &memoryStore{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updated: now}
+		s.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(limit.Burst), b.tokens+now.Sub(b.updated).Seconds()*limit.Rate)
+	b.updated = now
+
+	allowed := b.tokens >= 1
+	if allowed {
+		b.tokens--
+	}
+	return newResult(allowed, b.tokens, limit), nil
+}
+
+func (s *memoryStore) Peek(_ context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tokens := float64(limit.Burst)
+	if b, ok := s.buckets[key]; ok {
+		tokens = math.Min(tokens, b.tokens+s.now().Sub(b.updated).Seconds()*limit.Rate)
+	}
+	return newResult(tokens >= 1, tokens, limit), nil
+}
+
+func (s *memoryStore) Cleanup(_ context.Context, idle time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var removed int64
+	idleSince := s.now().Add(-idle)
+	for key, b := range s.buckets {
+		if b.updated.Before(idleSince) {
+			delete(s.buckets, key)
+			removed++
+		}
+	}
+	return removed, nil
+}
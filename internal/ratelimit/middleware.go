@@ -0,0 +1,139 @@
+package ratelimit
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"GreatProject/internal/auth"
+
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/labstack/echo/v4/middleware"
+)
+
+// Config настройки middleware ограничения частоты запросов
+type Config struct {
+	Store Store
+	// Read лимит для GET, HEAD и OPTIONS, Write - для остальных методов
+	Read  Limit
+	Write Limit
+	// KeyFunc определяет клиента запроса; по умолчанию ClientKey
+	KeyFunc func(c echo.Context) string
+	Skipper echomiddleware.Skipper
+}
+
+// ClientKey ключ клиента: API ключ, аутентифицированный пользователь или IP адрес
+func ClientKey(c echo.Context) string {
+	if principal, ok := auth.PrincipalFrom(c.Request().Context()); ok {
+		if principal.IsAPIKey() {
+			return "key:" + strconv.Itoa(int(principal.APIKeyID))
+		}
+		return "user:" + strconv.Itoa(int(principal.UserID))
+	}
+	return IPKey(c)
+}
+
+// IPKey ключ клиента по IP адресу, без учета аутентификации
+func IPKey(c echo.Context) string {
+	return "ip:" + c.RealIP()
+}
+
+// Middleware ограничивает частоту запросов клиента отдельными корзинами для чтения и записи.
+// Отвечает заголовками RateLimit-* (draft-ietf-httpapi-ratelimit-headers), при превышении - 429 с Retry-After.
+// Если хранилище недоступно, запрос пропускается: ограничение частоты не должно ронять API.
+func Middleware(config Config) echo.MiddlewareFunc {
+	if config.KeyFunc == nil {
+		config.KeyFunc = ClientKey
+	}
+	if config.Skipper == nil {
+		config.Skipper = echomiddleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) {
+				return next(c)
+			}
+
+			class, limit := "write", config.Write
+			switch c.Request().Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				class, limit = "read", config.Read
+			}
+
+			ctx := c.Request().Context()
+			result, err := config.Store.Take(ctx, class+":"+config.KeyFunc(c), limit)
+			if err != nil {
+				slog.WarnContext(ctx, "rate limit check failed, request allowed", slog.String("error", err.Error()))
+				return next(c)
+			}
+
+			header := c.Response().Header()
+			header.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+			header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+			header.Set("RateLimit-Reset", ceilSeconds(result.Reset))
+			header.Set("RateLimit-Policy", strconv.Itoa(limit.Burst)+";w="+ceilSeconds(limit.Window()))
+
+			if !result.Allowed {
+				header.Set(echo.HeaderRetryAfter, ceilSeconds(result.RetryAfter))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "rate limit exceeded, retry later")
+			}
+			return next(c)
+		}
+	}
+}
+
+// FailedAuthConfig настройки ограничения подбора учетных данных
+type FailedAuthConfig struct {
+	Store Store
+	// Limit отклоненных учетных данных с одного IP адреса
+	Limit   Limit
+	Skipper echomiddleware.Skipper
+}
+
+// FailedAuthMiddleware ограничивает подбор API ключей и токенов: каждая отклоненная попытка
+// (auth.ErrInvalidCredentials) списывает токен из корзины IP адреса клиента, а пока корзина пуста,
+// запросы с учетными данными получают 429 еще до их проверки. Подключается перед auth.Middleware:
+// Middleware считает запросы по ключу или пользователю уже после аутентификации.
+// Одновременные попытки могут немного превысить лимит: проверка и списание не атомарны.
+func FailedAuthMiddleware(config FailedAuthConfig) echo.MiddlewareFunc {
+	if config.Skipper == nil {
+		config.Skipper = echomiddleware.DefaultSkipper
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if config.Skipper(c) || !auth.HasCredentials(c.Request()) {
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			key := "auth:" + IPKey(c)
+			result, err := config.Store.Peek(ctx, key, config.Limit)
+			if err != nil {
+				slog.WarnContext(ctx, "rate limit check failed, request allowed", slog.String("error", err.Error()))
+				return next(c)
+			}
+			if !result.Allowed {
+				c.Response().Header().Set(echo.HeaderRetryAfter, ceilSeconds(result.RetryAfter))
+				return echo.NewHTTPError(http.StatusTooManyRequests, "too many failed authentication attempts, retry later")
+			}
+
+			err = next(c)
+			if errors.Is(err, auth.ErrInvalidCredentials) {
+				if _, takeErr := config.Store.Take(ctx, key, config.Limit); takeErr != nil {
+					slog.WarnContext(ctx, "failed to count authentication failure", slog.String("error", takeErr.Error()))
+				}
+			}
+			return err
+		}
+	}
+}
+
+// ceilSeconds длительность в целых секундах с округлением вверх
+func ceilSeconds(d time.Duration) string {
+	return strconv.Itoa(int(math.Ceil(d.Seconds())))
+}
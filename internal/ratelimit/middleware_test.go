@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"GreatProject/internal/auth"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestFailedAuthMiddlewareLimitsRejectedCredentials(t *testing.T) {
+	const burst = 3
+	e := echo.New()
+	calls := 0
+	handler := FailedAuthMiddleware(FailedAuthConfig{
+		Store: NewMemoryStore(),
+		Limit: PerMinute(1, burst),
+	})(func(c echo.Context) error {
+		calls++
+		if c.Request().Header.Get(auth.HeaderAPIKey) != "valid" {
+			return echo.NewHTTPError(http.StatusUnauthorized).SetInternal(auth.ErrInvalidCredentials)
+		}
+		return nil
+	})
+	serve := func(key, ip string) error {
+		req := httptest.NewRequest(http.MethodGet, "/tasks", nil)
+		req.RemoteAddr = ip + ":1234"
+		if key != "" {
+			req.Header.Set(auth.HeaderAPIKey, key)
+		}
+		return handler(e.NewContext(req, httptest.NewRecorder()))
+	}
+
+	// успешная аутентификация не расходует лимит
+	for i := 0; i < 2*burst; i++ {
+		if err := serve("valid", "192.0.2.1"); err != nil {
+			t.Fatalf("valid key: %v", err)
+		}
+	}
+	for i := 0; i < burst; i++ {
+		if err := serve("guess", "192.0.2.1"); !errors.Is(err, auth.ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: err = %v, want invalid credentials", i+1, err)
+		}
+	}
+
+	calls = 0
+	var httpErr *echo.HTTPError
+	if err := serve("valid", "192.0.2.1"); !errors.As(err, &httpErr) || httpErr.Code != http.StatusTooManyRequests {
+		t.Fatalf("after %d failures: err = %v, want 429", burst, err)
+	}
+	if calls != 0 {
+		t.Fatalf("credentials checked %d times after the limit, want 0", calls)
+	}
+	// запросы без учетных данных и с других адресов не ограничиваются
+	if err := serve("", "192.0.2.1"); !errors.Is(err, auth.ErrInvalidCredentials) {
+		t.Fatalf("anonymous request: err = %v, want it to reach the handler", err)
+	}
+	if err := serve("valid", "192.0.2.2"); err != nil {
+		t.Fatalf("other address: %v", err)
+	}
+}
@@ -0,0 +1,50 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// postgresStore корзины в таблице rate_limit_buckets, общие для всех экземпляров сервиса.
+// Пополнение и списание токена выполняются одним UPSERT, поэтому гонок между экземплярами нет.
+type postgresStore struct {
+	queries *db.Queries
+}
+
+func NewPostgresStore(queries *db.Queries) Store {
+	return &postgresStore{
+		queries: queries,
+	}
+}
+
+func (s *postgresStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	row, err := s.queries.TakeRateLimitToken(ctx, db.TakeRateLimitTokenParams{
+		Key:   key,
+		Burst: float64(limit.Burst),
+		Rate:  limit.Rate,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	return newResult(row.Allowed, row.Tokens, limit), nil
+}
+
+func (s *postgresStore) Peek(ctx context.Context, key string, limit Limit) (Result, error) {
+	tokens, err := s.queries.PeekRateLimitTokens(ctx, db.PeekRateLimitTokensParams{
+		Burst: float64(limit.Burst),
+		Rate:  limit.Rate,
+		Key:   key,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+	return newResult(tokens >= 1, tokens, limit), nil
+}
+
+func (s *postgresStore) Cleanup(ctx context.Context, idle time.Duration) (int64, error) {
+	return s.queries.DeleteIdleRateLimitBuckets(ctx, pgtype.Timestamptz{Time: time.Now().Add(-idle), Valid: true})
+}
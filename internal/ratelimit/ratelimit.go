@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// Limit параметры корзины токенов: Rate токенов в секунду, не больше Burst в запасе
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// PerMinute лимит в n запросов в минуту с запасом burst
+func PerMinute(n float64, burst int) Limit {
+	return Limit{Rate: n / 60, Burst: burst}
+}
+
+// Window время, за которое пустая корзина наполняется полностью
+func (l Limit) Window() time.Duration {
+	return seconds(float64(l.Burst) / l.Rate)
+}
+
+// Result решение по запросу
+type Result struct {
+	Allowed bool
+	// Remaining сколько запросов еще можно сделать без ожидания
+	Remaining int
+	// Reset через сколько корзина снова будет полной
+	Reset time.Duration
+	// RetryAfter через сколько появится следующий токен; 0, если запрос разрешен
+	RetryAfter time.Duration
+}
+
+// Store хранилище корзин токенов
+type Store interface {
+	// Take списывает токен из корзины key, пополнив ее за время с прошлого запроса
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	// Peek решение, которое принял бы Take, но без списания токена
+	Peek(ctx context.Context, key string, limit Limit) (Result, error)
+	// Cleanup удаляет корзины, к которым не обращались дольше idle, и возвращает их число
+	Cleanup(ctx context.Context, idle time.Duration) (int64, error)
+}
+
+// newResult считает Result по числу токенов, оставшихся в корзине после запроса
+func newResult(allowed bool, tokens float64, limit Limit) Result {
+	result := Result{
+		Allowed:   allowed,
+		Remaining: int(math.Max(0, math.Floor(tokens))),
+		Reset:     seconds((float64(limit.Burst) - tokens) / limit.Rate),
+	}
+	if !allowed {
+		result.RetryAfter = seconds((1 - tokens) / limit.Rate)
+	}
+	return result
+}
+
+func seconds(s float64) time.Duration {
+	if s <= 0 {
+		return 0
+	}
+	return time.Duration(s * float64(time.Second))
+}
+
+// RunCleanup раз в interval удаляет корзины, простаивающие дольше idle, пока не отменен ctx.
+// Корзина, простоявшая дольше окна лимита, снова полная, поэтому ее удаление ничего не меняет.
+func RunCleanup(ctx context.Context, store Store, interval, idle time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			removed, err := store.Cleanup(ctx, idle)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.WarnContext(ctx, "failed to clean up rate limit buckets", slog.String("error", err.Error()))
+				}
+				continue
+			}
+			if removed > 0 {
+				slog.DebugContext(ctx, "rate limit buckets cleaned up", slog.Int64("removed", removed))
+			}
+		}
+	}
+}
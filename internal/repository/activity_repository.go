@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Типы событий задачи в ленте активности
+const (
+	EventTaskCreated     = "created"
+	EventTaskUpdated     = "updated"
+	EventTaskCompleted   = "completed"
+	EventTaskUncompleted = "uncompleted"
+	EventTaskAssigned    = "assigned"
+	EventTaskUnassigned  = "unassigned"
+)
+
+// ActivityKindComment вид записи ленты для комментария; у событий вид - тип события
+const ActivityKindComment = "comment"
+
+type ActivityRepository interface {
+	// Record записывает событие задачи; actorID == 0 - анонимный клиент
+	Record(ctx context.Context, taskID, actorID int32, eventType string) error
+	// RecordFor записывает событие, касающееся пользователя subjectID (например, назначение исполнителя)
+	RecordFor(ctx context.Context, taskID, actorID, subjectID int32, eventType string) error
+	// List лента задачи: комментарии и события, новые сначала
+	List(ctx context.Context, taskID, limit, offset int32) ([]*db.ListTaskActivityRow, error)
+	Count(ctx context.Context, taskID int32) (int64, error)
+}
+
+// activityResource имя ресурса в доменных ошибках
+const activityResource = "activity"
+
+type activityRepository struct {
+	queries *db.Queries
+}
+
+func NewActivityRepository(queries *db.Queries) ActivityRepository {
+	return &activityRepository{
+		queries: queries,
+	}
+}
+
+func (r *activityRepository) Record(ctx context.Context, taskID, actorID int32, eventType string) error {
+	err := r.queries.CreateTaskEvent(ctx, db.CreateTaskEventParams{
+		TaskID:  taskID,
+		ActorID: pgtype.Int4{Int32: actorID, Valid: actorID != 0},
+		Type:    eventType,
+	})
+	return translateError(err, activityResource, nil)
+}
+
+func (r *activityRepository) RecordFor(ctx context.Context, taskID, actorID, subjectID int32, eventType string) error {
+	err := r.queries.CreateTaskSubjectEvent(ctx, db.CreateTaskSubjectEventParams{
+		TaskID:    taskID,
+		ActorID:   pgtype.Int4{Int32: actorID, Valid: actorID != 0},
+		Type:      eventType,
+		SubjectID: pgtype.Int4{Int32: subjectID, Valid: true},
+	})
+	return translateError(err, activityResource, nil)
+}
+
+func (r *activityRepository) List(ctx context.Context, taskID, limit, offset int32) ([]*db.ListTaskActivityRow, error) {
+	items, err := r.queries.ListTaskActivity(ctx, db.ListTaskActivityParams{
+		TaskID: taskID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	return items, translateError(err, activityResource, nil)
+}
+
+func (r *activityRepository) Count(ctx context.Context, taskID int32) (int64, error) {
+	count, err := r.queries.CountTaskActivity(ctx, taskID)
+	return count, translateError(err, activityResource, nil)
+}
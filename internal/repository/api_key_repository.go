@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type APIKeyRepository interface {
+	// Create сохраняет ключ; expiresAt == nil - бессрочный ключ
+	Create(ctx context.Context, userID int32, name, prefix string, hash []byte, scopes []string, expiresAt *time.Time) (*db.ApiKey, error)
+	ListByUser(ctx context.Context, userID int32) ([]*db.ApiKey, error)
+	GetByHash(ctx context.Context, hash []byte) (*db.ApiKey, error)
+	Revoke(ctx context.Context, id, userID int32) error
+	Rotate(ctx context.Context, id, userID int32, prefix string, hash []byte) (*db.ApiKey, error)
+	Touch(ctx context.Context, id int32, ip string) error
+}
+
+// apiKeyResource имя ресурса в доменных ошибках
+const apiKeyResource = "api_key"
+
+type apiKeyRepository struct {
+	queries *db.Queries
+}
+
+func NewAPIKeyRepository(queries *db.Queries) APIKeyRepository {
+	return &apiKeyRepository{
+		queries: queries,
+	}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, userID int32, name, prefix string, hash []byte, scopes []string, expiresAt *time.Time) (*db.ApiKey, error) {
+	key, err := r.queries.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		UserID:    userID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   hash,
+		Scopes:    scopes,
+		ExpiresAt: timestamptz(expiresAt),
+	})
+	return apiKeyOrError(key, err, nil)
+}
+
+func (r *apiKeyRepository) ListByUser(ctx context.Context, userID int32) ([]*db.ApiKey, error) {
+	keys, err := r.queries.ListAPIKeysByUser(ctx, userID)
+	return keys, translateError(err, apiKeyResource, nil)
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, hash []byte) (*db.ApiKey, error) {
+	key, err := r.queries.GetAPIKeyByHash(ctx, hash)
+	return apiKeyOrError(key, err, nil)
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id, userID int32) error {
+	rows, err := r.queries.RevokeAPIKey(ctx, db.RevokeAPIKeyParams{ID: id, UserID: userID})
+	if err != nil {
+		return translateError(err, apiKeyResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(apiKeyResource, id)
+	}
+	return nil
+}
+
+func (r *apiKeyRepository) Rotate(ctx context.Context, id, userID int32, prefix string, hash []byte) (*db.ApiKey, error) {
+	key, err := r.queries.RotateAPIKey(ctx, db.RotateAPIKeyParams{
+		ID:      id,
+		UserID:  userID,
+		Prefix:  prefix,
+		KeyHash: hash,
+	})
+	return apiKeyOrError(key, err, id)
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, id int32, ip string) error {
+	err := r.queries.TouchAPIKey(ctx, db.TouchAPIKeyParams{
+		ID:         id,
+		LastUsedIp: pgtype.Text{String: ip, Valid: ip != ""},
+	})
+	return translateError(err, apiKeyResource, id)
+}
+
+// timestamptz необязательное время в формате pgx
+func timestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
+
+func apiKeyOrError(key *db.ApiKey, err error, id any) (*db.ApiKey, error) {
+	if err != nil {
+		return nil, translateError(err, apiKeyResource, id)
+	}
+	return key, nil
+}
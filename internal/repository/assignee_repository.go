@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"context"
+
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AssigneeRepository interface {
+	// ByTasks исполнители задач taskIDs в порядке назначения
+	ByTasks(ctx context.Context, taskIDs []int32) ([]*db.TaskAssignee, error)
+	// Set оставляет у задачи ровно исполнителей userIDs; возвращает назначенных и снятых этим вызовом.
+	// assignedBy == 0 - анонимный клиент
+	Set(ctx context.Context, taskID, assignedBy int32, userIDs []int32) (added, removed []int32, err error)
+	// NonMembers пользователи из userIDs, не состоящие в проекте projectID
+	NonMembers(ctx context.Context, projectID int32, userIDs []int32) ([]int32, error)
+	// Assigned видимые пользователю задачи, на которые он назначен; completed == nil - в любом статусе
+	Assigned(ctx context.Context, userID int32, completed *bool, filter TaskFilter, limit, offset int32) ([]*db.Task, error)
+	CountAssigned(ctx context.Context, userID int32, completed *bool, filter TaskFilter) (int64, error)
+}
+
+// assigneeResource имя ресурса в доменных ошибках
+const assigneeResource = "assignee"
+
+type assigneeRepository struct {
+	queries *db.Queries
+}
+
+func NewAssigneeRepository(queries *db.Queries) AssigneeRepository {
+	return &assigneeRepository{
+		queries: queries,
+	}
+}
+
+func (r *assigneeRepository) ByTasks(ctx context.Context, taskIDs []int32) ([]*db.TaskAssignee, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+	assignees, err := r.queries.ListTaskAssignees(ctx, taskIDs)
+	return assignees, translateError(err, assigneeResource, nil)
+}
+
+func (r *assigneeRepository) Set(ctx context.Context, taskID, assignedBy int32, userIDs []int32) ([]int32, []int32, error) {
+	// nil ушел бы в запрос как NULL, и ANY(NULL) не снял бы ни одного исполнителя
+	if userIDs == nil {
+		userIDs = []int32{}
+	}
+	removed, err := r.queries.RemoveOtherTaskAssignees(ctx, db.RemoveOtherTaskAssigneesParams{
+		TaskID:  taskID,
+		UserIds: userIDs,
+	})
+	if err != nil {
+		return nil, nil, translateError(err, assigneeResource, nil)
+	}
+	added, err := r.queries.AddTaskAssignees(ctx, db.AddTaskAssigneesParams{
+		TaskID:     taskID,
+		AssignedBy: pgtype.Int4{Int32: assignedBy, Valid: assignedBy != 0},
+		UserIds:    userIDs,
+	})
+	if err != nil {
+		return nil, nil, translateError(err, assigneeResource, nil)
+	}
+	return added, removed, nil
+}
+
+func (r *assigneeRepository) NonMembers(ctx context.Context, projectID int32, userIDs []int32) ([]int32, error) {
+	ids, err := r.queries.ListNonMembers(ctx, db.ListNonMembersParams{
+		UserIds:   userIDs,
+		ProjectID: projectID,
+	})
+	return ids, translateError(err, assigneeResource, nil)
+}
+
+func (r *assigneeRepository) Assigned(ctx context.Context, userID int32, completed *bool, filter TaskFilter, limit, offset int32) ([]*db.Task, error) {
+	keys, values := filter.fieldArgs()
+	tasks, err := r.queries.ListAssignedTasks(ctx, db.ListAssignedTasksParams{
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		FieldKeys:   keys,
+		FieldValues: values,
+		Sort:        string(filter.Sort),
+		SortField:   filter.SortField,
+		Limit:       limit,
+		Offset:      offset,
+		Completed:   optionalBool(completed),
+	})
+	return tasks, translateError(err, taskResource, nil)
+}
+
+func (r *assigneeRepository) CountAssigned(ctx context.Context, userID int32, completed *bool, filter TaskFilter) (int64, error) {
+	keys, values := filter.fieldArgs()
+	count, err := r.queries.CountAssignedTasks(ctx, db.CountAssignedTasksParams{
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		FieldKeys:   keys,
+		FieldValues: values,
+		Completed:   optionalBool(completed),
+	})
+	return count, translateError(err, taskResource, nil)
+}
+
+// optionalBool nil - NULL в запросе, то есть фильтр не применяется
+func optionalBool(value *bool) pgtype.Bool {
+	if value == nil {
+		return pgtype.Bool{}
+	}
+	return pgtype.Bool{Bool: *value, Valid: true}
+}
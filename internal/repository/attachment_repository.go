@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type AttachmentRepository interface {
+	// Create описывает загруженное содержимое; uploadedBy == 0 - анонимный клиент
+	Create(ctx context.Context, taskID, uploadedBy int32, filename, contentType string, size int64, checksum, storageKey string) (*db.TaskAttachment, error)
+	GetByID(ctx context.Context, id int32) (*db.TaskAttachment, error)
+	ListByTask(ctx context.Context, taskID int32) ([]*db.TaskAttachment, error)
+	// Delete удаляет описание вложения; содержимое ставится в очередь удаления триггером
+	Delete(ctx context.Context, id int32) error
+
+	// PendingDeletions ключи содержимого удаленных вложений, которое еще лежит в хранилище
+	PendingDeletions(ctx context.Context, limit int32) ([]string, error)
+	// DeletionDone убирает ключ из очереди после удаления содержимого
+	DeletionDone(ctx context.Context, storageKey string) error
+}
+
+// attachmentResource имя ресурса в доменных ошибках
+const attachmentResource = "attachment"
+
+type attachmentRepository struct {
+	queries *db.Queries
+}
+
+func NewAttachmentRepository(queries *db.Queries) AttachmentRepository {
+	return &attachmentRepository{
+		queries: queries,
+	}
+}
+
+func (r *attachmentRepository) Create(ctx context.Context, taskID, uploadedBy int32, filename, contentType string, size int64, checksum, storageKey string) (*db.TaskAttachment, error) {
+	attachment, err := r.queries.CreateTaskAttachment(ctx, db.CreateTaskAttachmentParams{
+		TaskID:      taskID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    checksum,
+		StorageKey:  storageKey,
+		UploadedBy:  pgtype.Int4{Int32: uploadedBy, Valid: uploadedBy != 0},
+	})
+	return orError(attachment, err, attachmentResource, nil)
+}
+
+func (r *attachmentRepository) GetByID(ctx context.Context, id int32) (*db.TaskAttachment, error) {
+	attachment, err := r.queries.GetTaskAttachment(ctx, id)
+	return orError(attachment, err, attachmentResource, id)
+}
+
+func (r *attachmentRepository) ListByTask(ctx context.Context, taskID int32) ([]*db.TaskAttachment, error) {
+	attachments, err := r.queries.ListTaskAttachments(ctx, taskID)
+	return attachments, translateError(err, attachmentResource, nil)
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteTaskAttachment(ctx, id)
+	if err != nil {
+		return translateError(err, attachmentResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(attachmentResource, id)
+	}
+	return nil
+}
+
+func (r *attachmentRepository) PendingDeletions(ctx context.Context, limit int32) ([]string, error) {
+	keys, err := r.queries.ListBlobDeletions(ctx, limit)
+	return keys, translateError(err, attachmentResource, nil)
+}
+
+func (r *attachmentRepository) DeletionDone(ctx context.Context, storageKey string) error {
+	return translateError(r.queries.DeleteBlobDeletion(ctx, storageKey), attachmentResource, nil)
+}
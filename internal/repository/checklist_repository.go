@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ChecklistRepository interface {
+	List(ctx context.Context, taskID int32) ([]*db.TaskChecklistItem, error)
+	GetByID(ctx context.Context, id int32) (*db.TaskChecklistItem, error)
+	// Create добавляет пункт в конец чек-листа задачи
+	Create(ctx context.Context, taskID int32, text string, checked bool) (*db.TaskChecklistItem, error)
+	Update(ctx context.Context, id int32, text string, checked bool) (*db.TaskChecklistItem, error)
+	Delete(ctx context.Context, id int32) error
+	// Reorder расставляет пункты задачи в порядке itemIDs
+	Reorder(ctx context.Context, taskID int32, itemIDs []int32) error
+	// Progress число пунктов и отмеченных пунктов по задачам; задач без чек-листа в ответе нет
+	Progress(ctx context.Context, taskIDs []int32) ([]*db.CountChecklistProgressRow, error)
+	// Convert удаляет пункт и создает из него задачу в проекте родительской задачи;
+	// ownerID == 0 - задача без владельца
+	Convert(ctx context.Context, id, ownerID int32) (*db.Task, error)
+}
+
+// checklistResource имя ресурса в доменных ошибках
+const checklistResource = "checklist_item"
+
+type checklistRepository struct {
+	queries *db.Queries
+}
+
+func NewChecklistRepository(queries *db.Queries) ChecklistRepository {
+	return &checklistRepository{
+		queries: queries,
+	}
+}
+
+func (r *checklistRepository) List(ctx context.Context, taskID int32) ([]*db.TaskChecklistItem, error) {
+	items, err := r.queries.ListChecklistItems(ctx, taskID)
+	return items, translateError(err, checklistResource, nil)
+}
+
+func (r *checklistRepository) GetByID(ctx context.Context, id int32) (*db.TaskChecklistItem, error) {
+	item, err := r.queries.GetChecklistItem(ctx, id)
+	return orError(item, err, checklistResource, id)
+}
+
+func (r *checklistRepository) Create(ctx context.Context, taskID int32, text string, checked bool) (*db.TaskChecklistItem, error) {
+	item, err := r.queries.CreateChecklistItem(ctx, db.CreateChecklistItemParams{
+		TaskID:  taskID,
+		Text:    text,
+		Checked: checked,
+	})
+	return orError(item, err, checklistResource, nil)
+}
+
+func (r *checklistRepository) Update(ctx context.Context, id int32, text string, checked bool) (*db.TaskChecklistItem, error) {
+	item, err := r.queries.UpdateChecklistItem(ctx, db.UpdateChecklistItemParams{
+		ID:      id,
+		Text:    text,
+		Checked: checked,
+	})
+	return orError(item, err, checklistResource, id)
+}
+
+func (r *checklistRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteChecklistItem(ctx, id)
+	if err != nil {
+		return translateError(err, checklistResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(checklistResource, id)
+	}
+	return nil
+}
+
+func (r *checklistRepository) Reorder(ctx context.Context, taskID int32, itemIDs []int32) error {
+	_, err := r.queries.ReorderChecklistItems(ctx, db.ReorderChecklistItemsParams{
+		ItemIds: itemIDs,
+		TaskID:  taskID,
+	})
+	return translateError(err, checklistResource, nil)
+}
+
+func (r *checklistRepository) Progress(ctx context.Context, taskIDs []int32) ([]*db.CountChecklistProgressRow, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+	progress, err := r.queries.CountChecklistProgress(ctx, taskIDs)
+	return progress, translateError(err, checklistResource, nil)
+}
+
+func (r *checklistRepository) Convert(ctx context.Context, id, ownerID int32) (*db.Task, error) {
+	task, err := r.queries.ConvertChecklistItem(ctx, db.ConvertChecklistItemParams{
+		ID:      id,
+		OwnerID: pgtype.Int4{Int32: ownerID, Valid: ownerID != 0},
+	})
+	// нет строки - пункт удалили между проверкой и превращением
+	return orError(task, err, checklistResource, id)
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, taskID, authorID int32, body string, mentions []int32) (*db.TaskComment, error)
+	GetByID(ctx context.Context, id int32) (*db.TaskComment, error)
+	ListByTask(ctx context.Context, taskID, limit, offset int32) ([]*db.TaskComment, error)
+	CountByTask(ctx context.Context, taskID int32) (int64, error)
+	// Update заменяет текст комментария, сохраняя прежний в истории
+	Update(ctx context.Context, id int32, body string, mentions []int32) (*db.TaskComment, error)
+	Delete(ctx context.Context, id int32) error
+	ListRevisions(ctx context.Context, id int32) ([]*db.TaskCommentRevision, error)
+}
+
+// commentResource имя ресурса в доменных ошибках
+const commentResource = "comment"
+
+type commentRepository struct {
+	queries *db.Queries
+}
+
+func NewCommentRepository(queries *db.Queries) CommentRepository {
+	return &commentRepository{
+		queries: queries,
+	}
+}
+
+func (r *commentRepository) Create(ctx context.Context, taskID, authorID int32, body string, mentions []int32) (*db.TaskComment, error) {
+	comment, err := r.queries.CreateTaskComment(ctx, db.CreateTaskCommentParams{
+		TaskID:   taskID,
+		AuthorID: authorID,
+		Body:     body,
+		Mentions: mentions,
+	})
+	return orError(comment, err, commentResource, nil)
+}
+
+func (r *commentRepository) GetByID(ctx context.Context, id int32) (*db.TaskComment, error) {
+	comment, err := r.queries.GetTaskComment(ctx, id)
+	return orError(comment, err, commentResource, id)
+}
+
+func (r *commentRepository) ListByTask(ctx context.Context, taskID, limit, offset int32) ([]*db.TaskComment, error) {
+	comments, err := r.queries.ListTaskComments(ctx, db.ListTaskCommentsParams{
+		TaskID: taskID,
+		Limit:  limit,
+		Offset: offset,
+	})
+	return comments, translateError(err, commentResource, nil)
+}
+
+func (r *commentRepository) CountByTask(ctx context.Context, taskID int32) (int64, error) {
+	count, err := r.queries.CountTaskComments(ctx, taskID)
+	return count, translateError(err, commentResource, nil)
+}
+
+func (r *commentRepository) Update(ctx context.Context, id int32, body string, mentions []int32) (*db.TaskComment, error) {
+	comment, err := r.queries.UpdateTaskComment(ctx, db.UpdateTaskCommentParams{
+		ID:       id,
+		Body:     body,
+		Mentions: mentions,
+	})
+	return orError(comment, err, commentResource, id)
+}
+
+func (r *commentRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteTaskComment(ctx, id)
+	if err != nil {
+		return translateError(err, commentResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(commentResource, id)
+	}
+	return nil
+}
+
+func (r *commentRepository) ListRevisions(ctx context.Context, id int32) ([]*db.TaskCommentRevision, error) {
+	revisions, err := r.queries.ListTaskCommentRevisions(ctx, id)
+	return revisions, translateError(err, commentResource, id)
+}
@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type CustomFieldRepository interface {
+	// List поля проекта в порядке создания
+	List(ctx context.Context, projectID int32) ([]*db.ProjectCustomField, error)
+	GetByID(ctx context.Context, id int32) (*db.ProjectCustomField, error)
+	// Create добавляет поле; ключ, уже занятый в проекте, - ConflictError
+	Create(ctx context.Context, projectID int32, key, name, fieldType string, options []string, required bool) (*db.ProjectCustomField, error)
+	Update(ctx context.Context, id int32, name string, options []string, required bool) (*db.ProjectCustomField, error)
+	// Delete удаляет поле вместе с его значениями в задачах проекта
+	Delete(ctx context.Context, id int32) error
+	// CountWithValue число задач проекта, у которых поле key равно value или, для multi_select, содержит его
+	CountWithValue(ctx context.Context, projectID int32, key, value string) (int64, error)
+}
+
+// customFieldResource имя ресурса в доменных ошибках
+const customFieldResource = "custom_field"
+
+// customFieldKeyConstraint уникальность ключа поля в проекте (миграция 013)
+const customFieldKeyConstraint = "project_custom_fields_key_unique"
+
+type customFieldRepository struct {
+	queries *db.Queries
+}
+
+func NewCustomFieldRepository(queries *db.Queries) CustomFieldRepository {
+	return &customFieldRepository{
+		queries: queries,
+	}
+}
+
+func (r *customFieldRepository) List(ctx context.Context, projectID int32) ([]*db.ProjectCustomField, error) {
+	fields, err := r.queries.ListCustomFields(ctx, projectID)
+	return fields, translateError(err, customFieldResource, nil)
+}
+
+func (r *customFieldRepository) GetByID(ctx context.Context, id int32) (*db.ProjectCustomField, error) {
+	field, err := r.queries.GetCustomField(ctx, id)
+	return orError(field, err, customFieldResource, id)
+}
+
+func (r *customFieldRepository) Create(ctx context.Context, projectID int32, key, name, fieldType string, options []string, required bool) (*db.ProjectCustomField, error) {
+	field, err := r.queries.CreateCustomField(ctx, db.CreateCustomFieldParams{
+		ProjectID: projectID,
+		Key:       key,
+		Name:      name,
+		Type:      fieldType,
+		Options:   nonNilStrings(options),
+		Required:  required,
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == customFieldKeyConstraint {
+		return nil, &apperrors.ConflictError{Message: "project already has a field with this key", Constraint: pgErr.ConstraintName, Err: pgErr}
+	}
+	return orError(field, err, customFieldResource, nil)
+}
+
+func (r *customFieldRepository) Update(ctx context.Context, id int32, name string, options []string, required bool) (*db.ProjectCustomField, error) {
+	field, err := r.queries.UpdateCustomField(ctx, db.UpdateCustomFieldParams{
+		ID:       id,
+		Name:     name,
+		Options:  nonNilStrings(options),
+		Required: required,
+	})
+	return orError(field, err, customFieldResource, id)
+}
+
+func (r *customFieldRepository) Delete(ctx context.Context, id int32) error {
+	return translateError(r.queries.DeleteCustomField(ctx, id), customFieldResource, id)
+}
+
+func (r *customFieldRepository) CountWithValue(ctx context.Context, projectID int32, key, value string) (int64, error) {
+	count, err := r.queries.CountTasksWithFieldValue(ctx, db.CountTasksWithFieldValueParams{
+		ProjectID: projectID,
+		Key:       key,
+		Value:     value,
+	})
+	return count, translateError(err, customFieldResource, nil)
+}
+
+// nonNilStrings пустой массив вместо nil для столбцов-массивов NOT NULL (options, tags)
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type DependencyRepository interface {
+	// Add отмечает, что taskID заблокирована blockerID; ребро, замыкающее цикл, - ConflictError
+	Add(ctx context.Context, taskID, blockerID int32) (*db.TaskDependency, error)
+	Remove(ctx context.Context, taskID, blockerID int32) error
+	// Blockers задачи, которые блокируют taskID
+	Blockers(ctx context.Context, taskID int32) ([]*db.Task, error)
+	// Dependents задачи, которые ждут taskID
+	Dependents(ctx context.Context, taskID int32) ([]*db.Task, error)
+	// OpenBlockers идентификаторы невыполненных задач, блокирующих taskID
+	OpenBlockers(ctx context.Context, taskID int32) ([]int32, error)
+	// Blocked задачи из taskIDs, у которых есть невыполненные блокирующие задачи
+	Blocked(ctx context.Context, taskIDs []int32) ([]int32, error)
+	// ProjectGraph задачи проекта в порядке создания и их зависимости
+	ProjectGraph(ctx context.Context, projectID int32) ([]*db.Task, []*db.TaskDependency, error)
+}
+
+// dependencyResource имя ресурса в доменных ошибках
+const dependencyResource = "dependency"
+
+// cycleConstraint ограничение (и триггер) миграции 009, запрещающее циклы зависимостей
+const cycleConstraint = "task_dependencies_no_cycle"
+
+type dependencyRepository struct {
+	queries *db.Queries
+}
+
+func NewDependencyRepository(queries *db.Queries) DependencyRepository {
+	return &dependencyRepository{
+		queries: queries,
+	}
+}
+
+func (r *dependencyRepository) Add(ctx context.Context, taskID, blockerID int32) (*db.TaskDependency, error) {
+	dependency, err := r.queries.AddTaskDependency(ctx, db.AddTaskDependencyParams{
+		TaskID:    taskID,
+		BlockerID: blockerID,
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == cycleConstraint {
+		return nil, &apperrors.ConflictError{Message: "dependency would create a cycle", Constraint: pgErr.ConstraintName, Err: pgErr}
+	}
+	return orError(dependency, err, dependencyResource, nil)
+}
+
+func (r *dependencyRepository) Remove(ctx context.Context, taskID, blockerID int32) error {
+	rows, err := r.queries.DeleteTaskDependency(ctx, db.DeleteTaskDependencyParams{
+		TaskID:    taskID,
+		BlockerID: blockerID,
+	})
+	if err != nil {
+		return translateError(err, dependencyResource, blockerID)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(dependencyResource, blockerID)
+	}
+	return nil
+}
+
+func (r *dependencyRepository) Blockers(ctx context.Context, taskID int32) ([]*db.Task, error) {
+	tasks, err := r.queries.ListTaskBlockers(ctx, taskID)
+	return tasks, translateError(err, taskResource, nil)
+}
+
+func (r *dependencyRepository) Dependents(ctx context.Context, taskID int32) ([]*db.Task, error) {
+	tasks, err := r.queries.ListTaskDependents(ctx, taskID)
+	return tasks, translateError(err, taskResource, nil)
+}
+
+func (r *dependencyRepository) OpenBlockers(ctx context.Context, taskID int32) ([]int32, error) {
+	ids, err := r.queries.ListOpenBlockerIDs(ctx, taskID)
+	return ids, translateError(err, taskResource, nil)
+}
+
+func (r *dependencyRepository) Blocked(ctx context.Context, taskIDs []int32) ([]int32, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+	ids, err := r.queries.ListBlockedTaskIDs(ctx, taskIDs)
+	return ids, translateError(err, taskResource, nil)
+}
+
+func (r *dependencyRepository) ProjectGraph(ctx context.Context, projectID int32) ([]*db.Task, []*db.TaskDependency, error) {
+	project := pgtype.Int4{Int32: projectID, Valid: true}
+	tasks, err := r.queries.ListProjectTasks(ctx, project)
+	if err != nil {
+		return nil, nil, translateError(err, taskResource, nil)
+	}
+	dependencies, err := r.queries.ListProjectDependencies(ctx, project)
+	if err != nil {
+		return nil, nil, translateError(err, dependencyResource, nil)
+	}
+	return tasks, dependencies, nil
+}
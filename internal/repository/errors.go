@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	"GreatProject/internal/apperrors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/puddle/v2"
+)
+
+// translateError переводит ошибки pgx/PostgreSQL в доменные ошибки apperrors.
+// Ошибки контекста возвращаются как есть, чтобы их можно было отличить от сбоев БД.
+func translateError(err error, resource string, id any) error {
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperrors.NewNotFound(resource, id)
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return translatePgError(pgErr)
+	}
+
+	var connectErr *pgconn.ConnectError
+	var netErr net.Error
+	if errors.As(err, &connectErr) || errors.As(err, &netErr) || errors.Is(err, puddle.ErrClosedPool) {
+		return apperrors.NewUnavailable(err)
+	}
+
+	return err
+}
+
+// translatePgError сопоставляет SQLSTATE с категорией ошибки
+// (https://www.postgresql.org/docs/current/errcodes-appendix.html)
+func translatePgError(pgErr *pgconn.PgError) error {
+	switch pgErr.Code {
+	case "23505": // unique_violation
+		return &apperrors.ConflictError{Message: "resource already exists", Constraint: pgErr.ConstraintName, Err: pgErr}
+	case "23503": // foreign_key_violation
+		return &apperrors.ConflictError{Message: "referenced resource does not exist or is still in use", Constraint: pgErr.ConstraintName, Err: pgErr}
+	case "23P01": // exclusion_violation
+		return &apperrors.ConflictError{Message: "resource conflicts with an existing one", Constraint: pgErr.ConstraintName, Err: pgErr}
+	case "40001", "40P01": // serialization_failure, deadlock_detected
+		return &apperrors.ConflictError{Message: "concurrent modification, retry the request", Err: pgErr}
+	case "23502": // not_null_violation
+		return apperrors.NewValidation(fieldName(pgErr), "is required")
+	case "23514": // check_violation
+		return apperrors.NewValidation(fieldName(pgErr), "violates constraint "+pgErr.ConstraintName)
+	case "22001": // string_data_right_truncation
+		return apperrors.NewValidation(fieldName(pgErr), "is too long")
+	case "22P02", "22003", "22007", "22008": // invalid_text_representation, numeric_value_out_of_range, invalid_datetime_format, datetime_field_overflow
+		return apperrors.NewValidation(fieldName(pgErr), "has invalid format")
+	case "53300", "57P01", "57P02", "57P03": // too_many_connections, admin_shutdown, crash_shutdown, cannot_connect_now
+		return apperrors.NewUnavailable(pgErr)
+	}
+
+	// Класс 08 - ошибки соединения
+	if len(pgErr.Code) == 5 && pgErr.Code[:2] == "08" {
+		return apperrors.NewUnavailable(pgErr)
+	}
+
+	return pgErr
+}
+
+func fieldName(pgErr *pgconn.PgError) string {
+	if pgErr.ColumnName != "" {
+		return pgErr.ColumnName
+	}
+	if pgErr.ConstraintName != "" {
+		return pgErr.ConstraintName
+	}
+	return "body"
+}
+
+// orError не отдает наружу пустую запись, которую sqlc возвращает вместе с ошибкой
+func orError[T any](row *T, err error, resource string, id any) (*T, error) {
+	if err != nil {
+		return nil, translateError(err, resource, id)
+	}
+	return row, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MilestoneData изменяемые поля вехи
+type MilestoneData struct {
+	Name        string
+	Description string
+	// Start и End первый и последний день вехи (UTC); время суток не учитывается
+	Start time.Time
+	End   time.Time
+}
+
+type MilestoneRepository interface {
+	// List вехи проекта по дате начала
+	List(ctx context.Context, projectID int32) ([]*db.Milestone, error)
+	GetByID(ctx context.Context, id int32) (*db.Milestone, error)
+	Create(ctx context.Context, projectID int32, data MilestoneData) (*db.Milestone, error)
+	Update(ctx context.Context, id int32, data MilestoneData) (*db.Milestone, error)
+	Delete(ctx context.Context, id int32) error
+	// Progress число задач вех ids и выполненных из них; вехи без задач в результат не попадают
+	Progress(ctx context.Context, ids []int32) ([]*db.CountMilestoneProgressRow, error)
+	// Tasks задачи вехи в ручном порядке
+	Tasks(ctx context.Context, id int32, limit, offset int32) ([]*db.Task, error)
+	// Burndown остаток задач вехи на конец каждого дня с from по to включительно
+	Burndown(ctx context.Context, id int32, from, to time.Time) ([]*db.MilestoneBurndownRow, error)
+}
+
+// milestoneResource имя ресурса в доменных ошибках
+const milestoneResource = "milestone"
+
+type milestoneRepository struct {
+	queries *db.Queries
+}
+
+func NewMilestoneRepository(queries *db.Queries) MilestoneRepository {
+	return &milestoneRepository{
+		queries: queries,
+	}
+}
+
+func (r *milestoneRepository) List(ctx context.Context, projectID int32) ([]*db.Milestone, error) {
+	milestones, err := r.queries.ListMilestones(ctx, projectID)
+	return milestones, translateError(err, milestoneResource, nil)
+}
+
+func (r *milestoneRepository) GetByID(ctx context.Context, id int32) (*db.Milestone, error) {
+	milestone, err := r.queries.GetMilestone(ctx, id)
+	return orError(milestone, err, milestoneResource, id)
+}
+
+func (r *milestoneRepository) Create(ctx context.Context, projectID int32, data MilestoneData) (*db.Milestone, error) {
+	milestone, err := r.queries.CreateMilestone(ctx, db.CreateMilestoneParams{
+		ProjectID:   projectID,
+		Name:        data.Name,
+		Description: data.Description,
+		StartDate:   pgtype.Date{Time: data.Start, Valid: true},
+		EndDate:     pgtype.Date{Time: data.End, Valid: true},
+	})
+	return orError(milestone, err, milestoneResource, nil)
+}
+
+func (r *milestoneRepository) Update(ctx context.Context, id int32, data MilestoneData) (*db.Milestone, error) {
+	milestone, err := r.queries.UpdateMilestone(ctx, db.UpdateMilestoneParams{
+		ID:          id,
+		Name:        data.Name,
+		Description: data.Description,
+		StartDate:   pgtype.Date{Time: data.Start, Valid: true},
+		EndDate:     pgtype.Date{Time: data.End, Valid: true},
+	})
+	return orError(milestone, err, milestoneResource, id)
+}
+
+func (r *milestoneRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteMilestone(ctx, id)
+	if err != nil {
+		return translateError(err, milestoneResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(milestoneResource, id)
+	}
+	return nil
+}
+
+func (r *milestoneRepository) Progress(ctx context.Context, ids []int32) ([]*db.CountMilestoneProgressRow, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	progress, err := r.queries.CountMilestoneProgress(ctx, ids)
+	return progress, translateError(err, milestoneResource, nil)
+}
+
+func (r *milestoneRepository) Tasks(ctx context.Context, id int32, limit, offset int32) ([]*db.Task, error) {
+	tasks, err := r.queries.ListMilestoneTasks(ctx, db.ListMilestoneTasksParams{
+		MilestoneID: pgtype.Int4{Int32: id, Valid: true},
+		Limit:       limit,
+		Offset:      offset,
+	})
+	return tasks, translateError(err, taskResource, nil)
+}
+
+func (r *milestoneRepository) Burndown(ctx context.Context, id int32, from, to time.Time) ([]*db.MilestoneBurndownRow, error) {
+	days, err := r.queries.MilestoneBurndown(ctx, db.MilestoneBurndownParams{
+		StartDate:   pgtype.Date{Time: from, Valid: true},
+		EndDate:     pgtype.Date{Time: to, Valid: true},
+		MilestoneID: pgtype.Int4{Int32: id, Valid: true},
+	})
+	return days, translateError(err, milestoneResource, id)
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+)
+
+type ProjectRepository interface {
+	// Create создает проект и делает userID его владельцем
+	Create(ctx context.Context, userID int32, name string) (*db.Project, error)
+	GetByID(ctx context.Context, id int32) (*db.Project, error)
+	ListByMember(ctx context.Context, userID int32) ([]*db.ListProjectsByMemberRow, error)
+	Delete(ctx context.Context, id int32) error
+
+	// GetMemberRole роль пользователя в проекте; NotFound, если он не участник
+	GetMemberRole(ctx context.Context, projectID, userID int32) (string, error)
+	ListMembers(ctx context.Context, projectID int32) ([]*db.ProjectMember, error)
+	UpdateMemberRole(ctx context.Context, projectID, userID int32, role string) (*db.ProjectMember, error)
+	RemoveMember(ctx context.Context, projectID, userID int32) error
+	CountOwners(ctx context.Context, projectID int32) (int64, error)
+
+	CreateInvitation(ctx context.Context, projectID, userID int32, role string, invitedBy int32) (*db.ProjectInvitation, error)
+	GetInvitation(ctx context.Context, id int32) (*db.ProjectInvitation, error)
+	ListInvitations(ctx context.Context, projectID int32) ([]*db.ProjectInvitation, error)
+	ListInvitationsByUser(ctx context.Context, userID int32) ([]*db.ProjectInvitation, error)
+	DeleteInvitation(ctx context.Context, id int32) error
+	// AcceptInvitation превращает приглашение пользователя userID в участие
+	AcceptInvitation(ctx context.Context, id, userID int32) (*db.ProjectMember, error)
+}
+
+// Имена ресурсов в доменных ошибках
+const (
+	projectResource    = "project"
+	memberResource     = "project_member"
+	invitationResource = "invitation"
+)
+
+type projectRepository struct {
+	queries *db.Queries
+}
+
+func NewProjectRepository(queries *db.Queries) ProjectRepository {
+	return &projectRepository{
+		queries: queries,
+	}
+}
+
+func (r *projectRepository) Create(ctx context.Context, userID int32, name string) (*db.Project, error) {
+	project, err := r.queries.CreateProject(ctx, db.CreateProjectParams{Name: name, UserID: userID})
+	return orError(project, err, projectResource, nil)
+}
+
+func (r *projectRepository) GetByID(ctx context.Context, id int32) (*db.Project, error) {
+	project, err := r.queries.GetProject(ctx, id)
+	return orError(project, err, projectResource, id)
+}
+
+func (r *projectRepository) ListByMember(ctx context.Context, userID int32) ([]*db.ListProjectsByMemberRow, error) {
+	projects, err := r.queries.ListProjectsByMember(ctx, userID)
+	return projects, translateError(err, projectResource, nil)
+}
+
+func (r *projectRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteProject(ctx, id)
+	if err != nil {
+		return translateError(err, projectResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(projectResource, id)
+	}
+	return nil
+}
+
+func (r *projectRepository) GetMemberRole(ctx context.Context, projectID, userID int32) (string, error) {
+	role, err := r.queries.GetProjectMemberRole(ctx, db.GetProjectMemberRoleParams{ProjectID: projectID, UserID: userID})
+	return role, translateError(err, memberResource, userID)
+}
+
+func (r *projectRepository) ListMembers(ctx context.Context, projectID int32) ([]*db.ProjectMember, error) {
+	members, err := r.queries.ListProjectMembers(ctx, projectID)
+	return members, translateError(err, memberResource, nil)
+}
+
+func (r *projectRepository) UpdateMemberRole(ctx context.Context, projectID, userID int32, role string) (*db.ProjectMember, error) {
+	member, err := r.queries.UpdateProjectMemberRole(ctx, db.UpdateProjectMemberRoleParams{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      role,
+	})
+	return orError(member, err, memberResource, userID)
+}
+
+func (r *projectRepository) RemoveMember(ctx context.Context, projectID, userID int32) error {
+	rows, err := r.queries.DeleteProjectMember(ctx, db.DeleteProjectMemberParams{ProjectID: projectID, UserID: userID})
+	if err != nil {
+		return translateError(err, memberResource, userID)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(memberResource, userID)
+	}
+	return nil
+}
+
+func (r *projectRepository) CountOwners(ctx context.Context, projectID int32) (int64, error) {
+	count, err := r.queries.CountProjectOwners(ctx, projectID)
+	return count, translateError(err, memberResource, nil)
+}
+
+func (r *projectRepository) CreateInvitation(ctx context.Context, projectID, userID int32, role string, invitedBy int32) (*db.ProjectInvitation, error) {
+	invitation, err := r.queries.CreateProjectInvitation(ctx, db.CreateProjectInvitationParams{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      role,
+		InvitedBy: invitedBy,
+	})
+	return orError(invitation, err, invitationResource, nil)
+}
+
+func (r *projectRepository) GetInvitation(ctx context.Context, id int32) (*db.ProjectInvitation, error) {
+	invitation, err := r.queries.GetProjectInvitation(ctx, id)
+	return orError(invitation, err, invitationResource, id)
+}
+
+func (r *projectRepository) ListInvitations(ctx context.Context, projectID int32) ([]*db.ProjectInvitation, error) {
+	invitations, err := r.queries.ListProjectInvitations(ctx, projectID)
+	return invitations, translateError(err, invitationResource, nil)
+}
+
+func (r *projectRepository) ListInvitationsByUser(ctx context.Context, userID int32) ([]*db.ProjectInvitation, error) {
+	invitations, err := r.queries.ListInvitationsByUser(ctx, userID)
+	return invitations, translateError(err, invitationResource, nil)
+}
+
+func (r *projectRepository) DeleteInvitation(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteProjectInvitation(ctx, id)
+	if err != nil {
+		return translateError(err, invitationResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(invitationResource, id)
+	}
+	return nil
+}
+
+func (r *projectRepository) AcceptInvitation(ctx context.Context, id, userID int32) (*db.ProjectMember, error) {
+	member, err := r.queries.AcceptProjectInvitation(ctx, db.AcceptProjectInvitationParams{ID: id, UserID: userID})
+	return orError(member, err, invitationResource, id)
+}
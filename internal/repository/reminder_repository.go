@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type ReminderRepository interface {
+	// List напоминания пользователя о задаче с моментом срабатывания
+	List(ctx context.Context, taskID, userID int32) ([]*db.ListRemindersRow, error)
+	GetByID(ctx context.Context, id int32) (*db.TaskReminder, error)
+	// Create добавляет напоминание к моменту remindAt или, если он нулевой, за offsetMinutes до срока задачи
+	Create(ctx context.Context, taskID, userID int32, remindAt time.Time, offsetMinutes int32, email string) (*db.TaskReminder, error)
+	// Count число напоминаний пользователя о задаче
+	Count(ctx context.Context, taskID, userID int32) (int64, error)
+	Delete(ctx context.Context, id int32) error
+	// ClaimDue занимает на lease до limit напоминаний, время которых пришло; напоминания,
+	// занятые другим экземпляром сервера или не отправленные maxAttempts раз, пропускаются
+	ClaimDue(ctx context.Context, limit int32, lease time.Duration, maxAttempts int32) ([]*db.ClaimDueRemindersRow, error)
+	// Sent отмечает напоминание отправленным для срока задачи dueAt (нулевое время - срока нет)
+	Sent(ctx context.Context, id int32, dueAt time.Time) error
+	// Failed записывает неудачную попытку; следующая - не раньше чем через retryAfter
+	// и только в каналы не из delivered
+	Failed(ctx context.Context, id int32, reason string, retryAfter time.Duration, delivered []string) error
+}
+
+// reminderResource имя ресурса в доменных ошибках
+const reminderResource = "reminder"
+
+type reminderRepository struct {
+	queries *db.Queries
+}
+
+func NewReminderRepository(queries *db.Queries) ReminderRepository {
+	return &reminderRepository{
+		queries: queries,
+	}
+}
+
+func (r *reminderRepository) List(ctx context.Context, taskID, userID int32) ([]*db.ListRemindersRow, error) {
+	reminders, err := r.queries.ListReminders(ctx, db.ListRemindersParams{
+		TaskID: taskID,
+		UserID: userID,
+	})
+	return reminders, translateError(err, reminderResource, nil)
+}
+
+func (r *reminderRepository) GetByID(ctx context.Context, id int32) (*db.TaskReminder, error) {
+	reminder, err := r.queries.GetReminder(ctx, id)
+	return orError(reminder, err, reminderResource, id)
+}
+
+func (r *reminderRepository) Create(ctx context.Context, taskID, userID int32, remindAt time.Time, offsetMinutes int32, email string) (*db.TaskReminder, error) {
+	reminder, err := r.queries.CreateReminder(ctx, db.CreateReminderParams{
+		TaskID:        taskID,
+		UserID:        userID,
+		RemindAt:      pgtype.Timestamptz{Time: remindAt, Valid: !remindAt.IsZero()},
+		OffsetMinutes: pgtype.Int4{Int32: offsetMinutes, Valid: remindAt.IsZero()},
+		Email:         email,
+	})
+	return orError(reminder, err, reminderResource, nil)
+}
+
+func (r *reminderRepository) Count(ctx context.Context, taskID, userID int32) (int64, error) {
+	count, err := r.queries.CountUserReminders(ctx, db.CountUserRemindersParams{
+		TaskID: taskID,
+		UserID: userID,
+	})
+	return count, translateError(err, reminderResource, nil)
+}
+
+func (r *reminderRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteReminder(ctx, id)
+	if err != nil {
+		return translateError(err, reminderResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(reminderResource, id)
+	}
+	return nil
+}
+
+func (r *reminderRepository) ClaimDue(ctx context.Context, limit int32, lease time.Duration, maxAttempts int32) ([]*db.ClaimDueRemindersRow, error) {
+	reminders, err := r.queries.ClaimDueReminders(ctx, db.ClaimDueRemindersParams{
+		MaxAttempts:  maxAttempts,
+		Batch:        limit,
+		LeaseSeconds: int32(lease.Seconds()),
+	})
+	return reminders, translateError(err, reminderResource, nil)
+}
+
+func (r *reminderRepository) Sent(ctx context.Context, id int32, dueAt time.Time) error {
+	err := r.queries.MarkReminderSent(ctx, db.MarkReminderSentParams{
+		DueAt: pgtype.Timestamptz{Time: dueAt, Valid: !dueAt.IsZero()},
+		ID:    id,
+	})
+	return translateError(err, reminderResource, id)
+}
+
+func (r *reminderRepository) Failed(ctx context.Context, id int32, reason string, retryAfter time.Duration, delivered []string) error {
+	if delivered == nil {
+		// nil передается как NULL, а столбец NOT NULL
+		delivered = []string{}
+	}
+	err := r.queries.MarkReminderFailed(ctx, db.MarkReminderFailedParams{
+		LastError:         reason,
+		RetrySeconds:      int32(retryAfter.Seconds()),
+		DeliveredChannels: delivered,
+		ID:                id,
+	})
+	return translateError(err, reminderResource, id)
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	db "GreatProject/internal/database"
+	tenantdb "GreatProject/internal/db"
+	"GreatProject/internal/dbtest"
+	"GreatProject/internal/tenant"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// execInWorkspace выполняет SQL в транзакции с настройками RLS рабочего пространства
+func execInWorkspace(t *testing.T, pool *pgxpool.Pool, workspace int32, sql string, args ...any) {
+	t.Helper()
+	ctx := context.Background()
+	err := pgx.BeginFunc(ctx, pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `SELECT set_config('app.workspace_id', $1, true)`, strconv.Itoa(int(workspace))); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, sql, args...)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("%s: %v", sql, err)
+	}
+}
+
+// TestClaimDueConcurrently несколько экземпляров сервера одновременно забирают напоминания:
+// каждое занимает ровно один из них, а до конца аренды напоминания не забираются снова.
+// Запросы ограничены рабочим пространством теста, чтобы не занимать чужие напоминания в БД
+func TestClaimDueConcurrently(t *testing.T) {
+	const (
+		reminderCount = 30
+		workers       = 8
+		batch         = 3
+		lease         = time.Minute
+		maxAttempts   = 5
+	)
+	pool := dbtest.Pool(t)
+	queries := tenantdb.New(pool)
+	repo := NewReminderRepository(queries)
+
+	var workspace int32
+	if err := pool.QueryRow(context.Background(), `INSERT INTO workspaces (name) VALUES ('reminder claim test') RETURNING id`).Scan(&workspace); err != nil {
+		t.Fatalf("create workspace: %v", err)
+	}
+	ctx := tenant.WithWorkspace(context.Background(), workspace)
+	t.Cleanup(func() {
+		// напоминания удаляются вместе с задачами
+		execInWorkspace(t, pool, workspace, `DELETE FROM tasks WHERE workspace_id = $1`, workspace)
+		if _, err := pool.Exec(context.Background(), `DELETE FROM workspaces WHERE id = $1`, workspace); err != nil {
+			t.Errorf("delete workspace %d: %v", workspace, err)
+		}
+	})
+
+	task, err := queries.CreateTask(ctx, db.CreateTaskParams{Name: "reminder claim test", CustomFields: []byte("{}")})
+	if err != nil {
+		t.Fatalf("create task: %v", err)
+	}
+	want := map[int32]bool{}
+	for i := 0; i < reminderCount; i++ {
+		reminder, err := repo.Create(ctx, task.ID, 1, time.Now().Add(-time.Minute), 0, "")
+		if err != nil {
+			t.Fatalf("create reminder: %v", err)
+		}
+		want[reminder.ID] = true
+	}
+
+	var (
+		mu      sync.Mutex
+		claimed = map[int32]int{}
+		wg      sync.WaitGroup
+		errs    = make(chan error, workers)
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				due, err := repo.ClaimDue(ctx, batch, lease, maxAttempts)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if len(due) == 0 {
+					return
+				}
+				mu.Lock()
+				for _, reminder := range due {
+					claimed[reminder.ID]++
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("ClaimDue: %v", err)
+	}
+
+	for id, count := range claimed {
+		if !want[id] {
+			t.Errorf("claimed reminder %d from outside the test", id)
+		}
+		if count != 1 {
+			t.Errorf("reminder %d claimed %d times, want once", id, count)
+		}
+	}
+	if len(claimed) != reminderCount {
+		t.Fatalf("claimed %d reminders, want %d", len(claimed), reminderCount)
+	}
+
+	// до конца аренды напоминания заняты
+	if due, err := repo.ClaimDue(ctx, reminderCount, lease, maxAttempts); err != nil || len(due) != 0 {
+		t.Fatalf("ClaimDue within the lease = %d reminders, %v; want none", len(due), err)
+	}
+	// после аренды неотправленные напоминания забираются снова
+	execInWorkspace(t, pool, workspace, `UPDATE task_reminders SET claimed_until = now() - interval '1 second' WHERE task_id = $1`, task.ID)
+	if due, err := repo.ClaimDue(ctx, reminderCount, lease, maxAttempts); err != nil || len(due) != reminderCount {
+		t.Fatalf("ClaimDue after the lease = %d reminders, %v; want %d", len(due), err, reminderCount)
+	}
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StatsPeriod шаг ряда созданных и выполненных задач
+type StatsPeriod string
+
+const (
+	PeriodDay StatsPeriod = "day"
+	// PeriodWeek недели с понедельника
+	PeriodWeek StatsPeriod = "week"
+)
+
+// StatsRepository агрегаты по задачам, видимым пользователю userID; projectID > 0 - только по задачам
+// проекта. Дни и недели считаются по UTC
+type StatsRepository interface {
+	// Totals итоги по статусам и среднее время выполнения задач, выполненных в [from, to)
+	Totals(ctx context.Context, userID, projectID int32, from, to time.Time) (*db.TaskStatsRow, error)
+	ByProject(ctx context.Context, userID, projectID int32) ([]*db.TaskStatsByProjectRow, error)
+	// ByTag limit самых частых меток
+	ByTag(ctx context.Context, userID, projectID int32, limit int32) ([]*db.TaskStatsByTagRow, error)
+	// Series созданные и выполненные задачи по периодам с периода дня from по период дня to
+	Series(ctx context.Context, userID, projectID int32, period StatsPeriod, from, to time.Time) ([]*db.TaskStatsSeriesRow, error)
+	// Streaks текущая и самая длинная серия дней подряд, в которые пользователь выполнял задачи
+	Streaks(ctx context.Context, userID, projectID int32, today time.Time) (*db.CompletionStreaksRow, error)
+}
+
+type statsRepository struct {
+	queries *db.Queries
+}
+
+func NewStatsRepository(queries *db.Queries) StatsRepository {
+	return &statsRepository{
+		queries: queries,
+	}
+}
+
+func (r *statsRepository) Totals(ctx context.Context, userID, projectID int32, from, to time.Time) (*db.TaskStatsRow, error) {
+	totals, err := r.queries.TaskStats(ctx, db.TaskStatsParams{
+		CompletedFrom: pgtype.Timestamptz{Time: from, Valid: true},
+		CompletedTo:   pgtype.Timestamptz{Time: to, Valid: true},
+		UserID:        pgtype.Int4{Int32: userID, Valid: true},
+		ProjectID:     projectID,
+	})
+	return totals, translateError(err, taskResource, nil)
+}
+
+func (r *statsRepository) ByProject(ctx context.Context, userID, projectID int32) ([]*db.TaskStatsByProjectRow, error) {
+	rows, err := r.queries.TaskStatsByProject(ctx, db.TaskStatsByProjectParams{
+		UserID:    pgtype.Int4{Int32: userID, Valid: true},
+		ProjectID: projectID,
+	})
+	return rows, translateError(err, taskResource, nil)
+}
+
+func (r *statsRepository) ByTag(ctx context.Context, userID, projectID int32, limit int32) ([]*db.TaskStatsByTagRow, error) {
+	rows, err := r.queries.TaskStatsByTag(ctx, db.TaskStatsByTagParams{
+		UserID:    pgtype.Int4{Int32: userID, Valid: true},
+		ProjectID: projectID,
+		Limit:     limit,
+	})
+	return rows, translateError(err, taskResource, nil)
+}
+
+func (r *statsRepository) Series(ctx context.Context, userID, projectID int32, period StatsPeriod, from, to time.Time) ([]*db.TaskStatsSeriesRow, error) {
+	rows, err := r.queries.TaskStatsSeries(ctx, db.TaskStatsSeriesParams{
+		Period:    string(period),
+		FromDate:  pgtype.Date{Time: from, Valid: true},
+		ToDate:    pgtype.Date{Time: to, Valid: true},
+		UserID:    pgtype.Int4{Int32: userID, Valid: true},
+		ProjectID: projectID,
+	})
+	return rows, translateError(err, taskResource, nil)
+}
+
+func (r *statsRepository) Streaks(ctx context.Context, userID, projectID int32, today time.Time) (*db.CompletionStreaksRow, error) {
+	streaks, err := r.queries.CompletionStreaks(ctx, db.CompletionStreaksParams{
+		UserID:    pgtype.Int4{Int32: userID, Valid: true},
+		ProjectID: projectID,
+		Today:     pgtype.Date{Time: today, Valid: true},
+	})
+	return streaks, translateError(err, taskResource, nil)
+}
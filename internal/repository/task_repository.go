@@ -2,23 +2,106 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"GreatProject/internal/apperrors"
 	db "GreatProject/internal/database"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// TaskSort порядок списка задач
+type TaskSort string
+
+const (
+	// SortCreated новые задачи первыми
+	SortCreated TaskSort = "created_at"
+	// SortPosition ручной порядок (см. MoveTask)
+	SortPosition TaskSort = "position"
+	// SortField и SortFieldDesc по значению пользовательского поля TaskFilter.SortField;
+	// задачи без значения идут последними
+	SortField     TaskSort = "field"
+	SortFieldDesc TaskSort = "-field"
+)
+
+// TaskData изменяемые поля задачи
+type TaskData struct {
+	Name        string
+	Description string
+	Completed   bool
+	// CustomFields значения пользовательских полей в JSON
+	CustomFields []byte
+	// EstimateMinutes оценка задачи; 0 - без оценки
+	EstimateMinutes int32
+	// DueAt срок задачи; нулевое время - без срока
+	DueAt time.Time
+	// Tags метки задачи
+	Tags []string
+	// ParentID родительская задача (0 - нет); задается только при создании
+	ParentID int32
+	// MilestoneID веха задачи; 0 - без вехи
+	MilestoneID int32
+}
+
+// TaskFilter отбор и порядок списка задач
+type TaskFilter struct {
+	Sort      TaskSort
+	SortField string
+	// Fields значения пользовательских полей: задача подходит, если совпадают все
+	Fields []FieldFilter
+}
+
+// FieldFilter значение пользовательского поля Key в текстовом виде; для multi_select -
+// один из выбранных вариантов
+type FieldFilter struct {
+	Key   string
+	Value string
+}
+
+// fieldArgs ключи и значения фильтра параллельными массивами, как их принимают запросы
+func (f TaskFilter) fieldArgs() ([]string, []string) {
+	keys := make([]string, len(f.Fields))
+	values := make([]string, len(f.Fields))
+	for i, field := range f.Fields {
+		keys[i], values[i] = field.Key, field.Value
+	}
+	return keys, values
+}
+
 type TaskRepository interface {
-	GetAll(ctx context.Context, limit, offset int32) ([]*db.Task, error)
+	// GetAll задачи, видимые пользователю userID (0 - анонимный клиент)
+	GetAll(ctx context.Context, userID int32, filter TaskFilter, limit, offset int32) ([]*db.Task, error)
 	GetByID(ctx context.Context, id int32) (*db.Task, error)
-	Create(ctx context.Context, name, description string) (*db.Task, error)
-	Update(ctx context.Context, id int32, name, description string, completed bool) (*db.Task, error)
+	// Create создает задачу; ownerID == 0 - задача без владельца, projectID == 0 - вне проекта
+	Create(ctx context.Context, ownerID, projectID int32, data TaskData) (*db.Task, error)
+	Update(ctx context.Context, id int32, data TaskData) (*db.Task, error)
 	Delete(ctx context.Context, id int32) error
 	Complete(ctx context.Context, id int32) (*db.Task, error)
 	Uncomplete(ctx context.Context, id int32) (*db.Task, error)
-	GetByStatus(ctx context.Context, completed bool, limit, offset int32) ([]*db.Task, error)
+	GetByStatus(ctx context.Context, userID int32, completed bool, filter TaskFilter, limit, offset int32) ([]*db.Task, error)
+	// Count и CountByStatus считают все задачи без учета видимости (для метрик)
+	Count(ctx context.Context) (int64, error)
+	CountByStatus(ctx context.Context, completed bool) (int64, error)
+	CountVisible(ctx context.Context, userID int32, filter TaskFilter) (int64, error)
+	CountVisibleByStatus(ctx context.Context, userID int32, completed bool, filter TaskFilter) (int64, error)
+	CountByOwner(ctx context.Context, ownerID int32) (int64, error)
+	// LockQuota блокирует квоту задач владельца до конца транзакции (Transactor.InTx): конкурирующие
+	// операции, создающие его задачи, ждут ее завершения
+	LockQuota(ctx context.Context, ownerID int32) error
+	SetPosition(ctx context.Context, id int32, position float64) (*db.Task, error)
+	// PreviousPosition и NextPosition ранг соседа задачи id в ее списке без учета задачи excludeID;
+	// false - у задачи нет соседа с этой стороны
+	PreviousPosition(ctx context.Context, id, excludeID int32) (float64, bool, error)
+	NextPosition(ctx context.Context, id, excludeID int32) (float64, bool, error)
+	// Rebalance перенумеровывает список задачи id, сохраняя порядок
+	Rebalance(ctx context.Context, id int32) error
 }
 
+// taskResource имя ресурса в доменных ошибках
+const taskResource = "task"
+
 type taskRepository struct {
 	queries *db.Queries
 }
@@ -29,50 +112,177 @@ func NewTaskRepository(queries *db.Queries) TaskRepository {
 	}
 }
 
-func (r *taskRepository) GetAll(ctx context.Context, limit, offset int32) ([]*db.Task, error) {
-	return r.queries.ListTasks(ctx, db.ListTasksParams{
-		Limit:  limit,
-		Offset: offset,
+func (r *taskRepository) GetAll(ctx context.Context, userID int32, filter TaskFilter, limit, offset int32) ([]*db.Task, error) {
+	keys, values := filter.fieldArgs()
+	tasks, err := r.queries.ListTasks(ctx, db.ListTasksParams{
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		FieldKeys:   keys,
+		FieldValues: values,
+		Sort:        string(filter.Sort),
+		SortField:   filter.SortField,
+		Limit:       limit,
+		Offset:      offset,
 	})
+	return tasks, translateError(err, taskResource, nil)
 }
 
 func (r *taskRepository) GetByID(ctx context.Context, id int32) (*db.Task, error) {
-	return r.queries.GetTask(ctx, id)
+	task, err := r.queries.GetTask(ctx, id)
+	return taskOrError(task, err, id)
 }
 
-func (r *taskRepository) Create(ctx context.Context, name, description string) (*db.Task, error) {
-	return r.queries.CreateTask(ctx, db.CreateTaskParams{
-		Name:        name,
-		Description: pgtype.Text{String: description, Valid: description != ""},
-		Completed:   pgtype.Bool{Bool: false, Valid: true},
+func (r *taskRepository) Create(ctx context.Context, ownerID, projectID int32, data TaskData) (*db.Task, error) {
+	task, err := r.queries.CreateTask(ctx, db.CreateTaskParams{
+		Name:            data.Name,
+		Description:     pgtype.Text{String: data.Description, Valid: data.Description != ""},
+		Completed:       pgtype.Bool{Bool: data.Completed, Valid: true},
+		OwnerID:         pgtype.Int4{Int32: ownerID, Valid: ownerID != 0},
+		ProjectID:       pgtype.Int4{Int32: projectID, Valid: projectID != 0},
+		CustomFields:    data.CustomFields,
+		EstimateMinutes: pgtype.Int4{Int32: data.EstimateMinutes, Valid: data.EstimateMinutes != 0},
+		DueAt:           pgtype.Timestamptz{Time: data.DueAt, Valid: !data.DueAt.IsZero()},
+		ParentID:        pgtype.Int4{Int32: data.ParentID, Valid: data.ParentID != 0},
+		Tags:            nonNilStrings(data.Tags),
+		MilestoneID:     pgtype.Int4{Int32: data.MilestoneID, Valid: data.MilestoneID != 0},
 	})
+	return taskOrError(task, err, nil)
 }
 
-func (r *taskRepository) Update(ctx context.Context, id int32, name, description string, completed bool) (*db.Task, error) {
-	return r.queries.UpdateTask(ctx, db.UpdateTaskParams{
-		ID:          id,
-		Name:        name,
-		Description: pgtype.Text{String: description, Valid: description != ""},
-		Completed:   pgtype.Bool{Bool: completed, Valid: true},
+func (r *taskRepository) Update(ctx context.Context, id int32, data TaskData) (*db.Task, error) {
+	task, err := r.queries.UpdateTask(ctx, db.UpdateTaskParams{
+		ID:              id,
+		Name:            data.Name,
+		Description:     pgtype.Text{String: data.Description, Valid: data.Description != ""},
+		Completed:       pgtype.Bool{Bool: data.Completed, Valid: true},
+		CustomFields:    data.CustomFields,
+		EstimateMinutes: pgtype.Int4{Int32: data.EstimateMinutes, Valid: data.EstimateMinutes != 0},
+		DueAt:           pgtype.Timestamptz{Time: data.DueAt, Valid: !data.DueAt.IsZero()},
+		Tags:            nonNilStrings(data.Tags),
+		MilestoneID:     pgtype.Int4{Int32: data.MilestoneID, Valid: data.MilestoneID != 0},
 	})
+	return taskOrError(task, err, id)
 }
 
 func (r *taskRepository) Delete(ctx context.Context, id int32) error {
-	return r.queries.DeleteTask(ctx, id)
+	rows, err := r.queries.DeleteTask(ctx, id)
+	if err != nil {
+		return translateError(err, taskResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(taskResource, id)
+	}
+	return nil
 }
 
 func (r *taskRepository) Complete(ctx context.Context, id int32) (*db.Task, error) {
-	return r.queries.CompleteTask(ctx, id)
+	task, err := r.queries.CompleteTask(ctx, id)
+	return taskOrError(task, err, id)
 }
 
 func (r *taskRepository) Uncomplete(ctx context.Context, id int32) (*db.Task, error) {
-	return r.queries.UncompleteTask(ctx, id)
+	task, err := r.queries.UncompleteTask(ctx, id)
+	return taskOrError(task, err, id)
+}
+
+func (r *taskRepository) GetByStatus(ctx context.Context, userID int32, completed bool, filter TaskFilter, limit, offset int32) ([]*db.Task, error) {
+	keys, values := filter.fieldArgs()
+	tasks, err := r.queries.ListTasksByStatus(ctx, db.ListTasksByStatusParams{
+		Completed:   pgtype.Bool{Bool: completed, Valid: true},
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		FieldKeys:   keys,
+		FieldValues: values,
+		Sort:        string(filter.Sort),
+		SortField:   filter.SortField,
+		Limit:       limit,
+		Offset:      offset,
+	})
+	return tasks, translateError(err, taskResource, nil)
+}
+
+func (r *taskRepository) Count(ctx context.Context) (int64, error) {
+	count, err := r.queries.CountTasks(ctx)
+	return count, translateError(err, taskResource, nil)
+}
+
+func (r *taskRepository) CountByStatus(ctx context.Context, completed bool) (int64, error) {
+	count, err := r.queries.CountTasksByStatus(ctx, pgtype.Bool{Bool: completed, Valid: true})
+	return count, translateError(err, taskResource, nil)
+}
+
+func (r *taskRepository) CountVisible(ctx context.Context, userID int32, filter TaskFilter) (int64, error) {
+	keys, values := filter.fieldArgs()
+	count, err := r.queries.CountVisibleTasks(ctx, db.CountVisibleTasksParams{
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		FieldKeys:   keys,
+		FieldValues: values,
+	})
+	return count, translateError(err, taskResource, nil)
+}
+
+func (r *taskRepository) CountVisibleByStatus(ctx context.Context, userID int32, completed bool, filter TaskFilter) (int64, error) {
+	keys, values := filter.fieldArgs()
+	count, err := r.queries.CountVisibleTasksByStatus(ctx, db.CountVisibleTasksByStatusParams{
+		Completed:   pgtype.Bool{Bool: completed, Valid: true},
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		FieldKeys:   keys,
+		FieldValues: values,
+	})
+	return count, translateError(err, taskResource, nil)
+}
+
+func (r *taskRepository) CountByOwner(ctx context.Context, ownerID int32) (int64, error) {
+	count, err := r.queries.CountTasksByOwner(ctx, pgtype.Int4{Int32: ownerID, Valid: true})
+	return count, translateError(err, taskResource, nil)
+}
+
+func (r *taskRepository) LockQuota(ctx context.Context, ownerID int32) error {
+	return translateError(r.queries.LockTaskQuota(ctx, ownerID), taskResource, nil)
+}
+
+func (r *taskRepository) SetPosition(ctx context.Context, id int32, position float64) (*db.Task, error) {
+	task, err := r.queries.SetTaskPosition(ctx, db.SetTaskPositionParams{
+		ID:       id,
+		Position: position,
+	})
+	return taskOrError(task, err, id)
 }
 
-func (r *taskRepository) GetByStatus(ctx context.Context, completed bool, limit, offset int32) ([]*db.Task, error) {
-	return r.queries.ListTasksByStatus(ctx, db.ListTasksByStatusParams{
-		Completed: pgtype.Bool{Bool: completed, Valid: true},
-		Limit:     limit,
-		Offset:    offset,
+func (r *taskRepository) PreviousPosition(ctx context.Context, id, excludeID int32) (float64, bool, error) {
+	position, err := r.queries.GetPreviousTaskPosition(ctx, db.GetPreviousTaskPositionParams{
+		ID:        id,
+		ExcludeID: excludeID,
 	})
+	return positionOrError(position, err)
+}
+
+func (r *taskRepository) NextPosition(ctx context.Context, id, excludeID int32) (float64, bool, error) {
+	position, err := r.queries.GetNextTaskPosition(ctx, db.GetNextTaskPositionParams{
+		ID:        id,
+		ExcludeID: excludeID,
+	})
+	return positionOrError(position, err)
+}
+
+func (r *taskRepository) Rebalance(ctx context.Context, id int32) error {
+	_, err := r.queries.RebalanceTaskList(ctx, id)
+	return translateError(err, taskResource, id)
+}
+
+// positionOrError отсутствие соседа - не ошибка
+func positionOrError(position float64, err error) (float64, bool, error) {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, translateError(err, taskResource, nil)
+	}
+	return position, true, nil
+}
+
+// taskOrError не отдает наружу пустую задачу, которую sqlc возвращает вместе с ошибкой
+func taskOrError(task *db.Task, err error, id any) (*db.Task, error) {
+	if err != nil {
+		return nil, translateError(err, taskResource, id)
+	}
+	return task, nil
 }
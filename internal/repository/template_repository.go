@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// TemplateData изменяемые поля шаблона
+type TemplateData struct {
+	Name        string
+	Description string
+	// Tasks дерево задач шаблона в JSON
+	Tasks []byte
+}
+
+// TemplateNode задача, создаваемая по шаблону. Узлы передаются в порядке обхода дерева:
+// родитель раньше своих подзадач
+type TemplateNode struct {
+	// Parent номер родителя в списке узлов; -1 - задача верхнего уровня
+	Parent      int
+	Name        string
+	Description string
+	Tags        []string
+	// DueAt срок задачи; нулевое время - без срока
+	DueAt time.Time
+}
+
+type TemplateRepository interface {
+	// List шаблоны, видимые пользователю userID; projectID > 0 - только шаблоны проекта
+	List(ctx context.Context, userID, projectID int32) ([]*db.TaskTemplate, error)
+	GetByID(ctx context.Context, id int32) (*db.TaskTemplate, error)
+	// Create создает шаблон; projectID == 0 - личный шаблон владельца ownerID
+	Create(ctx context.Context, ownerID, projectID int32, data TemplateData) (*db.TaskTemplate, error)
+	Update(ctx context.Context, id int32, data TemplateData) (*db.TaskTemplate, error)
+	Delete(ctx context.Context, id int32) error
+	// CreateTasks создает задачи nodes одной командой - все или ни одной;
+	// задачи возвращаются в порядке nodes
+	CreateTasks(ctx context.Context, ownerID, projectID int32, nodes []TemplateNode) ([]*db.Task, error)
+}
+
+// templateResource имя ресурса в доменных ошибках
+const templateResource = "template"
+
+type templateRepository struct {
+	queries *db.Queries
+}
+
+func NewTemplateRepository(queries *db.Queries) TemplateRepository {
+	return &templateRepository{
+		queries: queries,
+	}
+}
+
+func (r *templateRepository) List(ctx context.Context, userID, projectID int32) ([]*db.TaskTemplate, error) {
+	templates, err := r.queries.ListTemplates(ctx, db.ListTemplatesParams{
+		UserID:    userID,
+		ProjectID: projectID,
+	})
+	return templates, translateError(err, templateResource, nil)
+}
+
+func (r *templateRepository) GetByID(ctx context.Context, id int32) (*db.TaskTemplate, error) {
+	template, err := r.queries.GetTemplate(ctx, id)
+	return orError(template, err, templateResource, id)
+}
+
+func (r *templateRepository) Create(ctx context.Context, ownerID, projectID int32, data TemplateData) (*db.TaskTemplate, error) {
+	template, err := r.queries.CreateTemplate(ctx, db.CreateTemplateParams{
+		ProjectID:   pgtype.Int4{Int32: projectID, Valid: projectID != 0},
+		OwnerID:     ownerID,
+		Name:        data.Name,
+		Description: data.Description,
+		Tasks:       data.Tasks,
+	})
+	return orError(template, err, templateResource, nil)
+}
+
+func (r *templateRepository) Update(ctx context.Context, id int32, data TemplateData) (*db.TaskTemplate, error) {
+	template, err := r.queries.UpdateTemplate(ctx, db.UpdateTemplateParams{
+		ID:          id,
+		Name:        data.Name,
+		Description: data.Description,
+		Tasks:       data.Tasks,
+	})
+	return orError(template, err, templateResource, id)
+}
+
+func (r *templateRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteTemplate(ctx, id)
+	if err != nil {
+		return translateError(err, templateResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(templateResource, id)
+	}
+	return nil
+}
+
+// templateNode узел в формате параметра @nodes запроса CreateTemplateTasks
+type templateNode struct {
+	Index       int        `json:"idx"`
+	Parent      *int       `json:"parent_idx"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Tags        []string   `json:"tags"`
+	DueAt       *time.Time `json:"due_at"`
+}
+
+func (r *templateRepository) CreateTasks(ctx context.Context, ownerID, projectID int32, nodes []TemplateNode) ([]*db.Task, error) {
+	params := make([]templateNode, len(nodes))
+	for i, node := range nodes {
+		params[i] = templateNode{
+			Index:       i,
+			Name:        node.Name,
+			Description: node.Description,
+			Tags:        nonNilStrings(node.Tags),
+		}
+		if node.Parent >= 0 {
+			parent := node.Parent
+			params[i].Parent = &parent
+		}
+		if !node.DueAt.IsZero() {
+			due := node.DueAt
+			params[i].DueAt = &due
+		}
+	}
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("encode template tasks: %w", err)
+	}
+
+	tasks, err := r.queries.CreateTemplateTasks(ctx, db.CreateTemplateTasksParams{
+		Nodes:     payload,
+		OwnerID:   pgtype.Int4{Int32: ownerID, Valid: ownerID != 0},
+		ProjectID: pgtype.Int4{Int32: projectID, Valid: projectID != 0},
+	})
+	if err != nil {
+		return nil, translateError(err, taskResource, nil)
+	}
+	// идентификаторы выданы в порядке узлов, а вставка шла с конца
+	slices.SortFunc(tasks, func(a, b *db.Task) int { return cmp.Compare(a.ID, b.ID) })
+	return tasks, nil
+}
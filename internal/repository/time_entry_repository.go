@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type TimeEntryRepository interface {
+	// List записи времени задачи, новые первыми
+	List(ctx context.Context, taskID int32) ([]*db.TaskTimeEntry, error)
+	GetByID(ctx context.Context, id int32) (*db.TaskTimeEntry, error)
+	// Running идущий таймер пользователя; нет таймера - NotFoundError
+	Running(ctx context.Context, userID int32) (*db.TaskTimeEntry, error)
+	// Create добавляет завершенный интервал работы
+	Create(ctx context.Context, taskID, userID int32, startedAt, endedAt time.Time, note string) (*db.TaskTimeEntry, error)
+	// Start запускает таймер, останавливая прежний таймер пользователя в той же транзакции
+	Start(ctx context.Context, taskID, userID int32, note string) (*db.TaskTimeEntry, error)
+	// Stop останавливает таймер пользователя на задаче; таймер не запущен - NotFoundError
+	Stop(ctx context.Context, taskID, userID int32) (*db.TaskTimeEntry, error)
+	Delete(ctx context.Context, id int32) error
+	// Totals учтенное время задачи в секундах и итоги вместе с ее подзадачами
+	Totals(ctx context.Context, taskID int32) (*db.GetTaskTimeTotalsRow, error)
+	// ProjectTasks оценка и учтенное время каждой задачи проекта, в том числе вместе с ее подзадачами
+	ProjectTasks(ctx context.Context, projectID int32) ([]*db.ListProjectTaskTimeRow, error)
+	// Timesheet учтенное время на задачах, видимых пользователю userID
+	Timesheet(ctx context.Context, userID int32, query TimesheetQuery) ([]*db.TimesheetRow, error)
+}
+
+// TimesheetQuery период и группировка табеля
+type TimesheetQuery struct {
+	// From и To - полуинтервал [From, To) времени начала записей
+	From, To  time.Time
+	ByDay     bool
+	ByUser    bool
+	ByProject bool
+	// ProjectID и MemberID отбирают записи проекта и пользователя; 0 - без отбора
+	ProjectID int32
+	MemberID  int32
+}
+
+// timeEntryResource имя ресурса в доменных ошибках
+const timeEntryResource = "time_entry"
+
+// runningTimerConstraint ограничение миграции 014: не больше одного идущего таймера у пользователя
+const runningTimerConstraint = "task_time_entries_one_running"
+
+type timeEntryRepository struct {
+	queries *db.Queries
+}
+
+func NewTimeEntryRepository(queries *db.Queries) TimeEntryRepository {
+	return &timeEntryRepository{
+		queries: queries,
+	}
+}
+
+func (r *timeEntryRepository) List(ctx context.Context, taskID int32) ([]*db.TaskTimeEntry, error) {
+	entries, err := r.queries.ListTimeEntries(ctx, taskID)
+	return entries, translateError(err, timeEntryResource, nil)
+}
+
+func (r *timeEntryRepository) GetByID(ctx context.Context, id int32) (*db.TaskTimeEntry, error) {
+	entry, err := r.queries.GetTimeEntry(ctx, id)
+	return orError(entry, err, timeEntryResource, id)
+}
+
+func (r *timeEntryRepository) Running(ctx context.Context, userID int32) (*db.TaskTimeEntry, error) {
+	entry, err := r.queries.GetRunningTimeEntry(ctx, userID)
+	return orError(entry, err, "timer", nil)
+}
+
+func (r *timeEntryRepository) Create(ctx context.Context, taskID, userID int32, startedAt, endedAt time.Time, note string) (*db.TaskTimeEntry, error) {
+	entry, err := r.queries.CreateTimeEntry(ctx, db.CreateTimeEntryParams{
+		TaskID:    taskID,
+		UserID:    userID,
+		StartedAt: pgtype.Timestamptz{Time: startedAt, Valid: true},
+		EndedAt:   pgtype.Timestamptz{Time: endedAt, Valid: true},
+		Note:      note,
+	})
+	return orError(entry, err, timeEntryResource, nil)
+}
+
+func (r *timeEntryRepository) Start(ctx context.Context, taskID, userID int32, note string) (*db.TaskTimeEntry, error) {
+	entry, err := r.queries.StartTimer(ctx, db.StartTimerParams{
+		UserID: userID,
+		TaskID: taskID,
+		Note:   note,
+	})
+	// одновременно запущенный таймер того же пользователя проверяется при фиксации транзакции
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == runningTimerConstraint {
+		return nil, &apperrors.ConflictError{Message: "another timer was started at the same time, retry the request", Constraint: pgErr.ConstraintName, Err: pgErr}
+	}
+	return orError(entry, err, timeEntryResource, nil)
+}
+
+func (r *timeEntryRepository) Stop(ctx context.Context, taskID, userID int32) (*db.TaskTimeEntry, error) {
+	entry, err := r.queries.StopTimer(ctx, db.StopTimerParams{
+		TaskID: taskID,
+		UserID: userID,
+	})
+	return orError(entry, err, "timer", taskID)
+}
+
+func (r *timeEntryRepository) Delete(ctx context.Context, id int32) error {
+	rows, err := r.queries.DeleteTimeEntry(ctx, id)
+	if err != nil {
+		return translateError(err, timeEntryResource, id)
+	}
+	if rows == 0 {
+		return apperrors.NewNotFound(timeEntryResource, id)
+	}
+	return nil
+}
+
+func (r *timeEntryRepository) Totals(ctx context.Context, taskID int32) (*db.GetTaskTimeTotalsRow, error) {
+	totals, err := r.queries.GetTaskTimeTotals(ctx, taskID)
+	return totals, translateError(err, timeEntryResource, nil)
+}
+
+func (r *timeEntryRepository) ProjectTasks(ctx context.Context, projectID int32) ([]*db.ListProjectTaskTimeRow, error) {
+	rows, err := r.queries.ListProjectTaskTime(ctx, pgtype.Int4{Int32: projectID, Valid: true})
+	return rows, translateError(err, timeEntryResource, nil)
+}
+
+func (r *timeEntryRepository) Timesheet(ctx context.Context, userID int32, query TimesheetQuery) ([]*db.TimesheetRow, error) {
+	rows, err := r.queries.Timesheet(ctx, db.TimesheetParams{
+		ByDay:       query.ByDay,
+		ByUser:      query.ByUser,
+		ByProject:   query.ByProject,
+		PeriodStart: pgtype.Timestamptz{Time: query.From, Valid: true},
+		PeriodEnd:   pgtype.Timestamptz{Time: query.To, Valid: true},
+		UserID:      pgtype.Int4{Int32: userID, Valid: true},
+		ProjectID:   pgtype.Int4{Int32: query.ProjectID, Valid: query.ProjectID != 0},
+		MemberID:    pgtype.Int4{Int32: query.MemberID, Valid: query.MemberID != 0},
+	})
+	return rows, translateError(err, timeEntryResource, nil)
+}
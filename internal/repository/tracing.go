@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedTaskRepository декоратор TaskRepository, открывающий span на каждый вызов
+type tracedTaskRepository struct {
+	next TaskRepository
+}
+
+func NewTracedTaskRepository(next TaskRepository) TaskRepository {
+	return &tracedTaskRepository{
+		next: next,
+	}
+}
+
+func (s *tracedTaskRepository) GetAll(ctx context.Context, userID int32, filter TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.GetAll", trace.WithAttributes(attribute.String("tasks.sort", string(filter.Sort))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetAll(ctx, userID, filter, limit, offset)
+}
+
+func (s *tracedTaskRepository) GetByID(ctx context.Context, id int32) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.GetByID", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetByID(ctx, id)
+}
+
+func (s *tracedTaskRepository) Create(ctx context.Context, ownerID, projectID int32, data TaskData) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Create")
+	defer func() { tracing.End(span, err) }()
+	return s.next.Create(ctx, ownerID, projectID, data)
+}
+
+func (s *tracedTaskRepository) Update(ctx context.Context, id int32, data TaskData) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Update", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.Update(ctx, id, data)
+}
+
+func (s *tracedTaskRepository) Delete(ctx context.Context, id int32) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Delete", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.Delete(ctx, id)
+}
+
+func (s *tracedTaskRepository) Complete(ctx context.Context, id int32) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Complete", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.Complete(ctx, id)
+}
+
+func (s *tracedTaskRepository) Uncomplete(ctx context.Context, id int32) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Uncomplete", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.Uncomplete(ctx, id)
+}
+
+func (s *tracedTaskRepository) GetByStatus(ctx context.Context, userID int32, completed bool, filter TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.GetByStatus", trace.WithAttributes(
+		attribute.Bool("task.completed", completed),
+		attribute.String("tasks.sort", string(filter.Sort)),
+	))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetByStatus(ctx, userID, completed, filter, limit, offset)
+}
+
+func (s *tracedTaskRepository) Count(ctx context.Context) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Count")
+	defer func() { tracing.End(span, err) }()
+	return s.next.Count(ctx)
+}
+
+func (s *tracedTaskRepository) CountByStatus(ctx context.Context, completed bool) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.CountByStatus", trace.WithAttributes(attribute.Bool("task.completed", completed)))
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountByStatus(ctx, completed)
+}
+
+func (s *tracedTaskRepository) CountVisible(ctx context.Context, userID int32, filter TaskFilter) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.CountVisible")
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountVisible(ctx, userID, filter)
+}
+
+func (s *tracedTaskRepository) CountVisibleByStatus(ctx context.Context, userID int32, completed bool, filter TaskFilter) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.CountVisibleByStatus", trace.WithAttributes(attribute.Bool("task.completed", completed)))
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountVisibleByStatus(ctx, userID, completed, filter)
+}
+
+func (s *tracedTaskRepository) CountByOwner(ctx context.Context, ownerID int32) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.CountByOwner", trace.WithAttributes(attribute.Int("task.owner_id", int(ownerID))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountByOwner(ctx, ownerID)
+}
+
+func (s *tracedTaskRepository) LockQuota(ctx context.Context, ownerID int32) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.LockQuota", trace.WithAttributes(attribute.Int("task.owner_id", int(ownerID))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.LockQuota(ctx, ownerID)
+}
+
+func (s *tracedTaskRepository) SetPosition(ctx context.Context, id int32, position float64) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.SetPosition", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.SetPosition(ctx, id, position)
+}
+
+func (s *tracedTaskRepository) PreviousPosition(ctx context.Context, id, excludeID int32) (position float64, found bool, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.PreviousPosition", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.PreviousPosition(ctx, id, excludeID)
+}
+
+func (s *tracedTaskRepository) NextPosition(ctx context.Context, id, excludeID int32) (position float64, found bool, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.NextPosition", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.NextPosition(ctx, id, excludeID)
+}
+
+func (s *tracedTaskRepository) Rebalance(ctx context.Context, id int32) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskRepository.Rebalance", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.Rebalance(ctx, id)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	db "GreatProject/internal/database"
+	tenantdb "GreatProject/internal/db"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Transactor выполняет несколько операций репозиториев атомарно
+type Transactor interface {
+	// InTx выполняет fn в одной транзакции рабочего пространства из ctx; репозитории, вызванные
+	// с контекстом fn, работают в ней. Ошибка fn откатывает транзакцию и возвращается как есть
+	InTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// InSnapshot как InTx, но транзакция только для чтения с уровнем REPEATABLE READ:
+	// все запросы fn видят данные на один момент
+	InSnapshot(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type transactor struct {
+	pool *pgxpool.Pool
+}
+
+func NewTransactor(pool *pgxpool.Pool) Transactor {
+	return &transactor{
+		pool: pool,
+	}
+}
+
+func (t *transactor) InTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	err := tenantdb.WithTenantTx(ctx, t.pool, func(ctx context.Context, _ *db.Queries) error {
+		return fn(ctx)
+	})
+	return translateError(err, "", nil)
+}
+
+func (t *transactor) InSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	options := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err := tenantdb.WithTenantTxOptions(ctx, t.pool, options, func(ctx context.Context, _ *db.Queries) error {
+		return fn(ctx)
+	})
+	return translateError(err, "", nil)
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/tenant"
+)
+
+type APIKeyService interface {
+	// CreateAPIKey выпускает ключ текущему пользователю; секрет возвращается только здесь и при ротации
+	CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*db.ApiKey, string, error)
+	ListAPIKeys(ctx context.Context) ([]*db.ApiKey, error)
+	RevokeAPIKey(ctx context.Context, id int32) error
+	RotateAPIKey(ctx context.Context, id int32) (*db.ApiKey, string, error)
+	auth.APIKeyAuthenticator
+}
+
+type apiKeyService struct {
+	repo repository.APIKeyRepository
+}
+
+func NewAPIKeyService(repo repository.APIKeyRepository) APIKeyService {
+	return &apiKeyService{
+		repo: repo,
+	}
+}
+
+// keyOwner пользователь, управляющий ключами. Ключами управляет только сам пользователь:
+// иначе утекший ключ позволил бы выпустить себе новые.
+func keyOwner(ctx context.Context) (int32, error) {
+	principal, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return 0, apperrors.ErrUnauthenticated
+	}
+	if principal.IsAPIKey() {
+		return 0, apperrors.NewForbidden("API keys cannot be managed with an API key", "")
+	}
+	return principal.UserID, nil
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, name string, scopes []string, expiresAt *time.Time) (*db.ApiKey, string, error) {
+	userID, err := keyOwner(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, scope := range scopes {
+		if !slices.Contains(auth.Scopes, scope) {
+			return nil, "", apperrors.NewValidation("scopes", "unknown scope "+scope)
+		}
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", apperrors.NewValidation("expires_at", "must be in the future")
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey, err := s.repo.Create(ctx, userID, name, prefix, hash, scopes, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+func (s *apiKeyService) ListAPIKeys(ctx context.Context) ([]*db.ApiKey, error) {
+	userID, err := keyOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListByUser(ctx, userID)
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, id int32) error {
+	userID, err := keyOwner(ctx)
+	if err != nil {
+		return err
+	}
+	return s.repo.Revoke(ctx, id, userID)
+}
+
+// RotateAPIKey выдает ключу новый секрет; старый перестает действовать сразу
+func (s *apiKeyService) RotateAPIKey(ctx context.Context, id int32) (*db.ApiKey, string, error) {
+	userID, err := keyOwner(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key, prefix, hash, err := auth.NewAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiKey, err := s.repo.Rotate(ctx, id, userID, prefix, hash)
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+// Authenticate находит действующий ключ и отмечает его использование.
+// Сбой записи времени использования не мешает запросу.
+func (s *apiKeyService) Authenticate(ctx context.Context, key, ip string) (auth.Principal, error) {
+	// Пространство клиента станет известно только из ключа, поэтому ключ ищется во всех
+	apiKey, err := s.repo.GetByHash(tenant.WithAllWorkspaces(ctx), auth.HashAPIKey(key))
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return auth.Principal{}, auth.ErrInvalidCredentials
+	}
+	if err != nil {
+		return auth.Principal{}, err
+	}
+
+	if apiKey.RevokedAt.Valid || (apiKey.ExpiresAt.Valid && !apiKey.ExpiresAt.Time.After(time.Now())) {
+		return auth.Principal{}, auth.ErrInvalidCredentials
+	}
+
+	if err := s.repo.Touch(tenant.WithWorkspace(ctx, apiKey.WorkspaceID), apiKey.ID, ip); err != nil {
+		slog.WarnContext(ctx, "failed to record API key usage",
+			slog.Int("api_key_id", int(apiKey.ID)),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	return auth.Principal{
+		UserID:      apiKey.UserID,
+		WorkspaceID: apiKey.WorkspaceID,
+		APIKeyID:    apiKey.ID,
+		Scopes:      apiKey.Scopes,
+	}, nil
+}
@@ -0,0 +1,320 @@
+package service
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/storage"
+	"GreatProject/internal/tenant"
+)
+
+// AttachmentService вложения задач. Описание хранится в БД, содержимое - в BlobStore.
+// Видеть и скачивать вложения может любой, кому видна задача; загружать и удалять - с правом tasks:write.
+type AttachmentService interface {
+	// Upload сохраняет содержимое потоком, не держа его в памяти целиком.
+	// checksum - ожидаемый SHA-256 содержимого (hex); пустой - не проверяется
+	Upload(ctx context.Context, taskID int32, filename string, content io.Reader, checksum string) (*db.TaskAttachment, error)
+	ListAttachments(ctx context.Context, taskID int32) ([]*db.TaskAttachment, error)
+	GetAttachment(ctx context.Context, taskID, id int32) (*db.TaskAttachment, error)
+	// Open открывает содержимое вложения; вызывающий закрывает Blob
+	Open(ctx context.Context, taskID, id int32) (*db.TaskAttachment, storage.Blob, error)
+	DeleteAttachment(ctx context.Context, taskID, id int32) error
+	// PurgeDeleted удаляет из хранилища содержимое удаленных вложений всех пространств
+	PurgeDeleted(ctx context.Context) (int, error)
+}
+
+// AttachmentLimits ограничения на загружаемые файлы
+type AttachmentLimits struct {
+	// MaxSize максимальный размер файла в байтах
+	MaxSize int64
+	// AllowedTypes разрешенные типы содержимого: точные (application/pdf) или по маске (image/*)
+	AllowedTypes []string
+}
+
+// sniffLen сколько байт содержимого смотрит http.DetectContentType
+const sniffLen = 512
+
+// purgeBatch сколько ключей очистка забирает из очереди за раз
+const purgeBatch = 100
+
+type attachmentService struct {
+	attachments repository.AttachmentRepository
+	tasks       repository.TaskRepository
+	store       storage.BlobStore
+	authorizer  Authorizer
+	limits      AttachmentLimits
+}
+
+func NewAttachmentService(
+	attachments repository.AttachmentRepository,
+	tasks repository.TaskRepository,
+	store storage.BlobStore,
+	authorizer Authorizer,
+	limits AttachmentLimits,
+) AttachmentService {
+	return &attachmentService{
+		attachments: attachments,
+		tasks:       tasks,
+		store:       store,
+		authorizer:  authorizer,
+		limits:      limits,
+	}
+}
+
+func (s *attachmentService) Upload(ctx context.Context, taskID int32, filename string, content io.Reader, checksum string) (*db.TaskAttachment, error) {
+	task, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite)
+	if err != nil {
+		return nil, err
+	}
+
+	filename, err = cleanFilename(filename)
+	if err != nil {
+		return nil, err
+	}
+	checksum = strings.ToLower(strings.TrimSpace(checksum))
+	if checksum != "" && !isSHA256(checksum) {
+		return nil, apperrors.NewValidation("checksum", "must be a hex-encoded SHA-256 digest")
+	}
+
+	// тип определяется по содержимому, а не по заголовку клиента, который легко подделать
+	buffered := bufio.NewReaderSize(content, sniffLen)
+	head, err := buffered.Peek(sniffLen)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	contentType := http.DetectContentType(head)
+	if !s.allowed(contentType) {
+		return nil, apperrors.NewUnsupportedType(contentType)
+	}
+
+	key, err := storageKey(task)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	limited := &limitReader{r: io.TeeReader(buffered, hash), remaining: s.limits.MaxSize}
+	if err := s.store.Put(ctx, key, limited, -1, contentType); err != nil {
+		s.discard(ctx, key)
+		if limited.exceeded {
+			return nil, apperrors.NewTooLarge(s.limits.MaxSize)
+		}
+		return nil, fmt.Errorf("store attachment: %w", err)
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	if checksum != "" && checksum != sum {
+		s.discard(ctx, key)
+		return nil, apperrors.NewValidation("checksum", "does not match the uploaded content (got "+sum+")")
+	}
+
+	attachment, err := s.attachments.Create(ctx, task.ID, userID(ctx), filename, contentType, limited.read, sum, key)
+	if err != nil {
+		s.discard(ctx, key)
+		return nil, err
+	}
+	return attachment, nil
+}
+
+func (s *attachmentService) ListAttachments(ctx context.Context, taskID int32) ([]*db.TaskAttachment, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.attachments.ListByTask(ctx, taskID)
+}
+
+func (s *attachmentService) GetAttachment(ctx context.Context, taskID, id int32) (*db.TaskAttachment, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.attachment(ctx, taskID, id)
+}
+
+func (s *attachmentService) Open(ctx context.Context, taskID, id int32) (*db.TaskAttachment, storage.Blob, error) {
+	attachment, err := s.GetAttachment(ctx, taskID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blob, err := s.store.Open(ctx, attachment.StorageKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		// описание есть, а содержимого нет - хранилище рассинхронизировано с БД
+		return nil, nil, fmt.Errorf("attachment %d content is missing: %w", id, err)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("open attachment: %w", err)
+	}
+	return attachment, blob, nil
+}
+
+func (s *attachmentService) DeleteAttachment(ctx context.Context, taskID, id int32) error {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return err
+	}
+	if _, err := s.attachment(ctx, taskID, id); err != nil {
+		return err
+	}
+	// содержимое удалит фоновая очистка: ключ ставит в очередь триггер БД
+	return s.attachments.Delete(ctx, id)
+}
+
+func (s *attachmentService) PurgeDeleted(ctx context.Context) (int, error) {
+	ctx = tenant.WithAllWorkspaces(ctx)
+
+	purged := 0
+	for {
+		keys, err := s.attachments.PendingDeletions(ctx, purgeBatch)
+		if err != nil {
+			return purged, err
+		}
+		for _, key := range keys {
+			if err := s.store.Delete(ctx, key); err != nil {
+				return purged, fmt.Errorf("delete blob %s: %w", key, err)
+			}
+			if err := s.attachments.DeletionDone(ctx, key); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+		if len(keys) < purgeBatch {
+			return purged, nil
+		}
+	}
+}
+
+// attachment загружает вложение задачи; вложение другой задачи не найдено
+func (s *attachmentService) attachment(ctx context.Context, taskID, id int32) (*db.TaskAttachment, error) {
+	attachment, err := s.attachments.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attachment.TaskID != taskID {
+		return nil, apperrors.NewNotFound("attachment", id)
+	}
+	return attachment, nil
+}
+
+// allowed тип разрешен списком AllowedTypes; пустой список разрешает все
+func (s *attachmentService) allowed(contentType string) bool {
+	if len(s.limits.AllowedTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, pattern := range s.limits.AllowedTypes {
+		if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+			if strings.HasPrefix(mediaType, prefix+"/") {
+				return true
+			}
+			continue
+		}
+		if mediaType == pattern {
+			return true
+		}
+	}
+	return false
+}
+
+// discard удаляет содержимое, для которого не будет описания в БД
+func (s *attachmentService) discard(ctx context.Context, key string) {
+	// запрос мог быть отменен, а содержимое все равно нужно удалить
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Second)
+	defer cancel()
+
+	if err := s.store.Delete(ctx, key); err != nil {
+		slog.WarnContext(ctx, "failed to discard attachment content", slog.String("key", key), slog.String("error", err.Error()))
+	}
+}
+
+// storageKey уникальный ключ содержимого: workspaces/<пространство>/tasks/<задача>/<случайный id>
+func storageKey(task *db.Task) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", fmt.Errorf("generate storage key: %w", err)
+	}
+	return fmt.Sprintf("workspaces/%d/tasks/%d/%s", task.WorkspaceID, task.ID, hex.EncodeToString(random)), nil
+}
+
+// cleanFilename оставляет от имени файла последний элемент пути
+func cleanFilename(filename string) (string, error) {
+	filename = strings.TrimSpace(path.Base(strings.ReplaceAll(filename, `\`, "/")))
+	switch {
+	case filename == "" || filename == "." || filename == "/":
+		return "", apperrors.NewValidation("file", "filename is required")
+	case !utf8.ValidString(filename):
+		return "", apperrors.NewValidation("file", "filename must be valid UTF-8")
+	case utf8.RuneCountInString(filename) > 255:
+		return "", apperrors.NewValidation("file", "filename must be at most 255 characters")
+	}
+	return filename, nil
+}
+
+func isSHA256(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
+
+// errTooLarge обрывает запись содержимого, превысившего лимит
+var errTooLarge = errors.New("content exceeds the size limit")
+
+// limitReader считает прочитанные байты и возвращает ошибку, как только их больше remaining.
+// В отличие от io.LimitReader превышение не выглядит как конец файла.
+type limitReader struct {
+	r         io.Reader
+	remaining int64
+	read      int64
+	exceeded  bool
+}
+
+func (l *limitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.remaining > 0 && l.read > l.remaining {
+		l.exceeded = true
+		return n, errTooLarge
+	}
+	return n, err
+}
+
+// RunAttachmentCleanup раз в interval удаляет содержимое удаленных вложений, пока не отменен ctx
+func RunAttachmentCleanup(ctx context.Context, attachments AttachmentService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purged, err := attachments.PurgeDeleted(ctx)
+			if err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "failed to purge deleted attachments", slog.String("error", err.Error()))
+			}
+			if purged > 0 {
+				slog.DebugContext(ctx, "deleted attachments purged", slog.Int("count", purged))
+			}
+		}
+	}
+}
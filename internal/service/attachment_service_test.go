@@ -0,0 +1,184 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/storage"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// uploadTasks задачи для загрузки: одна личная задача пользователя 1
+type uploadTasks struct {
+	repository.TaskRepository
+}
+
+func (uploadTasks) GetByID(_ context.Context, id int32) (*db.Task, error) {
+	if id != 1 {
+		return nil, apperrors.NewNotFound("task", id)
+	}
+	return &db.Task{ID: 1, WorkspaceID: 1, OwnerID: pgtype.Int4{Int32: 1, Valid: true}}, nil
+}
+
+// uploadAttachments запоминает описания вложений вместо записи в БД
+type uploadAttachments struct {
+	repository.AttachmentRepository
+	created []*db.TaskAttachment
+}
+
+func (r *uploadAttachments) Create(_ context.Context, taskID, uploadedBy int32, filename, contentType string, size int64, checksum, storageKey string) (*db.TaskAttachment, error) {
+	attachment := &db.TaskAttachment{
+		ID:          int32(len(r.created) + 1),
+		TaskID:      taskID,
+		UploadedBy:  pgtype.Int4{Int32: uploadedBy, Valid: uploadedBy != 0},
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        size,
+		Checksum:    checksum,
+		StorageKey:  storageKey,
+	}
+	r.created = append(r.created, attachment)
+	return attachment, nil
+}
+
+func newUploadService(t *testing.T, limits AttachmentLimits) (AttachmentService, *uploadAttachments, string) {
+	t.Helper()
+	dir := t.TempDir()
+	store, err := storage.NewLocalStore(dir)
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	attachments := &uploadAttachments{}
+	return NewAttachmentService(attachments, uploadTasks{}, store, NewAuthorizer(nil), limits), attachments, dir
+}
+
+// ownerContext запрос владельца задачи uploadTasks
+func ownerContext() context.Context {
+	return auth.WithPrincipal(context.Background(), auth.Principal{UserID: 1, WorkspaceID: 1})
+}
+
+// storedFiles файлы в каталоге хранилища
+func storedFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			files = append(files, path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatalf("walk storage: %v", err)
+	}
+	return files
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestUploadStoresContentWithChecksum(t *testing.T) {
+	svc, attachments, dir := newUploadService(t, AttachmentLimits{MaxSize: 1024})
+	content := []byte("hello, attachment")
+
+	// контрольная сумма сравнивается без учета регистра и пробелов
+	attachment, err := svc.Upload(ownerContext(), 1, `C:\docs\notes.txt`, bytes.NewReader(content), " "+strings.ToUpper(sha256Hex(content))+" ")
+	if err != nil {
+		t.Fatalf("Upload: %v", err)
+	}
+	if attachment.Filename != "notes.txt" || attachment.Size != int64(len(content)) || attachment.Checksum != sha256Hex(content) {
+		t.Fatalf("attachment = %+v, want notes.txt of %d bytes with checksum %s", attachment, len(content), sha256Hex(content))
+	}
+	if !strings.HasPrefix(attachment.ContentType, "text/plain") {
+		t.Fatalf("content type = %q, want text/plain", attachment.ContentType)
+	}
+	if len(attachments.created) != 1 || len(storedFiles(t, dir)) != 1 {
+		t.Fatalf("got %d descriptions and %d stored files, want 1 and 1", len(attachments.created), len(storedFiles(t, dir)))
+	}
+}
+
+func TestUploadAcceptsContentOfMaxSize(t *testing.T) {
+	svc, _, _ := newUploadService(t, AttachmentLimits{MaxSize: 600})
+	content := bytes.Repeat([]byte("a"), 600)
+
+	attachment, err := svc.Upload(ownerContext(), 1, "full.txt", bytes.NewReader(content), "")
+	if err != nil {
+		t.Fatalf("Upload of exactly MaxSize bytes: %v", err)
+	}
+	if attachment.Size != 600 {
+		t.Fatalf("size = %d, want 600", attachment.Size)
+	}
+}
+
+func TestUploadRejectsChecksumMismatch(t *testing.T) {
+	svc, attachments, dir := newUploadService(t, AttachmentLimits{MaxSize: 1024})
+	content := []byte("hello, attachment")
+
+	_, err := svc.Upload(ownerContext(), 1, "notes.txt", bytes.NewReader(content), sha256Hex([]byte("other content")))
+	var validation *apperrors.ValidationError
+	if !errors.As(err, &validation) || validation.Fields["checksum"] == "" {
+		t.Fatalf("Upload: err = %v, want checksum validation error", err)
+	}
+	if len(attachments.created) != 0 {
+		t.Fatalf("attachment described despite checksum mismatch: %+v", attachments.created)
+	}
+	if files := storedFiles(t, dir); len(files) != 0 {
+		t.Fatalf("content left in storage: %v", files)
+	}
+}
+
+func TestUploadRejectsMalformedChecksum(t *testing.T) {
+	svc, _, dir := newUploadService(t, AttachmentLimits{MaxSize: 1024})
+
+	_, err := svc.Upload(ownerContext(), 1, "notes.txt", strings.NewReader("data"), "not-a-digest")
+	if !errors.Is(err, apperrors.ErrValidation) {
+		t.Fatalf("Upload: err = %v, want validation error", err)
+	}
+	if files := storedFiles(t, dir); len(files) != 0 {
+		t.Fatalf("content stored despite malformed checksum: %v", files)
+	}
+}
+
+func TestUploadRejectsOversizedContent(t *testing.T) {
+	const limit = 1024
+	svc, attachments, dir := newUploadService(t, AttachmentLimits{MaxSize: limit})
+	// больше буфера определения типа и лимита: превышение обнаруживается при записи потока
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+
+	_, err := svc.Upload(ownerContext(), 1, "big.txt", bytes.NewReader(content), "")
+	var tooLarge *apperrors.TooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != limit {
+		t.Fatalf("Upload: err = %v, want TooLargeError with limit %d", err, limit)
+	}
+	if len(attachments.created) != 0 {
+		t.Fatalf("attachment described despite size limit: %+v", attachments.created)
+	}
+	if files := storedFiles(t, dir); len(files) != 0 {
+		t.Fatalf("content left in storage: %v", files)
+	}
+}
+
+func TestUploadRejectsDisallowedType(t *testing.T) {
+	svc, _, dir := newUploadService(t, AttachmentLimits{MaxSize: 1024, AllowedTypes: []string{"image/*"}})
+
+	_, err := svc.Upload(ownerContext(), 1, "notes.txt", strings.NewReader("plain text"), "")
+	if !errors.Is(err, apperrors.ErrUnsupportedType) {
+		t.Fatalf("Upload: err = %v, want unsupported type", err)
+	}
+	if files := storedFiles(t, dir); len(files) != 0 {
+		t.Fatalf("content stored despite disallowed type: %v", files)
+	}
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// Authorizer проверяет права клиента запроса на проекты и задачи.
+// Тем, кто не видит ресурс, возвращается NotFound, чтобы не раскрывать его существование;
+// тем, кто видит, но не может изменить, - ForbiddenError с недостающим правом.
+type Authorizer interface {
+	AuthorizeProject(ctx context.Context, projectID int32, permission auth.Permission) (auth.Role, error)
+	AuthorizeTask(ctx context.Context, task *db.Task, permission auth.Permission) error
+	LoadTask(ctx context.Context, tasks repository.TaskRepository, id int32, permission auth.Permission) (*db.Task, error)
+}
+
+// ownerlessRole права любого клиента на задачу без владельца и проекта
+const ownerlessRole = auth.RoleCommenter
+
+type authorizer struct {
+	projects repository.ProjectRepository
+}
+
+func NewAuthorizer(projects repository.ProjectRepository) Authorizer {
+	return &authorizer{
+		projects: projects,
+	}
+}
+
+// AuthorizeProject проверяет право в проекте и возвращает роль клиента в нем
+func (a *authorizer) AuthorizeProject(ctx context.Context, projectID int32, permission auth.Permission) (auth.Role, error) {
+	principal, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return "", apperrors.ErrUnauthenticated
+	}
+	return a.authorize(ctx, principal, projectID, permission, apperrors.NewNotFound("project", projectID))
+}
+
+// AuthorizeTask задача проекта - по роли в проекте; задача вне проекта - только владельцу.
+// Задачу без владельца (созданную анонимно) все могут читать и комментировать, но не изменять
+func (a *authorizer) AuthorizeTask(ctx context.Context, task *db.Task, permission auth.Permission) error {
+	principal, ok := auth.PrincipalFrom(ctx)
+	hidden := apperrors.NewNotFound("task", task.ID)
+
+	switch {
+	case task.ProjectID.Valid:
+		if !ok {
+			return hidden
+		}
+		_, err := a.authorize(ctx, principal, task.ProjectID.Int32, permission, hidden)
+		return err
+	case !task.OwnerID.Valid:
+		if !ownerlessRole.Allows(permission) {
+			return apperrors.NewForbidden("task without owner is read-only", string(permission))
+		}
+		return nil
+	case ok && principal.UserID == task.OwnerID.Int32:
+		return nil
+	}
+	return hidden
+}
+
+// LoadTask загружает задачу и проверяет право на нее; чужая задача не найдена
+func (a *authorizer) LoadTask(ctx context.Context, tasks repository.TaskRepository, id int32, permission auth.Permission) (*db.Task, error) {
+	task, err := tasks.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.AuthorizeTask(ctx, task, permission); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (a *authorizer) authorize(ctx context.Context, principal auth.Principal, projectID int32, permission auth.Permission, hidden error) (auth.Role, error) {
+	name, err := a.projects.GetMemberRole(ctx, projectID, principal.UserID)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return "", hidden
+	}
+	if err != nil {
+		return "", err
+	}
+
+	role := auth.Role(name)
+	if !role.Allows(permission) {
+		return role, apperrors.NewForbidden("project role "+name+" does not allow this action", string(permission))
+	}
+	return role, nil
+}
+
+// userID пользователь запроса; 0 - анонимный клиент
+func userID(ctx context.Context) int32 {
+	principal, _ := auth.PrincipalFrom(ctx)
+	return principal.UserID
+}
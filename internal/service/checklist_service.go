@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// ChecklistService пункты чек-листа внутри задачи.
+// Видеть чек-лист может любой, кому видна задача; менять - клиент с правом tasks:write на нее.
+type ChecklistService interface {
+	ListItems(ctx context.Context, taskID int32) ([]*db.TaskChecklistItem, error)
+	CreateItem(ctx context.Context, taskID int32, text string, checked bool) (*db.TaskChecklistItem, error)
+	// UpdateItem меняет переданные поля; nil - поле не меняется
+	UpdateItem(ctx context.Context, taskID, id int32, text *string, checked *bool) (*db.TaskChecklistItem, error)
+	DeleteItem(ctx context.Context, taskID, id int32) error
+	// ReorderItems расставляет пункты в порядке itemIDs; в нем должны быть все пункты задачи
+	ReorderItems(ctx context.Context, taskID int32, itemIDs []int32) ([]*db.TaskChecklistItem, error)
+	// ConvertItem превращает пункт в задачу того же проекта; пункт из чек-листа удаляется
+	ConvertItem(ctx context.Context, taskID, id int32) (*db.Task, error)
+}
+
+type checklistService struct {
+	checklists repository.ChecklistRepository
+	tasks      repository.TaskRepository
+	fields     repository.CustomFieldRepository
+	activity   repository.ActivityRepository
+	tx         repository.Transactor
+	authorizer Authorizer
+	limits     Limits
+}
+
+func NewChecklistService(
+	checklists repository.ChecklistRepository,
+	tasks repository.TaskRepository,
+	fields repository.CustomFieldRepository,
+	activity repository.ActivityRepository,
+	tx repository.Transactor,
+	authorizer Authorizer,
+	limits Limits,
+) ChecklistService {
+	return &checklistService{
+		checklists: checklists,
+		tasks:      tasks,
+		fields:     fields,
+		activity:   activity,
+		tx:         tx,
+		authorizer: authorizer,
+		limits:     limits,
+	}
+}
+
+func (s *checklistService) ListItems(ctx context.Context, taskID int32) ([]*db.TaskChecklistItem, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.checklists.List(ctx, taskID)
+}
+
+func (s *checklistService) CreateItem(ctx context.Context, taskID int32, text string, checked bool) (*db.TaskChecklistItem, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	return s.checklists.Create(ctx, taskID, text, checked)
+}
+
+func (s *checklistService) UpdateItem(ctx context.Context, taskID, id int32, text *string, checked *bool) (*db.TaskChecklistItem, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	item, err := s.item(ctx, taskID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if text != nil {
+		item.Text = *text
+	}
+	if checked != nil {
+		item.Checked = *checked
+	}
+	return s.checklists.Update(ctx, id, item.Text, item.Checked)
+}
+
+func (s *checklistService) DeleteItem(ctx context.Context, taskID, id int32) error {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return err
+	}
+	if _, err := s.item(ctx, taskID, id); err != nil {
+		return err
+	}
+	return s.checklists.Delete(ctx, id)
+}
+
+func (s *checklistService) ReorderItems(ctx context.Context, taskID int32, itemIDs []int32) ([]*db.TaskChecklistItem, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+
+	items, err := s.checklists.List(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	current := make([]int32, len(items))
+	for i, item := range items {
+		current[i] = item.ID
+	}
+	requested := slices.Clone(itemIDs)
+	slices.Sort(current)
+	slices.Sort(requested)
+	if !slices.Equal(current, requested) {
+		return nil, apperrors.NewValidation("item_ids", fmt.Sprintf("must list each of the %d checklist items exactly once", len(items)))
+	}
+
+	if err := s.checklists.Reorder(ctx, taskID, itemIDs); err != nil {
+		return nil, err
+	}
+	return s.checklists.List(ctx, taskID)
+}
+
+// ConvertItem создает задачу от имени клиента (с учетом его квоты) в проекте родительской задачи.
+// Право tasks:write на родительскую задачу проекта - то же право создавать задачи в этом проекте.
+// Задача создается без пользовательских полей, поэтому в проекте с обязательными полями - ошибка.
+func (s *checklistService) ConvertItem(ctx context.Context, taskID, id int32) (*db.Task, error) {
+	parent, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.item(ctx, taskID, id); err != nil {
+		return nil, err
+	}
+	if err := checkNoRequiredFields(ctx, s.fields, parent.ProjectID.Int32, "a checklist item"); err != nil {
+		return nil, err
+	}
+
+	ownerID := userID(ctx)
+	var task *db.Task
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := checkTaskQuota(ctx, s.tasks, s.limits, ownerID, 1); err != nil {
+			return err
+		}
+		var err error
+		task, err = s.checklists.Convert(ctx, id, ownerID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	recordEvent(ctx, s.activity, task.ID, repository.EventTaskCreated)
+	return task, nil
+}
+
+// item загружает пункт чек-листа задачи; пункт другой задачи не найден
+func (s *checklistService) item(ctx context.Context, taskID, id int32) (*db.TaskChecklistItem, error) {
+	item, err := s.checklists.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.TaskID != taskID {
+		return nil, apperrors.NewNotFound("checklist_item", id)
+	}
+	return item, nil
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// CommentService комментарии к задачам и лента активности задачи.
+// Читать может любой, кому видна задача; писать - участник с правом tasks:comment;
+// править и удалять комментарий - только его автор.
+type CommentService interface {
+	ListComments(ctx context.Context, taskID, limit, offset int32) ([]*db.TaskComment, int64, error)
+	GetComment(ctx context.Context, taskID, id int32) (*db.TaskComment, error)
+	CreateComment(ctx context.Context, taskID int32, body string) (*db.TaskComment, error)
+	UpdateComment(ctx context.Context, taskID, id int32, body string) (*db.TaskComment, error)
+	DeleteComment(ctx context.Context, taskID, id int32) error
+	// ListRevisions прежние версии комментария, старые сначала
+	ListRevisions(ctx context.Context, taskID, id int32) ([]*db.TaskCommentRevision, error)
+	// ListActivity комментарии и события задачи, новые сначала
+	ListActivity(ctx context.Context, taskID, limit, offset int32) ([]*db.ListTaskActivityRow, int64, error)
+}
+
+// mentionPattern упоминание пользователя в тексте: @<id>, например @42
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@(\d+)\b`)
+
+type commentService struct {
+	comments   repository.CommentRepository
+	activity   repository.ActivityRepository
+	tasks      repository.TaskRepository
+	projects   repository.ProjectRepository
+	authorizer Authorizer
+}
+
+func NewCommentService(
+	comments repository.CommentRepository,
+	activity repository.ActivityRepository,
+	tasks repository.TaskRepository,
+	projects repository.ProjectRepository,
+	authorizer Authorizer,
+) CommentService {
+	return &commentService{
+		comments:   comments,
+		activity:   activity,
+		tasks:      tasks,
+		projects:   projects,
+		authorizer: authorizer,
+	}
+}
+
+// comment загружает комментарий задачи; комментарий другой задачи не найден
+func (s *commentService) comment(ctx context.Context, taskID, id int32) (*db.TaskComment, error) {
+	comment, err := s.comments.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.TaskID != taskID {
+		return nil, apperrors.NewNotFound("comment", id)
+	}
+	return comment, nil
+}
+
+// authored комментарий, который клиент может изменить как автор
+func (s *commentService) authored(ctx context.Context, taskID, id int32) (*db.Task, *db.TaskComment, error) {
+	authorID, err := currentUser(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	task, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksComment)
+	if err != nil {
+		return nil, nil, err
+	}
+	comment, err := s.comment(ctx, taskID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comment.AuthorID != authorID {
+		return nil, nil, apperrors.NewForbidden("only the author can change a comment", "")
+	}
+	return task, comment, nil
+}
+
+func (s *commentService) ListComments(ctx context.Context, taskID, limit, offset int32) ([]*db.TaskComment, int64, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, 0, err
+	}
+	comments, err := s.comments.ListByTask(ctx, taskID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.comments.CountByTask(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return comments, total, nil
+}
+
+func (s *commentService) GetComment(ctx context.Context, taskID, id int32) (*db.TaskComment, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.comment(ctx, taskID, id)
+}
+
+// CreateComment добавляет комментарий. Входные данные проверяются на уровне API по схеме CommentRequest.
+func (s *commentService) CreateComment(ctx context.Context, taskID int32, body string) (*db.TaskComment, error) {
+	authorID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	task, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksComment)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := s.mentions(ctx, task, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.comments.Create(ctx, taskID, authorID, body, mentions)
+}
+
+func (s *commentService) UpdateComment(ctx context.Context, taskID, id int32, body string) (*db.TaskComment, error) {
+	task, _, err := s.authored(ctx, taskID, id)
+	if err != nil {
+		return nil, err
+	}
+	mentions, err := s.mentions(ctx, task, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.comments.Update(ctx, id, body, mentions)
+}
+
+func (s *commentService) DeleteComment(ctx context.Context, taskID, id int32) error {
+	if _, _, err := s.authored(ctx, taskID, id); err != nil {
+		return err
+	}
+	return s.comments.Delete(ctx, id)
+}
+
+func (s *commentService) ListRevisions(ctx context.Context, taskID, id int32) ([]*db.TaskCommentRevision, error) {
+	if _, err := s.GetComment(ctx, taskID, id); err != nil {
+		return nil, err
+	}
+	return s.comments.ListRevisions(ctx, id)
+}
+
+func (s *commentService) ListActivity(ctx context.Context, taskID, limit, offset int32) ([]*db.ListTaskActivityRow, int64, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, 0, err
+	}
+	items, err := s.activity.List(ctx, taskID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.activity.Count(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// mentions пользователи, упомянутые в тексте, которым видна задача: участники ее проекта,
+// владелец личной задачи или кто угодно для задачи без владельца. Остальные упоминания - просто текст.
+func (s *commentService) mentions(ctx context.Context, task *db.Task, body string) ([]int32, error) {
+	mentioned := []int32{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		id, err := strconv.ParseInt(match[1], 10, 32)
+		if err != nil || id <= 0 || slices.Contains(mentioned, int32(id)) {
+			continue
+		}
+		mentioned = append(mentioned, int32(id))
+	}
+	if len(mentioned) == 0 {
+		return mentioned, nil
+	}
+
+	switch {
+	case task.ProjectID.Valid:
+		members, err := s.projects.ListMembers(ctx, task.ProjectID.Int32)
+		if err != nil {
+			return nil, err
+		}
+		return slices.DeleteFunc(mentioned, func(id int32) bool {
+			return !slices.ContainsFunc(members, func(m *db.ProjectMember) bool { return m.UserID == id })
+		}), nil
+	case task.OwnerID.Valid:
+		return slices.DeleteFunc(mentioned, func(id int32) bool { return id != task.OwnerID.Int32 }), nil
+	}
+	return mentioned, nil
+}
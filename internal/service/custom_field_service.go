@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// Типы пользовательских полей
+const (
+	FieldText        = "text"
+	FieldNumber      = "number"
+	FieldDate        = "date"
+	FieldSelect      = "select"
+	FieldMultiSelect = "multi_select"
+	// FieldUser идентификатор участника проекта
+	FieldUser = "user"
+)
+
+// maxFieldTextLength наибольшая длина значения текстового поля в символах
+const maxFieldTextLength = 1000
+
+// CustomFieldService схема пользовательских полей проекта.
+// Видеть поля может любой участник проекта; менять схему - только владелец (project:manage).
+// Значения полей задают вместе с задачей, их проверяет TaskService.
+type CustomFieldService interface {
+	ListFields(ctx context.Context, projectID int32) ([]*db.ProjectCustomField, error)
+	CreateField(ctx context.Context, projectID int32, key, name, fieldType string, options []string, required bool) (*db.ProjectCustomField, error)
+	// UpdateField меняет переданные свойства; nil - свойство не меняется. Ключ и тип поля неизменны
+	UpdateField(ctx context.Context, projectID, id int32, name *string, options []string, required *bool) (*db.ProjectCustomField, error)
+	// DeleteField удаляет поле и его значения во всех задачах проекта
+	DeleteField(ctx context.Context, projectID, id int32) error
+}
+
+type customFieldService struct {
+	fields     repository.CustomFieldRepository
+	authorizer Authorizer
+}
+
+func NewCustomFieldService(fields repository.CustomFieldRepository, authorizer Authorizer) CustomFieldService {
+	return &customFieldService{
+		fields:     fields,
+		authorizer: authorizer,
+	}
+}
+
+func (s *customFieldService) ListFields(ctx context.Context, projectID int32) ([]*db.ProjectCustomField, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.fields.List(ctx, projectID)
+}
+
+func (s *customFieldService) CreateField(ctx context.Context, projectID int32, key, name, fieldType string, options []string, required bool) (*db.ProjectCustomField, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionProjectManage); err != nil {
+		return nil, err
+	}
+	if err := checkFieldOptions(fieldType, options); err != nil {
+		return nil, err
+	}
+	return s.fields.Create(ctx, projectID, key, name, fieldType, options, required)
+}
+
+// UpdateField не дает убрать вариант, выбранный в задачах: их значения перестали бы
+// соответствовать схеме. Ставшее обязательным поле не проверяется в существующих задачах -
+// значение потребуется при следующем изменении их полей.
+func (s *customFieldService) UpdateField(ctx context.Context, projectID, id int32, name *string, options []string, required *bool) (*db.ProjectCustomField, error) {
+	field, err := s.field(ctx, projectID, id, auth.PermissionProjectManage)
+	if err != nil {
+		return nil, err
+	}
+
+	if options != nil {
+		if err := checkFieldOptions(field.Type, options); err != nil {
+			return nil, err
+		}
+		for _, option := range field.Options {
+			if slices.Contains(options, option) {
+				continue
+			}
+			count, err := s.fields.CountWithValue(ctx, projectID, field.Key, option)
+			if err != nil {
+				return nil, err
+			}
+			if count > 0 {
+				return nil, apperrors.NewConflict(fmt.Sprintf("option %q is still used by %d tasks", option, count), nil)
+			}
+		}
+		field.Options = options
+	}
+	if name != nil {
+		field.Name = *name
+	}
+	if required != nil {
+		field.Required = *required
+	}
+	return s.fields.Update(ctx, id, field.Name, field.Options, field.Required)
+}
+
+func (s *customFieldService) DeleteField(ctx context.Context, projectID, id int32) error {
+	if _, err := s.field(ctx, projectID, id, auth.PermissionProjectManage); err != nil {
+		return err
+	}
+	return s.fields.Delete(ctx, id)
+}
+
+// field проверяет право клиента в проекте и загружает его поле; поле другого проекта не найдено
+func (s *customFieldService) field(ctx context.Context, projectID, id int32, permission auth.Permission) (*db.ProjectCustomField, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, permission); err != nil {
+		return nil, err
+	}
+	field, err := s.fields.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if field.ProjectID != projectID {
+		return nil, apperrors.NewNotFound("custom_field", id)
+	}
+	return field, nil
+}
+
+// checkFieldOptions варианты есть у полей select и multi_select, и только у них
+func checkFieldOptions(fieldType string, options []string) error {
+	if fieldType != FieldSelect && fieldType != FieldMultiSelect {
+		if len(options) > 0 {
+			return apperrors.NewValidation("options", "only select and multi_select fields have options")
+		}
+		return nil
+	}
+	if len(options) == 0 {
+		return apperrors.NewValidation("options", fmt.Sprintf("%s field needs at least one option", fieldType))
+	}
+	if len(slices.Compact(slices.Sorted(slices.Values(options)))) != len(options) {
+		return apperrors.NewValidation("options", "must be unique")
+	}
+	return nil
+}
+
+// fieldValue приводит значение из JSON к типу поля. nil - значение не задано (пустой multi_select)
+func fieldValue(field *db.ProjectCustomField, value any) (any, error) {
+	switch field.Type {
+	case FieldText:
+		text, ok := value.(string)
+		if !ok {
+			return nil, errors.New("must be a string")
+		}
+		if utf8.RuneCountInString(text) > maxFieldTextLength {
+			return nil, fmt.Errorf("must be at most %d characters", maxFieldTextLength)
+		}
+		return text, nil
+	case FieldNumber:
+		number, ok := value.(float64)
+		if !ok {
+			return nil, errors.New("must be a number")
+		}
+		return number, nil
+	case FieldDate:
+		date, ok := value.(string)
+		if !ok {
+			return nil, errors.New("must be a date in YYYY-MM-DD format")
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, errors.New("must be a date in YYYY-MM-DD format")
+		}
+		return date, nil
+	case FieldSelect:
+		option, ok := value.(string)
+		if !ok || !slices.Contains(field.Options, option) {
+			return nil, errors.New("must be one of the field options")
+		}
+		return option, nil
+	case FieldMultiSelect:
+		items, ok := value.([]any)
+		if !ok {
+			return nil, errors.New("must be an array of field options")
+		}
+		chosen := make(map[string]bool, len(items))
+		for _, item := range items {
+			option, ok := item.(string)
+			if !ok || !slices.Contains(field.Options, option) {
+				return nil, errors.New("must be an array of field options")
+			}
+			chosen[option] = true
+		}
+		if len(chosen) == 0 {
+			return nil, nil
+		}
+		// варианты хранятся в порядке схемы, без повторов
+		options := make([]string, 0, len(chosen))
+		for _, option := range field.Options {
+			if chosen[option] {
+				options = append(options, option)
+			}
+		}
+		return options, nil
+	case FieldUser:
+		id, ok := value.(float64)
+		if !ok || id != math.Trunc(id) || id < 1 || id > math.MaxInt32 {
+			return nil, errors.New("must be a user id")
+		}
+		return int32(id), nil
+	}
+	return nil, fmt.Errorf("unsupported field type %q", field.Type)
+}
+
+// checkNoRequiredFields ValidationError, если у проекта projectID есть обязательные поля: задачи,
+// которые создаются без значений полей (по шаблону, из пункта чек-листа), в нем создать нельзя.
+// source - откуда создается задача, для сообщения об ошибке
+func checkNoRequiredFields(ctx context.Context, fields repository.CustomFieldRepository, projectID int32, source string) error {
+	if projectID == 0 {
+		return nil
+	}
+	list, err := fields.List(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, field := range list {
+		if field.Required {
+			return apperrors.NewValidation("custom_fields."+field.Key,
+				fmt.Sprintf("is required in project %d; tasks from %s have no custom field values", projectID, source))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// DependencyService зависимости между задачами: "задача ждет выполнения блокирующей задачи".
+// Менять зависимости задачи может клиент с правом tasks:write на нее и видящий блокирующую задачу.
+type DependencyService interface {
+	// ListDependencies блокирующие задачу и ожидающие ее задачи, видимые клиенту
+	ListDependencies(ctx context.Context, taskID int32) (blockers, dependents []*db.Task, err error)
+	AddDependency(ctx context.Context, taskID, blockerID int32) (*db.TaskDependency, error)
+	RemoveDependency(ctx context.Context, taskID, blockerID int32) error
+	// ProjectPlan задачи проекта в порядке выполнения: каждая задача идет после всех своих блокирующих
+	ProjectPlan(ctx context.Context, projectID int32) ([]PlanStep, error)
+}
+
+// PlanStep задача в плане проекта
+type PlanStep struct {
+	Task *db.Task
+	// BlockedBy блокирующие задачи, в том числе из других проектов
+	BlockedBy []int32
+	// Blocked среди блокирующих есть невыполненные
+	Blocked bool
+	// Stage этап плана: 0 - задача ничего не ждет внутри проекта, иначе на 1 больше
+	// самого позднего этапа ее блокирующих задач. Задачи одного этапа можно делать параллельно
+	Stage int
+}
+
+type dependencyService struct {
+	dependencies repository.DependencyRepository
+	tasks        repository.TaskRepository
+	authorizer   Authorizer
+}
+
+func NewDependencyService(dependencies repository.DependencyRepository, tasks repository.TaskRepository, authorizer Authorizer) DependencyService {
+	return &dependencyService{
+		dependencies: dependencies,
+		tasks:        tasks,
+		authorizer:   authorizer,
+	}
+}
+
+func (s *dependencyService) ListDependencies(ctx context.Context, taskID int32) ([]*db.Task, []*db.Task, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, nil, err
+	}
+
+	blockers, err := s.dependencies.Blockers(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	dependents, err := s.dependencies.Dependents(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if blockers, err = s.visible(ctx, blockers); err != nil {
+		return nil, nil, err
+	}
+	if dependents, err = s.visible(ctx, dependents); err != nil {
+		return nil, nil, err
+	}
+	return blockers, dependents, nil
+}
+
+func (s *dependencyService) AddDependency(ctx context.Context, taskID, blockerID int32) (*db.TaskDependency, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, blockerID, auth.PermissionTasksRead); err != nil {
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return nil, apperrors.NewValidation("blocker_id", fmt.Sprintf("task %d not found", blockerID))
+		}
+		return nil, err
+	}
+	return s.dependencies.Add(ctx, taskID, blockerID)
+}
+
+func (s *dependencyService) RemoveDependency(ctx context.Context, taskID, blockerID int32) error {
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return err
+	}
+	return s.dependencies.Remove(ctx, taskID, blockerID)
+}
+
+// ProjectPlan упорядочивает задачи по этапам (алгоритм Кана), внутри этапа - в порядке создания
+func (s *dependencyService) ProjectPlan(ctx context.Context, projectID int32) ([]PlanStep, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+
+	tasks, dependencies, err := s.dependencies.ProjectGraph(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make(map[int32]*PlanStep, len(tasks))
+	for _, task := range tasks {
+		steps[task.ID] = &PlanStep{Task: task}
+	}
+
+	// waiting сколько блокирующих задач проекта еще не размещено в плане
+	waiting := make(map[int32]int, len(tasks))
+	dependents := make(map[int32][]int32)
+	blockerIDs := make([]int32, 0, len(dependencies))
+	for _, dependency := range dependencies {
+		step := steps[dependency.TaskID]
+		step.BlockedBy = append(step.BlockedBy, dependency.BlockerID)
+		blockerIDs = append(blockerIDs, dependency.BlockerID)
+		if _, inProject := steps[dependency.BlockerID]; inProject {
+			waiting[dependency.TaskID]++
+			dependents[dependency.BlockerID] = append(dependents[dependency.BlockerID], dependency.TaskID)
+		}
+	}
+
+	open, err := s.openTasks(ctx, steps, blockerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := make([]PlanStep, 0, len(tasks))
+	var stage []int32
+	for _, task := range tasks {
+		if waiting[task.ID] == 0 {
+			stage = append(stage, task.ID)
+		}
+	}
+	for number := 0; len(stage) > 0; number++ {
+		var next []int32
+		for _, id := range stage {
+			step := steps[id]
+			step.Stage = number
+			for _, blockerID := range step.BlockedBy {
+				if open[blockerID] {
+					step.Blocked = true
+				}
+			}
+			plan = append(plan, *step)
+
+			for _, dependentID := range dependents[id] {
+				if waiting[dependentID]--; waiting[dependentID] == 0 {
+					next = append(next, dependentID)
+				}
+			}
+		}
+		// внутри этапа - в порядке создания, как в списке задач проекта
+		stage = inOrder(tasks, next)
+	}
+
+	if len(plan) != len(tasks) {
+		// триггер БД не допускает циклов; сюда попасть можно только при нарушенной схеме
+		return nil, fmt.Errorf("project %d dependency graph has a cycle", projectID)
+	}
+	return plan, nil
+}
+
+// openTasks невыполненные задачи среди блокирующих; задачи вне проекта загружаются отдельно
+func (s *dependencyService) openTasks(ctx context.Context, steps map[int32]*PlanStep, ids []int32) (map[int32]bool, error) {
+	open := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		if _, done := open[id]; done {
+			continue
+		}
+		var task *db.Task
+		if step, ok := steps[id]; ok {
+			task = step.Task
+		} else {
+			loaded, err := s.tasks.GetByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			task = loaded
+		}
+		open[id] = !(task.Completed.Valid && task.Completed.Bool)
+	}
+	return open, nil
+}
+
+// inOrder ids в порядке следования задач в tasks
+func inOrder(tasks []*db.Task, ids []int32) []int32 {
+	if len(ids) < 2 {
+		return ids
+	}
+	wanted := make(map[int32]bool, len(ids))
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	ordered := make([]int32, 0, len(ids))
+	for _, task := range tasks {
+		if wanted[task.ID] {
+			ordered = append(ordered, task.ID)
+		}
+	}
+	return ordered
+}
+
+// visible оставляет задачи, которые клиент может видеть
+func (s *dependencyService) visible(ctx context.Context, tasks []*db.Task) ([]*db.Task, error) {
+	result := make([]*db.Task, 0, len(tasks))
+	for _, task := range tasks {
+		err := s.authorizer.AuthorizeTask(ctx, task, auth.PermissionTasksRead)
+		if errors.Is(err, apperrors.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, task)
+	}
+	return result, nil
+}
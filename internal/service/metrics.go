@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/metrics"
+	"GreatProject/internal/repository"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// instrumentedTaskService декоратор TaskService, считающий вызовы и их длительность по методам
+type instrumentedTaskService struct {
+	next       TaskService
+	operations *prometheus.CounterVec
+	duration   *prometheus.HistogramVec
+}
+
+func NewInstrumentedTaskService(next TaskService, registerer prometheus.Registerer) TaskService {
+	s := &instrumentedTaskService{
+		next: next,
+		operations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "task_service",
+			Name:      "operations_total",
+			Help:      "Number of TaskService calls by method and result.",
+		}, []string{"method", "result"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "task_service",
+			Name:      "operation_duration_seconds",
+			Help:      "TaskService call latency by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+	}
+	registerer.MustRegister(s.operations, s.duration)
+	return s
+}
+
+// observe фиксирует результат вызова method, начатого в start
+func (s *instrumentedTaskService) observe(method string, start time.Time, err error) {
+	s.duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+	s.operations.WithLabelValues(method, resultLabel(err)).Inc()
+}
+
+// resultLabel классифицирует ошибку по типам из apperrors
+func resultLabel(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, apperrors.ErrNotFound):
+		return "not_found"
+	case errors.Is(err, apperrors.ErrValidation):
+		return "validation"
+	case errors.Is(err, apperrors.ErrConflict):
+		return "conflict"
+	case errors.Is(err, apperrors.ErrQuota):
+		return "quota_exceeded"
+	case errors.Is(err, apperrors.ErrForbidden):
+		return "forbidden"
+	case errors.Is(err, apperrors.ErrUnauthenticated):
+		return "unauthenticated"
+	case errors.Is(err, apperrors.ErrUnavailable):
+		return "unavailable"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	default:
+		return "error"
+	}
+}
+
+func (s *instrumentedTaskService) GetAllTasks(ctx context.Context, filter repository.TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	defer func(start time.Time) { s.observe("GetAllTasks", start, err) }(time.Now())
+	return s.next.GetAllTasks(ctx, filter, limit, offset)
+}
+
+func (s *instrumentedTaskService) GetTaskByID(ctx context.Context, id int32) (task *db.Task, err error) {
+	defer func(start time.Time) { s.observe("GetTaskByID", start, err) }(time.Now())
+	return s.next.GetTaskByID(ctx, id)
+}
+
+func (s *instrumentedTaskService) CreateTask(ctx context.Context, projectID int32, input TaskInput) (task *db.Task, err error) {
+	defer func(start time.Time) { s.observe("CreateTask", start, err) }(time.Now())
+	return s.next.CreateTask(ctx, projectID, input)
+}
+
+func (s *instrumentedTaskService) UpdateTask(ctx context.Context, id int32, input TaskInput) (task *db.Task, err error) {
+	defer func(start time.Time) { s.observe("UpdateTask", start, err) }(time.Now())
+	return s.next.UpdateTask(ctx, id, input)
+}
+
+func (s *instrumentedTaskService) DeleteTask(ctx context.Context, id int32) (err error) {
+	defer func(start time.Time) { s.observe("DeleteTask", start, err) }(time.Now())
+	return s.next.DeleteTask(ctx, id)
+}
+
+func (s *instrumentedTaskService) CompleteTask(ctx context.Context, id int32, force bool) (task *db.Task, err error) {
+	defer func(start time.Time) { s.observe("CompleteTask", start, err) }(time.Now())
+	return s.next.CompleteTask(ctx, id, force)
+}
+
+func (s *instrumentedTaskService) UncompleteTask(ctx context.Context, id int32) (task *db.Task, err error) {
+	defer func(start time.Time) { s.observe("UncompleteTask", start, err) }(time.Now())
+	return s.next.UncompleteTask(ctx, id)
+}
+
+func (s *instrumentedTaskService) GetCompletedTasks(ctx context.Context, limit, offset int32) (tasks []*db.Task, err error) {
+	defer func(start time.Time) { s.observe("GetCompletedTasks", start, err) }(time.Now())
+	return s.next.GetCompletedTasks(ctx, limit, offset)
+}
+
+func (s *instrumentedTaskService) GetPendingTasks(ctx context.Context, limit, offset int32) (tasks []*db.Task, err error) {
+	defer func(start time.Time) { s.observe("GetPendingTasks", start, err) }(time.Now())
+	return s.next.GetPendingTasks(ctx, limit, offset)
+}
+
+func (s *instrumentedTaskService) GetTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	defer func(start time.Time) { s.observe("GetTasksByStatus", start, err) }(time.Now())
+	return s.next.GetTasksByStatus(ctx, completed, filter, limit, offset)
+}
+
+func (s *instrumentedTaskService) MoveTask(ctx context.Context, id, beforeID, afterID int32) (task *db.Task, err error) {
+	defer func(start time.Time) { s.observe("MoveTask", start, err) }(time.Now())
+	return s.next.MoveTask(ctx, id, beforeID, afterID)
+}
+
+func (s *instrumentedTaskService) CountTasks(ctx context.Context, filter repository.TaskFilter) (count int64, err error) {
+	defer func(start time.Time) { s.observe("CountTasks", start, err) }(time.Now())
+	return s.next.CountTasks(ctx, filter)
+}
+
+func (s *instrumentedTaskService) CountTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter) (count int64, err error) {
+	defer func(start time.Time) { s.observe("CountTasksByStatus", start, err) }(time.Now())
+	return s.next.CountTasksByStatus(ctx, completed, filter)
+}
+
+func (s *instrumentedTaskService) GetAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	defer func(start time.Time) { s.observe("GetAssignedTasks", start, err) }(time.Now())
+	return s.next.GetAssignedTasks(ctx, completed, filter, limit, offset)
+}
+
+func (s *instrumentedTaskService) CountAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter) (count int64, err error) {
+	defer func(start time.Time) { s.observe("CountAssignedTasks", start, err) }(time.Now())
+	return s.next.CountAssignedTasks(ctx, completed, filter)
+}
+
+func (s *instrumentedTaskService) Details(ctx context.Context, tasks []*db.Task) (details map[int32]TaskDetails, err error) {
+	defer func(start time.Time) { s.observe("Details", start, err) }(time.Now())
+	return s.next.Details(ctx, tasks)
+}
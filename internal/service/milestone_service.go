@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// MilestoneService вехи (спринты) проектов: задачи проекта, которые нужно выполнить к сроку.
+// Вехи видят участники проекта, а создают и меняют те, кто может изменять задачи проекта.
+type MilestoneService interface {
+	ListMilestones(ctx context.Context, projectID int32) ([]*db.Milestone, error)
+	GetMilestone(ctx context.Context, id int32) (*db.Milestone, error)
+	CreateMilestone(ctx context.Context, projectID int32, input MilestoneInput) (*db.Milestone, error)
+	// UpdateMilestone меняет поля input, отличные от nil
+	UpdateMilestone(ctx context.Context, id int32, input MilestoneUpdate) (*db.Milestone, error)
+	// DeleteMilestone удаляет веху; ее задачи остаются в проекте без вехи
+	DeleteMilestone(ctx context.Context, id int32) error
+	// Progress число задач вех и выполненных из них
+	Progress(ctx context.Context, milestones []*db.Milestone) (map[int32]MilestoneProgress, error)
+	// MilestoneTasks задачи вехи в ручном порядке и их общее число
+	MilestoneTasks(ctx context.Context, id int32, limit, offset int32) ([]*db.Task, int64, error)
+	// Burndown остаток задач вехи по дням от начала вехи до ее конца или до сегодня, если веха идет
+	Burndown(ctx context.Context, id int32) (*db.Milestone, []BurndownDay, error)
+}
+
+// MilestoneInput поля новой вехи; Start и End - первый и последний день (UTC)
+type MilestoneInput struct {
+	Name        string
+	Description string
+	Start       time.Time
+	End         time.Time
+}
+
+// MilestoneUpdate изменения вехи; nil - поле не меняется
+type MilestoneUpdate struct {
+	Name        *string
+	Description *string
+	Start       *time.Time
+	End         *time.Time
+}
+
+// MilestoneProgress задачи вехи: всего и выполнено, в штуках и в минутах оценки
+type MilestoneProgress struct {
+	Total                    int32
+	Completed                int32
+	EstimateMinutes          int32
+	CompletedEstimateMinutes int32
+}
+
+// BurndownDay точка диаграммы сгорания на конец дня Date
+type BurndownDay struct {
+	Date time.Time
+	// Remaining созданные и еще не выполненные задачи вехи, RemainingMinutes - их оценка
+	Remaining        int32
+	RemainingMinutes int32
+	// Completed задачи, выполненные за день
+	Completed int32
+	// Ideal остаток при равномерной работе: от остатка первого дня до нуля в последний день вехи
+	Ideal float64
+}
+
+type milestoneService struct {
+	milestones repository.MilestoneRepository
+	authorizer Authorizer
+}
+
+func NewMilestoneService(milestones repository.MilestoneRepository, authorizer Authorizer) MilestoneService {
+	return &milestoneService{
+		milestones: milestones,
+		authorizer: authorizer,
+	}
+}
+
+func (s *milestoneService) ListMilestones(ctx context.Context, projectID int32) ([]*db.Milestone, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.milestones.List(ctx, projectID)
+}
+
+func (s *milestoneService) GetMilestone(ctx context.Context, id int32) (*db.Milestone, error) {
+	return s.milestone(ctx, id, auth.PermissionTasksRead)
+}
+
+func (s *milestoneService) CreateMilestone(ctx context.Context, projectID int32, input MilestoneInput) (*db.Milestone, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	data := repository.MilestoneData{
+		Name:        input.Name,
+		Description: input.Description,
+		Start:       input.Start,
+		End:         input.End,
+	}
+	if err := checkMilestoneDates(data); err != nil {
+		return nil, err
+	}
+	return s.milestones.Create(ctx, projectID, data)
+}
+
+func (s *milestoneService) UpdateMilestone(ctx context.Context, id int32, input MilestoneUpdate) (*db.Milestone, error) {
+	milestone, err := s.milestone(ctx, id, auth.PermissionTasksWrite)
+	if err != nil {
+		return nil, err
+	}
+	data := repository.MilestoneData{
+		Name:        milestone.Name,
+		Description: milestone.Description,
+		Start:       milestone.StartDate.Time,
+		End:         milestone.EndDate.Time,
+	}
+	if input.Name != nil {
+		data.Name = *input.Name
+	}
+	if input.Description != nil {
+		data.Description = *input.Description
+	}
+	if input.Start != nil {
+		data.Start = *input.Start
+	}
+	if input.End != nil {
+		data.End = *input.End
+	}
+	if err := checkMilestoneDates(data); err != nil {
+		return nil, err
+	}
+	return s.milestones.Update(ctx, id, data)
+}
+
+func (s *milestoneService) DeleteMilestone(ctx context.Context, id int32) error {
+	if _, err := s.milestone(ctx, id, auth.PermissionTasksWrite); err != nil {
+		return err
+	}
+	return s.milestones.Delete(ctx, id)
+}
+
+func (s *milestoneService) Progress(ctx context.Context, milestones []*db.Milestone) (map[int32]MilestoneProgress, error) {
+	ids := make([]int32, len(milestones))
+	for i, milestone := range milestones {
+		ids[i] = milestone.ID
+	}
+	rows, err := s.milestones.Progress(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := make(map[int32]MilestoneProgress, len(rows))
+	for _, row := range rows {
+		progress[row.MilestoneID] = MilestoneProgress{
+			Total:                    row.Total,
+			Completed:                row.Completed,
+			EstimateMinutes:          row.EstimateMinutes,
+			CompletedEstimateMinutes: row.CompletedEstimateMinutes,
+		}
+	}
+	return progress, nil
+}
+
+func (s *milestoneService) MilestoneTasks(ctx context.Context, id int32, limit, offset int32) ([]*db.Task, int64, error) {
+	milestone, err := s.milestone(ctx, id, auth.PermissionTasksRead)
+	if err != nil {
+		return nil, 0, err
+	}
+	tasks, err := s.milestones.Tasks(ctx, id, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	progress, err := s.Progress(ctx, []*db.Milestone{milestone})
+	if err != nil {
+		return nil, 0, err
+	}
+	return tasks, int64(progress[id].Total), nil
+}
+
+// Burndown дни считаются по UTC; у вехи, которая еще не началась, точек нет
+func (s *milestoneService) Burndown(ctx context.Context, id int32) (*db.Milestone, []BurndownDay, error) {
+	milestone, err := s.milestone(ctx, id, auth.PermissionTasksRead)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	start, end := milestone.StartDate.Time, milestone.EndDate.Time
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if end.Before(to) {
+		to = end
+	}
+	if to.Before(start) {
+		return milestone, []BurndownDay{}, nil
+	}
+	rows, err := s.milestones.Burndown(ctx, id, start, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// идеальная линия идет до последнего дня вехи, даже если он еще не наступил
+	span := end.Sub(start).Hours() / 24
+	days := make([]BurndownDay, len(rows))
+	for i, row := range rows {
+		days[i] = BurndownDay{
+			Date:             row.Day.Time,
+			Remaining:        row.Remaining,
+			RemainingMinutes: row.RemainingMinutes,
+			Completed:        row.Completed,
+		}
+		if span > 0 {
+			elapsed := row.Day.Time.Sub(start).Hours() / 24
+			days[i].Ideal = float64(rows[0].Remaining) * (1 - elapsed/span)
+		}
+	}
+	return milestone, days, nil
+}
+
+// milestone загружает веху и проверяет право клиента в ее проекте; вехи чужих проектов не найдены
+func (s *milestoneService) milestone(ctx context.Context, id int32, permission auth.Permission) (*db.Milestone, error) {
+	milestone, err := s.milestones.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	_, err = s.authorizer.AuthorizeProject(ctx, milestone.ProjectID, permission)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, apperrors.NewNotFound("milestone", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return milestone, nil
+}
+
+// checkMilestoneDates веха заканчивается не раньше, чем начинается
+func checkMilestoneDates(data repository.MilestoneData) error {
+	if data.End.Before(data.Start) {
+		return apperrors.NewValidation("end_date", "must not be before start_date")
+	}
+	return nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+type ProjectService interface {
+	CreateProject(ctx context.Context, name string) (*db.Project, error)
+	// ListProjects проекты, в которых состоит клиент, с его ролью
+	ListProjects(ctx context.Context) ([]*db.ListProjectsByMemberRow, error)
+	GetProject(ctx context.Context, id int32) (*db.Project, auth.Role, error)
+	DeleteProject(ctx context.Context, id int32) error
+
+	ListMembers(ctx context.Context, projectID int32) ([]*db.ProjectMember, error)
+	UpdateMemberRole(ctx context.Context, projectID, userID int32, role auth.Role) (*db.ProjectMember, error)
+	// RemoveMember исключает участника; участник может выйти из проекта сам
+	RemoveMember(ctx context.Context, projectID, userID int32) error
+
+	InviteMember(ctx context.Context, projectID, userID int32, role auth.Role) (*db.ProjectInvitation, error)
+	ListProjectInvitations(ctx context.Context, projectID int32) ([]*db.ProjectInvitation, error)
+	// ListInvitations приглашения, адресованные клиенту
+	ListInvitations(ctx context.Context) ([]*db.ProjectInvitation, error)
+	AcceptInvitation(ctx context.Context, id int32) (*db.ProjectMember, error)
+	// DeleteInvitation отклоняет приглашение (приглашенный) или отзывает его (владелец проекта)
+	DeleteInvitation(ctx context.Context, id int32) error
+}
+
+type projectService struct {
+	repo       repository.ProjectRepository
+	authorizer Authorizer
+}
+
+func NewProjectService(repo repository.ProjectRepository, authorizer Authorizer) ProjectService {
+	return &projectService{
+		repo:       repo,
+		authorizer: authorizer,
+	}
+}
+
+// currentUser пользователь запроса; проекты доступны только аутентифицированным клиентам
+func currentUser(ctx context.Context) (int32, error) {
+	principal, ok := auth.PrincipalFrom(ctx)
+	if !ok {
+		return 0, apperrors.ErrUnauthenticated
+	}
+	return principal.UserID, nil
+}
+
+func (s *projectService) CreateProject(ctx context.Context, name string) (*db.Project, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.Create(ctx, userID, name)
+}
+
+func (s *projectService) ListProjects(ctx context.Context) ([]*db.ListProjectsByMemberRow, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListByMember(ctx, userID)
+}
+
+func (s *projectService) GetProject(ctx context.Context, id int32) (*db.Project, auth.Role, error) {
+	role, err := s.authorizer.AuthorizeProject(ctx, id, auth.PermissionTasksRead)
+	if err != nil {
+		return nil, "", err
+	}
+	project, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, "", err
+	}
+	return project, role, nil
+}
+
+// DeleteProject удаляет проект вместе с его задачами
+func (s *projectService) DeleteProject(ctx context.Context, id int32) error {
+	if _, err := s.authorizer.AuthorizeProject(ctx, id, auth.PermissionProjectManage); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s *projectService) ListMembers(ctx context.Context, projectID int32) ([]*db.ProjectMember, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListMembers(ctx, projectID)
+}
+
+func (s *projectService) UpdateMemberRole(ctx context.Context, projectID, userID int32, role auth.Role) (*db.ProjectMember, error) {
+	if !role.Valid() {
+		return nil, apperrors.NewValidation("role", "unknown role "+string(role))
+	}
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionProjectManage); err != nil {
+		return nil, err
+	}
+	if role != auth.RoleOwner {
+		if err := s.keepOwner(ctx, projectID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.UpdateMemberRole(ctx, projectID, userID, string(role))
+}
+
+func (s *projectService) RemoveMember(ctx context.Context, projectID, userID int32) error {
+	currentUserID, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	permission := auth.PermissionProjectManage
+	if userID == currentUserID {
+		permission = auth.PermissionTasksRead
+	}
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, permission); err != nil {
+		return err
+	}
+	if err := s.keepOwner(ctx, projectID, userID); err != nil {
+		return err
+	}
+	return s.repo.RemoveMember(ctx, projectID, userID)
+}
+
+// keepOwner не дает лишить проект последнего владельца
+func (s *projectService) keepOwner(ctx context.Context, projectID, userID int32) error {
+	role, err := s.repo.GetMemberRole(ctx, projectID, userID)
+	if err != nil || auth.Role(role) != auth.RoleOwner {
+		return err
+	}
+	owners, err := s.repo.CountOwners(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return &apperrors.ConflictError{Message: "project must keep at least one owner"}
+	}
+	return nil
+}
+
+func (s *projectService) InviteMember(ctx context.Context, projectID, inviteeID int32, role auth.Role) (*db.ProjectInvitation, error) {
+	if !role.Valid() {
+		return nil, apperrors.NewValidation("role", "unknown role "+string(role))
+	}
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionProjectManage); err != nil {
+		return nil, err
+	}
+
+	_, err := s.repo.GetMemberRole(ctx, projectID, inviteeID)
+	if err == nil {
+		return nil, &apperrors.ConflictError{Message: "user is already a project member"}
+	}
+	if !errors.Is(err, apperrors.ErrNotFound) {
+		return nil, err
+	}
+
+	return s.repo.CreateInvitation(ctx, projectID, inviteeID, string(role), userID(ctx))
+}
+
+func (s *projectService) ListProjectInvitations(ctx context.Context, projectID int32) ([]*db.ProjectInvitation, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionProjectManage); err != nil {
+		return nil, err
+	}
+	return s.repo.ListInvitations(ctx, projectID)
+}
+
+func (s *projectService) ListInvitations(ctx context.Context) ([]*db.ProjectInvitation, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListInvitationsByUser(ctx, userID)
+}
+
+func (s *projectService) AcceptInvitation(ctx context.Context, id int32) (*db.ProjectMember, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.AcceptInvitation(ctx, id, userID)
+}
+
+func (s *projectService) DeleteInvitation(ctx context.Context, id int32) error {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := s.repo.GetInvitation(ctx, id)
+	if err != nil {
+		return err
+	}
+	if invitation.UserID != userID {
+		_, err := s.authorizer.AuthorizeProject(ctx, invitation.ProjectID, auth.PermissionProjectManage)
+		if errors.Is(err, apperrors.ErrNotFound) {
+			return apperrors.NewNotFound("invitation", id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return s.repo.DeleteInvitation(ctx, id)
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/mail"
+	"strings"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/notify"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/tenant"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ReminderService личные напоминания о задачах и их отправка.
+// Напоминание может поставить себе любой пользователь, которому видна задача; видит и удаляет
+// его только автор.
+type ReminderService interface {
+	ListReminders(ctx context.Context, taskID int32) ([]*db.ListRemindersRow, error)
+	// CreateReminder ставит напоминание к моменту remindAt или за offsetMinutes до срока задачи:
+	// задается ровно одно из них. Пустой email - напоминание не отправляется по почте
+	CreateReminder(ctx context.Context, taskID int32, remindAt *time.Time, offsetMinutes *int32, email string) (*db.ListRemindersRow, error)
+	DeleteReminder(ctx context.Context, taskID, id int32) error
+	// SendDue отправляет напоминания всех пространств, время которых пришло; возвращает число отправленных
+	SendDue(ctx context.Context) (int, error)
+}
+
+const (
+	// maxRemindersPerTask сколько напоминаний о задаче может поставить себе один пользователь
+	maxRemindersPerTask = 20
+	// reminderBatch сколько напоминаний отправка занимает за раз
+	reminderBatch = 50
+	// reminderLease на сколько напоминание занимается для отправки. Если экземпляр сервера
+	// упадет, не отметив результат, напоминание отправит другой экземпляр после аренды
+	reminderLease = 5 * time.Minute
+	// reminderMaxAttempts после стольких неудачных попыток подряд напоминание больше не отправляется
+	reminderMaxAttempts = 5
+	// maxReminderError сколько байт ошибки доставки сохраняется в напоминании
+	maxReminderError = 1000
+)
+
+type reminderService struct {
+	reminders  repository.ReminderRepository
+	tasks      repository.TaskRepository
+	notifier   notify.Notifier
+	authorizer Authorizer
+}
+
+func NewReminderService(reminders repository.ReminderRepository, tasks repository.TaskRepository, notifier notify.Notifier, authorizer Authorizer) ReminderService {
+	return &reminderService{
+		reminders:  reminders,
+		tasks:      tasks,
+		notifier:   notifier,
+		authorizer: authorizer,
+	}
+}
+
+func (s *reminderService) ListReminders(ctx context.Context, taskID int32) ([]*db.ListRemindersRow, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	return s.reminders.List(ctx, taskID, userID)
+}
+
+// CreateReminder относительное напоминание можно поставить и задаче без срока:
+// оно сработает, когда срок появится
+func (s *reminderService) CreateReminder(ctx context.Context, taskID int32, remindAt *time.Time, offsetMinutes *int32, email string) (*db.ListRemindersRow, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if (remindAt == nil) == (offsetMinutes == nil) {
+		return nil, apperrors.NewValidation("remind_at", "exactly one of remind_at and offset_minutes is required")
+	}
+	if remindAt != nil && !remindAt.After(time.Now()) {
+		return nil, apperrors.NewValidation("remind_at", "must be in the future")
+	}
+	if email != "" {
+		if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+			return nil, apperrors.NewValidation("email", "must be an email address")
+		}
+	}
+
+	task, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.reminders.Count(ctx, taskID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= maxRemindersPerTask {
+		return nil, apperrors.NewQuotaExceeded("reminders", maxRemindersPerTask)
+	}
+
+	var at time.Time
+	var offset int32
+	if remindAt != nil {
+		at = *remindAt
+	} else {
+		offset = *offsetMinutes
+	}
+	reminder, err := s.reminders.Create(ctx, taskID, userID, at, offset, email)
+	if err != nil {
+		return nil, err
+	}
+	return &db.ListRemindersRow{
+		ID:            reminder.ID,
+		TaskID:        reminder.TaskID,
+		UserID:        reminder.UserID,
+		RemindAt:      reminder.RemindAt,
+		OffsetMinutes: reminder.OffsetMinutes,
+		Email:         reminder.Email,
+		SentAt:        reminder.SentAt,
+		CreatedAt:     reminder.CreatedAt,
+		FireAt:        fireAt(reminder.RemindAt, reminder.OffsetMinutes, task.DueAt),
+	}, nil
+}
+
+func (s *reminderService) DeleteReminder(ctx context.Context, taskID, id int32) error {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead); err != nil {
+		return err
+	}
+	reminder, err := s.reminders.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	// чужие напоминания не видны, а не запрещены
+	if reminder.TaskID != taskID || reminder.UserID != userID {
+		return apperrors.NewNotFound("reminder", id)
+	}
+	return s.reminders.Delete(ctx, id)
+}
+
+// SendDue ошибка канала доставки не прерывает отправку: напоминание откладывается
+// и отправляется повторно с растущей паузой - только в каналы, которые его еще не доставили
+func (s *reminderService) SendDue(ctx context.Context) (int, error) {
+	ctx = tenant.WithAllWorkspaces(ctx)
+
+	sent := 0
+	for {
+		due, err := s.reminders.ClaimDue(ctx, reminderBatch, reminderLease, reminderMaxAttempts)
+		if err != nil {
+			return sent, err
+		}
+		for _, reminder := range due {
+			err := s.notifier.Notify(ctx, notification(reminder))
+			if err != nil {
+				slog.WarnContext(ctx, "failed to send reminder",
+					slog.Int("reminder_id", int(reminder.ID)),
+					slog.Int("attempt", int(reminder.Attempts)+1),
+					slog.String("error", err.Error()),
+				)
+				reason := err.Error()
+				if len(reason) > maxReminderError {
+					reason = strings.ToValidUTF8(reason[:maxReminderError], "")
+				}
+				delivered := reminder.DeliveredChannels
+				var partial *notify.DeliveryError
+				if errors.As(err, &partial) {
+					delivered = partial.Delivered
+				}
+				if err := s.reminders.Failed(ctx, reminder.ID, reason, reminderRetryDelay(reminder.Attempts), delivered); err != nil {
+					return sent, err
+				}
+				continue
+			}
+			if err := s.reminders.Sent(ctx, reminder.ID, reminder.DueAt.Time); err != nil {
+				return sent, err
+			}
+			sent++
+		}
+		if len(due) < reminderBatch {
+			return sent, nil
+		}
+	}
+}
+
+// RunReminderScheduler отправляет напоминания раз в interval до отмены ctx. Несколько экземпляров
+// сервера могут работать одновременно: каждое напоминание занимает один из них (см. ClaimDue)
+func RunReminderScheduler(ctx context.Context, reminders ReminderService, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent, err := reminders.SendDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				slog.WarnContext(ctx, "failed to send due reminders", slog.String("error", err.Error()))
+			}
+			if sent > 0 {
+				slog.DebugContext(ctx, "reminders sent", slog.Int("count", sent))
+			}
+		}
+	}
+}
+
+// reminderRetryDelay пауза перед повтором: минута, удваивается с каждой неудачей, не больше часа
+func reminderRetryDelay(attempts int32) time.Duration {
+	return min(time.Minute<<min(attempts, 6), time.Hour)
+}
+
+// fireAt момент срабатывания напоминания; у относительного напоминания задачи без срока его нет
+func fireAt(remindAt pgtype.Timestamptz, offsetMinutes pgtype.Int4, dueAt pgtype.Timestamptz) pgtype.Timestamptz {
+	if remindAt.Valid {
+		return remindAt
+	}
+	if !dueAt.Valid {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: dueAt.Time.Add(-time.Duration(offsetMinutes.Int32) * time.Minute), Valid: true}
+}
+
+func notification(reminder *db.ClaimDueRemindersRow) notify.Notification {
+	return notify.Notification{
+		ReminderID:  reminder.ID,
+		TaskID:      reminder.TaskID,
+		TaskName:    reminder.TaskName,
+		UserID:      reminder.UserID,
+		WorkspaceID: reminder.WorkspaceID,
+		Email:       reminder.Email,
+		DueAt:       reminder.DueAt.Time,
+		FireAt:      fireAt(reminder.RemindAt, reminder.OffsetMinutes, reminder.DueAt).Time,
+		Delivered:   reminder.DeliveredChannels,
+	}
+}
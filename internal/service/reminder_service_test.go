@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/notify"
+	"GreatProject/internal/repository"
+)
+
+// sendingReminders одно напоминание, которое отдается при каждом ClaimDue, пока не отправлено;
+// доставившие каналы сохраняются, как в task_reminders.delivered_channels
+type sendingReminders struct {
+	repository.ReminderRepository
+	reminder db.ClaimDueRemindersRow
+	sent     bool
+}
+
+func (r *sendingReminders) ClaimDue(context.Context, int32, time.Duration, int32) ([]*db.ClaimDueRemindersRow, error) {
+	if r.sent {
+		return nil, nil
+	}
+	reminder := r.reminder
+	return []*db.ClaimDueRemindersRow{&reminder}, nil
+}
+
+func (r *sendingReminders) Sent(context.Context, int32, time.Time) error {
+	r.sent = true
+	r.reminder.DeliveredChannels = nil
+	return nil
+}
+
+func (r *sendingReminders) Failed(_ context.Context, _ int32, _ string, _ time.Duration, delivered []string) error {
+	r.reminder.Attempts++
+	r.reminder.DeliveredChannels = delivered
+	return nil
+}
+
+// flakyNotifier считает отправки; первые failures из них завершаются ошибкой
+type flakyNotifier struct {
+	calls    int
+	failures int
+}
+
+func (n *flakyNotifier) Notify(context.Context, notify.Notification) error {
+	n.calls++
+	if n.calls <= n.failures {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+// После ошибки одного канала повтор отправляет напоминание только в него
+func TestSendDueRetriesOnlyFailedChannels(t *testing.T) {
+	reminders := &sendingReminders{reminder: db.ClaimDueRemindersRow{ID: 1, TaskID: 1, UserID: 1, WorkspaceID: 1}}
+	log, webhook := &flakyNotifier{}, &flakyNotifier{failures: 1}
+	notifier := notify.Multi(notify.Channel{Name: "log", Notifier: log}, notify.Channel{Name: "webhook", Notifier: webhook})
+	svc := NewReminderService(reminders, nil, notifier, NewAuthorizer(nil))
+
+	if sent, err := svc.SendDue(context.Background()); err != nil || sent != 0 {
+		t.Fatalf("first SendDue = %d, %v; want 0 sent", sent, err)
+	}
+	if !slices.Equal(reminders.reminder.DeliveredChannels, []string{"log"}) {
+		t.Fatalf("delivered channels = %v, want [log]", reminders.reminder.DeliveredChannels)
+	}
+
+	if sent, err := svc.SendDue(context.Background()); err != nil || sent != 1 {
+		t.Fatalf("retry SendDue = %d, %v; want 1 sent", sent, err)
+	}
+	if log.calls != 1 || webhook.calls != 2 {
+		t.Fatalf("log sent %d times, webhook %d; want 1 and 2", log.calls, webhook.calls)
+	}
+}
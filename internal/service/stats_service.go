@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// StatsService статистика по задачам, которые видит клиент: его личные задачи, общие задачи
+// и задачи его проектов
+type StatsService interface {
+	Stats(ctx context.Context, query StatsQuery) (*Stats, error)
+}
+
+// StatsQuery параметры статистики; нулевые значения - по умолчанию
+type StatsQuery struct {
+	// ProjectID только задачи проекта; 0 - все видимые задачи
+	ProjectID int32
+	// From и To первый и последний день ряда (UTC); по умолчанию ряд заканчивается сегодня
+	// и содержит 30 дней или 12 недель
+	From   time.Time
+	To     time.Time
+	Period repository.StatsPeriod
+}
+
+// Stats статистика по задачам. Итоги по статусам, проектам и меткам - на текущий момент,
+// ряд и среднее время выполнения - за дни [From, To]
+type Stats struct {
+	From     time.Time
+	To       time.Time
+	Period   repository.StatsPeriod
+	Totals   *db.TaskStatsRow
+	Projects []*db.TaskStatsByProjectRow
+	Tags     []*db.TaskStatsByTagRow
+	Series   []*db.TaskStatsSeriesRow
+	Streaks  *db.CompletionStreaksRow
+}
+
+const (
+	// maxStatsDays наибольший период ряда в днях, как у табеля
+	maxStatsDays = 366
+	// maxStatsTags сколько самых частых меток попадает в статистику
+	maxStatsTags = 50
+)
+
+type statsService struct {
+	stats      repository.StatsRepository
+	tx         repository.Transactor
+	authorizer Authorizer
+}
+
+func NewStatsService(stats repository.StatsRepository, tx repository.Transactor, authorizer Authorizer) StatsService {
+	return &statsService{
+		stats:      stats,
+		tx:         tx,
+		authorizer: authorizer,
+	}
+}
+
+func (s *statsService) Stats(ctx context.Context, query StatsQuery) (*Stats, error) {
+	if query.ProjectID != 0 {
+		if _, err := s.authorizer.AuthorizeProject(ctx, query.ProjectID, auth.PermissionTasksRead); err != nil {
+			return nil, err
+		}
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	result := &Stats{From: query.From, To: query.To, Period: query.Period}
+	if result.Period == "" {
+		result.Period = repository.PeriodDay
+	}
+	if result.To.IsZero() {
+		result.To = today
+	}
+	if result.From.IsZero() {
+		if result.Period == repository.PeriodWeek {
+			result.From = result.To.AddDate(0, 0, -7*11)
+		} else {
+			result.From = result.To.AddDate(0, 0, -29)
+		}
+	}
+	if result.To.Before(result.From) {
+		return nil, apperrors.NewValidation("to", "must not be before from")
+	}
+	if result.To.Sub(result.From) >= maxStatsDays*24*time.Hour {
+		return nil, apperrors.NewValidation("to", fmt.Sprintf("period must not exceed %d days", maxStatsDays))
+	}
+
+	// все части статистики считаются по одному снимку данных, чтобы итоги сходились с рядом и сериями
+	userID, projectID := userID(ctx), query.ProjectID
+	err := s.tx.InSnapshot(ctx, func(ctx context.Context) error {
+		var err error
+		if result.Totals, err = s.stats.Totals(ctx, userID, projectID, result.From, result.To.AddDate(0, 0, 1)); err != nil {
+			return err
+		}
+		if result.Projects, err = s.stats.ByProject(ctx, userID, projectID); err != nil {
+			return err
+		}
+		if result.Tags, err = s.stats.ByTag(ctx, userID, projectID, maxStatsTags); err != nil {
+			return err
+		}
+		if result.Series, err = s.stats.Series(ctx, userID, projectID, result.Period, result.From, result.To); err != nil {
+			return err
+		}
+		result.Streaks, err = s.stats.Streaks(ctx, userID, projectID, today)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
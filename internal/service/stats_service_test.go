@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+type snapshotKey struct{}
+
+// snapshotTransactor помечает контекст fn, чтобы репозиторий видел, что вызван внутри снимка
+type snapshotTransactor struct {
+	repository.Transactor
+	snapshots int
+}
+
+func (t *snapshotTransactor) InSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	t.snapshots++
+	return fn(context.WithValue(ctx, snapshotKey{}, true))
+}
+
+// snapshotStats запоминает запросы, выполненные вне снимка
+type snapshotStats struct {
+	outside []string
+}
+
+func (s *snapshotStats) check(ctx context.Context, query string) {
+	if ctx.Value(snapshotKey{}) == nil {
+		s.outside = append(s.outside, query)
+	}
+}
+
+func (s *snapshotStats) Totals(ctx context.Context, _, _ int32, _, _ time.Time) (*db.TaskStatsRow, error) {
+	s.check(ctx, "Totals")
+	return &db.TaskStatsRow{}, nil
+}
+
+func (s *snapshotStats) ByProject(ctx context.Context, _, _ int32) ([]*db.TaskStatsByProjectRow, error) {
+	s.check(ctx, "ByProject")
+	return nil, nil
+}
+
+func (s *snapshotStats) ByTag(ctx context.Context, _, _ int32, _ int32) ([]*db.TaskStatsByTagRow, error) {
+	s.check(ctx, "ByTag")
+	return nil, nil
+}
+
+func (s *snapshotStats) Series(ctx context.Context, _, _ int32, _ repository.StatsPeriod, _, _ time.Time) ([]*db.TaskStatsSeriesRow, error) {
+	s.check(ctx, "Series")
+	return nil, nil
+}
+
+func (s *snapshotStats) Streaks(ctx context.Context, _, _ int32, _ time.Time) (*db.CompletionStreaksRow, error) {
+	s.check(ctx, "Streaks")
+	return &db.CompletionStreaksRow{}, nil
+}
+
+// Все части статистики читаются в одной транзакции-снимке
+func TestStatsReadsOneSnapshot(t *testing.T) {
+	stats, tx := &snapshotStats{}, &snapshotTransactor{}
+	svc := NewStatsService(stats, tx, NewAuthorizer(nil))
+
+	if _, err := svc.Stats(context.Background(), StatsQuery{}); err != nil {
+		t.Fatalf("Stats: %v", err)
+	}
+	if tx.snapshots != 1 || len(stats.outside) != 0 {
+		t.Fatalf("%d snapshots, queries outside: %v; want one snapshot with every query", tx.snapshots, stats.outside)
+	}
+}
@@ -2,108 +2,670 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
 	db "GreatProject/internal/database"
 	"GreatProject/internal/repository"
 )
 
-var (
-	ErrTaskNotFound    = errors.New("task not found")
-	ErrInvalidTaskData = errors.New("invalid task data")
-	ErrEmptyTaskName   = errors.New("task name cannot be empty")
-)
-
 type TaskService interface {
-	GetAllTasks(ctx context.Context, limit, offset int32) ([]*db.Task, error)
+	GetAllTasks(ctx context.Context, filter repository.TaskFilter, limit, offset int32) ([]*db.Task, error)
 	GetTaskByID(ctx context.Context, id int32) (*db.Task, error)
-	CreateTask(ctx context.Context, name, description string) (*db.Task, error)
-	UpdateTask(ctx context.Context, id int32, name, description string, completed bool) (*db.Task, error)
+	// CreateTask создает задачу; projectID == 0 - задача вне проекта
+	CreateTask(ctx context.Context, projectID int32, input TaskInput) (*db.Task, error)
+	// UpdateTask обновляет задачу; необязательные поля input, равные nil, не меняются
+	UpdateTask(ctx context.Context, id int32, input TaskInput) (*db.Task, error)
 	DeleteTask(ctx context.Context, id int32) error
-	CompleteTask(ctx context.Context, id int32) (*db.Task, error)
+	// CompleteTask отмечает задачу выполненной; пока не выполнены блокирующие ее задачи -
+	// BlockedError, если не указан force
+	CompleteTask(ctx context.Context, id int32, force bool) (*db.Task, error)
 	UncompleteTask(ctx context.Context, id int32) (*db.Task, error)
 	GetCompletedTasks(ctx context.Context, limit, offset int32) ([]*db.Task, error)
 	GetPendingTasks(ctx context.Context, limit, offset int32) ([]*db.Task, error)
+	GetTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter, limit, offset int32) ([]*db.Task, error)
+	// MoveTask ставит задачу в ее списке перед beforeID и/или после afterID (0 - не задано)
+	MoveTask(ctx context.Context, id, beforeID, afterID int32) (*db.Task, error)
+	CountTasks(ctx context.Context, filter repository.TaskFilter) (int64, error)
+	CountTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter) (int64, error)
+	// GetAssignedTasks задачи, на которые назначен текущий пользователь; completed == nil - в любом статусе
+	GetAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter, limit, offset int32) ([]*db.Task, error)
+	CountAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter) (int64, error)
+	// Details вычисляемые свойства задач для ответа API
+	Details(ctx context.Context, tasks []*db.Task) (map[int32]TaskDetails, error)
 }
 
+// TaskInput поля задачи из запроса на создание или изменение
+type TaskInput struct {
+	Name        string
+	Description string
+	// Completed учитывается только в UpdateTask
+	Completed bool
+	// AssigneeIDs исполнители; nil - не меняются
+	AssigneeIDs []int32
+	// CustomFields значения пользовательских полей проекта, как они пришли в JSON:
+	// меняются только переданные поля, null снимает значение; nil - поля не меняются
+	CustomFields map[string]any
+	// EstimateMinutes оценка в минутах; nil - не меняется, 0 - снять оценку
+	EstimateMinutes *int32
+	// DueAt срок задачи; nil - не меняется, нулевое время - снять срок
+	DueAt *time.Time
+	// Tags метки задачи; nil - не меняются
+	Tags []string
+	// ParentID родительская задача, 0 - нет; учитывается только в CreateTask
+	ParentID int32
+	// MilestoneID веха проекта задачи; nil - не меняется, 0 - убрать из вехи
+	MilestoneID *int32
+}
+
+// TaskDetails вычисляемые свойства задачи, которых нет в ее строке в БД
+type TaskDetails struct {
+	// Blocked у задачи есть невыполненные блокирующие задачи
+	Blocked bool
+	// ChecklistTotal и ChecklistChecked число пунктов чек-листа и отмеченных из них
+	ChecklistTotal   int32
+	ChecklistChecked int32
+	// AssigneeIDs исполнители задачи в порядке назначения
+	AssigneeIDs []int32
+}
+
+// Limits квоты на ресурсы одного пользователя; 0 - без ограничения
+type Limits struct {
+	MaxTasksPerUser int64
+}
+
+// taskService проверяет права клиента на каждую операцию: списки содержат только видимые
+// ему задачи, а изменение задачи проекта требует соответствующей роли (см. Authorizer)
 type taskService struct {
-	repo repository.TaskRepository
+	repo         repository.TaskRepository
+	authorizer   Authorizer
+	activity     repository.ActivityRepository
+	dependencies repository.DependencyRepository
+	checklists   repository.ChecklistRepository
+	assignees    repository.AssigneeRepository
+	fields       repository.CustomFieldRepository
+	milestones   repository.MilestoneRepository
+	tx           repository.Transactor
+	limits       Limits
 }
 
-func NewTaskService(repo repository.TaskRepository) TaskService {
+func NewTaskService(
+	repo repository.TaskRepository,
+	authorizer Authorizer,
+	activity repository.ActivityRepository,
+	dependencies repository.DependencyRepository,
+	checklists repository.ChecklistRepository,
+	assignees repository.AssigneeRepository,
+	fields repository.CustomFieldRepository,
+	milestones repository.MilestoneRepository,
+	tx repository.Transactor,
+	limits Limits,
+) TaskService {
 	return &taskService{
-		repo: repo,
+		repo:         repo,
+		authorizer:   authorizer,
+		activity:     activity,
+		dependencies: dependencies,
+		checklists:   checklists,
+		assignees:    assignees,
+		fields:       fields,
+		milestones:   milestones,
+		tx:           tx,
+		limits:       limits,
 	}
 }
 
-func (s *taskService) GetAllTasks(ctx context.Context, limit, offset int32) ([]*db.Task, error) {
-	return s.repo.GetAll(ctx, limit, offset)
+func (s *taskService) GetAllTasks(ctx context.Context, filter repository.TaskFilter, limit, offset int32) ([]*db.Task, error) {
+	return s.repo.GetAll(ctx, userID(ctx), filter, limit, offset)
 }
 
 func (s *taskService) GetTaskByID(ctx context.Context, id int32) (*db.Task, error) {
-	task, err := s.repo.GetByID(ctx, id)
-	if err != nil {
-		return nil, ErrTaskNotFound
-	}
-	return task, nil
+	return s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksRead)
 }
 
-func (s *taskService) CreateTask(ctx context.Context, name, description string) (*db.Task, error) {
-	if name == "" {
-		return nil, ErrEmptyTaskName
+// CreateTask создает задачу. Входные данные проверяются на уровне API по схеме CreateTaskRequest.
+// Задача аутентифицированного пользователя записывается на него и учитывается в его квоте;
+// анонимные задачи создаются без владельца и вне проектов.
+func (s *taskService) CreateTask(ctx context.Context, projectID int32, input TaskInput) (*db.Task, error) {
+	if projectID != 0 {
+		if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksWrite); err != nil {
+			return nil, err
+		}
 	}
 
-	if len(name) > 255 {
-		return nil, ErrInvalidTaskData
+	ownerID := userID(ctx)
+	if err := s.checkAssignees(ctx, projectID, ownerID, input.AssigneeIDs); err != nil {
+		return nil, err
+	}
+	if input.ParentID != 0 {
+		if err := s.checkParent(ctx, input.ParentID, projectID); err != nil {
+			return nil, err
+		}
+	}
+	data := repository.TaskData{Name: input.Name, Description: input.Description, Tags: input.Tags, ParentID: input.ParentID}
+	var err error
+	if data.CustomFields, err = s.customFields(ctx, projectID, nil, input.CustomFields, true); err != nil {
+		return nil, err
+	}
+	if input.EstimateMinutes != nil {
+		data.EstimateMinutes = *input.EstimateMinutes
+	}
+	if input.DueAt != nil {
+		data.DueAt = *input.DueAt
+	}
+	if input.MilestoneID != nil && *input.MilestoneID != 0 {
+		if err := s.checkMilestone(ctx, *input.MilestoneID, projectID); err != nil {
+			return nil, err
+		}
+		data.MilestoneID = *input.MilestoneID
 	}
 
-	return s.repo.Create(ctx, name, description)
+	// задача и ее исполнители создаются вместе: ошибка назначения не оставляет задачу без них
+	var task *db.Task
+	var added []int32
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := checkTaskQuota(ctx, s.repo, s.limits, ownerID, 1); err != nil {
+			return err
+		}
+		var err error
+		if task, err = s.repo.Create(ctx, ownerID, projectID, data); err != nil {
+			return err
+		}
+		if len(input.AssigneeIDs) > 0 {
+			added, _, err = s.assign(ctx, task.ID, input.AssigneeIDs)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, task.ID, repository.EventTaskCreated)
+	s.recordAssignments(ctx, task.ID, added, nil)
+	return task, nil
 }
 
-func (s *taskService) UpdateTask(ctx context.Context, id int32, name, description string, completed bool) (*db.Task, error) {
-	if name == "" {
-		return nil, ErrEmptyTaskName
+// UpdateTask обновляет задачу. Входные данные проверяются на уровне API по схеме UpdateTaskRequest.
+// Заблокированную задачу так выполнить нельзя: принудительно - только через CompleteTask с force.
+func (s *taskService) UpdateTask(ctx context.Context, id int32, input TaskInput) (*db.Task, error) {
+	task, err := s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksWrite)
+	if err != nil {
+		return nil, err
 	}
-
-	if len(name) > 255 {
-		return nil, ErrInvalidTaskData
+	if input.AssigneeIDs != nil {
+		if err := s.checkAssignees(ctx, task.ProjectID.Int32, task.OwnerID.Int32, input.AssigneeIDs); err != nil {
+			return nil, err
+		}
+	}
+	data := repository.TaskData{
+		Name:            input.Name,
+		Description:     input.Description,
+		Completed:       input.Completed,
+		CustomFields:    task.CustomFields,
+		EstimateMinutes: task.EstimateMinutes.Int32,
+		DueAt:           task.DueAt.Time,
+		Tags:            task.Tags,
+		MilestoneID:     task.MilestoneID.Int32,
+	}
+	if input.CustomFields != nil {
+		if data.CustomFields, err = s.customFields(ctx, task.ProjectID.Int32, task.CustomFields, input.CustomFields, false); err != nil {
+			return nil, err
+		}
+	}
+	if input.EstimateMinutes != nil {
+		data.EstimateMinutes = *input.EstimateMinutes
+	}
+	if input.DueAt != nil {
+		data.DueAt = *input.DueAt
+	}
+	if input.Tags != nil {
+		data.Tags = input.Tags
+	}
+	if input.MilestoneID != nil && *input.MilestoneID != task.MilestoneID.Int32 {
+		if *input.MilestoneID != 0 {
+			if err := s.checkMilestone(ctx, *input.MilestoneID, task.ProjectID.Int32); err != nil {
+				return nil, err
+			}
+		}
+		data.MilestoneID = *input.MilestoneID
 	}
 
-	task, err := s.repo.Update(ctx, id, name, description, completed)
+	event := repository.EventTaskUpdated
+	if was := task.Completed.Valid && task.Completed.Bool; was != input.Completed {
+		event = statusEvent(input.Completed)
+		if input.Completed {
+			if err := s.checkBlockers(ctx, id); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var updated *db.Task
+	var added, removed []int32
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		var err error
+		if updated, err = s.repo.Update(ctx, id, data); err != nil {
+			return err
+		}
+		if input.AssigneeIDs != nil {
+			added, removed, err = s.assign(ctx, id, input.AssigneeIDs)
+		}
+		return err
+	})
 	if err != nil {
-		return nil, ErrTaskNotFound
+		return nil, err
 	}
-	return task, nil
+	s.record(ctx, updated.ID, event)
+	s.recordAssignments(ctx, id, added, removed)
+	return updated, nil
 }
 
 func (s *taskService) DeleteTask(ctx context.Context, id int32) error {
-	err := s.repo.Delete(ctx, id)
-	if err != nil {
-		return ErrTaskNotFound
+	if _, err := s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksWrite); err != nil {
+		return err
 	}
-	return nil
+	return s.repo.Delete(ctx, id)
 }
 
-func (s *taskService) CompleteTask(ctx context.Context, id int32) (*db.Task, error) {
+func (s *taskService) CompleteTask(ctx context.Context, id int32, force bool) (*db.Task, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	if !force {
+		if err := s.checkBlockers(ctx, id); err != nil {
+			return nil, err
+		}
+	}
 	task, err := s.repo.Complete(ctx, id)
 	if err != nil {
-		return nil, ErrTaskNotFound
+		return nil, err
 	}
+	s.record(ctx, task.ID, repository.EventTaskCompleted)
 	return task, nil
 }
 
 func (s *taskService) UncompleteTask(ctx context.Context, id int32) (*db.Task, error) {
+	if _, err := s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
 	task, err := s.repo.Uncomplete(ctx, id)
 	if err != nil {
-		return nil, ErrTaskNotFound
+		return nil, err
 	}
+	s.record(ctx, task.ID, repository.EventTaskUncompleted)
 	return task, nil
 }
 
 func (s *taskService) GetCompletedTasks(ctx context.Context, limit, offset int32) ([]*db.Task, error) {
-	return s.repo.GetByStatus(ctx, true, limit, offset)
+	return s.repo.GetByStatus(ctx, userID(ctx), true, repository.TaskFilter{Sort: repository.SortCreated}, limit, offset)
 }
 
 func (s *taskService) GetPendingTasks(ctx context.Context, limit, offset int32) ([]*db.Task, error) {
-	return s.repo.GetByStatus(ctx, false, limit, offset)
+	return s.repo.GetByStatus(ctx, userID(ctx), false, repository.TaskFilter{Sort: repository.SortCreated}, limit, offset)
+}
+
+func (s *taskService) GetTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter, limit, offset int32) ([]*db.Task, error) {
+	return s.repo.GetByStatus(ctx, userID(ctx), completed, filter, limit, offset)
+}
+
+// minPositionGap ближе этого соседние ранги не сходятся: середина между ними теряла бы точность,
+// поэтому список сначала перенумеровывается
+const minPositionGap = 1e-6
+
+// MoveTask ставит задачу на середину между соседями. Соседи - задачи того же списка, что и перемещаемая.
+// Если задан только один сосед, второй - следующая за ним задача списка (или край списка).
+func (s *taskService) MoveTask(ctx context.Context, id, beforeID, afterID int32) (*db.Task, error) {
+	task, err := s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksWrite)
+	if err != nil {
+		return nil, err
+	}
+	if beforeID == 0 && afterID == 0 {
+		return nil, apperrors.NewValidation("before_id", "before_id or after_id is required")
+	}
+
+	// после перенумерации соседи получают новые ранги, поэтому они загружаются заново
+	for attempt := 0; attempt < 2; attempt++ {
+		low, high, err := s.moveBounds(ctx, task, beforeID, afterID)
+		if err != nil {
+			return nil, err
+		}
+		if high-low >= minPositionGap {
+			return s.repo.SetPosition(ctx, id, low+(high-low)/2)
+		}
+		if err := s.repo.Rebalance(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("no room to move task %d after rebalancing", id)
+}
+
+// moveBounds ранги, между которыми должна встать задача
+func (s *taskService) moveBounds(ctx context.Context, task *db.Task, beforeID, afterID int32) (float64, float64, error) {
+	var before, after *db.Task
+	var err error
+	if beforeID != 0 {
+		if before, err = s.neighbor(ctx, task, beforeID, "before_id"); err != nil {
+			return 0, 0, err
+		}
+	}
+	if afterID != 0 {
+		if after, err = s.neighbor(ctx, task, afterID, "after_id"); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	switch {
+	case before != nil && after != nil:
+		if after.Position > before.Position || (after.Position == before.Position && after.ID > before.ID) {
+			return 0, 0, apperrors.NewValidation("after_id", "must come before the before_id task in the list")
+		}
+		return after.Position, before.Position, nil
+	case before != nil:
+		low, found, err := s.repo.PreviousPosition(ctx, before.ID, task.ID)
+		if !found {
+			low = before.Position - 1
+		}
+		return low, before.Position, err
+	default:
+		high, found, err := s.repo.NextPosition(ctx, after.ID, task.ID)
+		if !found {
+			high = after.Position + 1
+		}
+		return after.Position, high, err
+	}
+}
+
+// neighbor загружает соседа перемещаемой задачи; невидимый клиенту сосед или сосед из другого
+// списка - ошибка поля field
+func (s *taskService) neighbor(ctx context.Context, task *db.Task, id int32, field string) (*db.Task, error) {
+	if id == task.ID {
+		return nil, apperrors.NewValidation(field, "must differ from the moved task")
+	}
+	neighbor, err := s.authorizer.LoadTask(ctx, s.repo, id, auth.PermissionTasksRead)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, apperrors.NewValidation(field, fmt.Sprintf("task %d not found", id))
+	}
+	if err != nil {
+		return nil, err
+	}
+	sameList := neighbor.ProjectID == task.ProjectID && (task.ProjectID.Valid || neighbor.OwnerID == task.OwnerID)
+	if !sameList {
+		return nil, apperrors.NewValidation(field, fmt.Sprintf("task %d is in another list", id))
+	}
+	return neighbor, nil
+}
+
+func (s *taskService) CountTasks(ctx context.Context, filter repository.TaskFilter) (int64, error) {
+	return s.repo.CountVisible(ctx, userID(ctx), filter)
+}
+
+func (s *taskService) CountTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter) (int64, error) {
+	return s.repo.CountVisibleByStatus(ctx, userID(ctx), completed, filter)
+}
+
+func (s *taskService) GetAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter, limit, offset int32) ([]*db.Task, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.assignees.Assigned(ctx, user, completed, filter, limit, offset)
+}
+
+func (s *taskService) CountAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter) (int64, error) {
+	user, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return s.assignees.CountAssigned(ctx, user, completed, filter)
+}
+
+func (s *taskService) Details(ctx context.Context, tasks []*db.Task) (map[int32]TaskDetails, error) {
+	ids := make([]int32, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.ID
+	}
+	blocked, err := s.dependencies.Blocked(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	progress, err := s.checklists.Progress(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	assignees, err := s.assignees.ByTasks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	details := make(map[int32]TaskDetails, len(tasks))
+	for _, id := range blocked {
+		details[id] = TaskDetails{Blocked: true}
+	}
+	for _, p := range progress {
+		d := details[p.TaskID]
+		d.ChecklistTotal, d.ChecklistChecked = p.Total, p.Checked
+		details[p.TaskID] = d
+	}
+	for _, a := range assignees {
+		d := details[a.TaskID]
+		d.AssigneeIDs = append(d.AssigneeIDs, a.UserID)
+		details[a.TaskID] = d
+	}
+	return details, nil
+}
+
+// checkAssignees исполнителем может быть только пользователь, которому видна задача: участник ее проекта,
+// а у личной задачи - ее владелец. Задачу без владельца и проекта видят все, ее исполнитель - любой.
+func (s *taskService) checkAssignees(ctx context.Context, projectID, ownerID int32, assigneeIDs []int32) error {
+	switch {
+	case len(assigneeIDs) == 0:
+		return nil
+	case projectID != 0:
+		outsiders, err := s.assignees.NonMembers(ctx, projectID, assigneeIDs)
+		if err != nil {
+			return err
+		}
+		if len(outsiders) > 0 {
+			return apperrors.NewValidation("assignee_ids", fmt.Sprintf("user %d is not a member of project %d", outsiders[0], projectID))
+		}
+	case ownerID != 0:
+		for _, id := range assigneeIDs {
+			if id != ownerID {
+				return apperrors.NewValidation("assignee_ids", fmt.Sprintf("user %d cannot see this personal task; only its owner can be assigned", id))
+			}
+		}
+	}
+	return nil
+}
+
+// checkParent подзадачу можно создать у задачи, которую клиент может изменять, в том же проекте;
+// невидимая клиенту родительская задача - ошибка поля parent_id
+func (s *taskService) checkParent(ctx context.Context, parentID, projectID int32) error {
+	parent, err := s.authorizer.LoadTask(ctx, s.repo, parentID, auth.PermissionTasksWrite)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return apperrors.NewValidation("parent_id", fmt.Sprintf("task %d not found", parentID))
+	}
+	if err != nil {
+		return err
+	}
+	if parent.ProjectID.Int32 != projectID {
+		return apperrors.NewValidation("parent_id", "subtask must be in the same project as its parent")
+	}
+	return nil
+}
+
+// checkMilestone задача может входить только в веху своего проекта
+func (s *taskService) checkMilestone(ctx context.Context, milestoneID, projectID int32) error {
+	milestone, err := s.milestones.GetByID(ctx, milestoneID)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return apperrors.NewValidation("milestone_id", fmt.Sprintf("milestone %d not found", milestoneID))
+	}
+	if err != nil {
+		return err
+	}
+	if milestone.ProjectID != projectID {
+		return apperrors.NewValidation("milestone_id", fmt.Sprintf("milestone %d is not in the task's project", milestoneID))
+	}
+	return nil
+}
+
+// customFields проверяет значения input по схеме полей проекта и накладывает их на текущие значения
+// задачи current (JSON). null в input снимает значение. Обязательные поля проверяются при создании
+// задачи и при каждом изменении ее полей. У задач вне проектов пользовательских полей нет.
+func (s *taskService) customFields(ctx context.Context, projectID int32, current []byte, input map[string]any, creating bool) ([]byte, error) {
+	values := map[string]any{}
+	if len(current) > 0 {
+		if err := json.Unmarshal(current, &values); err != nil {
+			return nil, fmt.Errorf("decode task custom fields: %w", err)
+		}
+	}
+	if projectID == 0 {
+		if len(input) > 0 {
+			return nil, apperrors.NewValidation("custom_fields", "tasks outside projects have no custom fields")
+		}
+		return json.Marshal(values)
+	}
+
+	fields, err := s.fields.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	schema := make(map[string]*db.ProjectCustomField, len(fields))
+	for _, field := range fields {
+		schema[field.Key] = field
+	}
+
+	// userKeys поле, в котором указан пользователь: участников проекта проверяет один запрос
+	userKeys := make(map[int32]string)
+	for _, key := range slices.Sorted(maps.Keys(input)) {
+		field, ok := schema[key]
+		if !ok {
+			return nil, apperrors.NewValidation("custom_fields."+key, fmt.Sprintf("project %d has no such field", projectID))
+		}
+		if input[key] == nil {
+			delete(values, key)
+			continue
+		}
+		value, err := fieldValue(field, input[key])
+		if err != nil {
+			return nil, apperrors.NewValidation("custom_fields."+key, err.Error())
+		}
+		if value == nil {
+			delete(values, key)
+			continue
+		}
+		if id, ok := value.(int32); ok {
+			userKeys[id] = key
+		}
+		values[key] = value
+	}
+	if len(userKeys) > 0 {
+		outsiders, err := s.assignees.NonMembers(ctx, projectID, slices.Collect(maps.Keys(userKeys)))
+		if err != nil {
+			return nil, err
+		}
+		if len(outsiders) > 0 {
+			return nil, apperrors.NewValidation("custom_fields."+userKeys[outsiders[0]], fmt.Sprintf("user %d is not a member of project %d", outsiders[0], projectID))
+		}
+	}
+
+	if creating || len(input) > 0 {
+		for _, field := range fields {
+			if _, ok := values[field.Key]; field.Required && !ok {
+				return nil, apperrors.NewValidation("custom_fields."+field.Key, "is required")
+			}
+		}
+	}
+	return json.Marshal(values)
+}
+
+// assign оставляет у задачи ровно исполнителей assigneeIDs; возвращает назначенных и снятых
+func (s *taskService) assign(ctx context.Context, taskID int32, assigneeIDs []int32) (added, removed []int32, err error) {
+	assigneeIDs = slices.Compact(slices.Sorted(slices.Values(assigneeIDs)))
+	return s.assignees.Set(ctx, taskID, userID(ctx), assigneeIDs)
+}
+
+// recordAssignments записывает назначения и снятия исполнителей в ленту задачи. Вызывается после
+// транзакции изменения: ошибка записи события внутри нее прервала бы транзакцию
+func (s *taskService) recordAssignments(ctx context.Context, taskID int32, added, removed []int32) {
+	for _, id := range added {
+		s.recordFor(ctx, taskID, id, repository.EventTaskAssigned)
+	}
+	for _, id := range removed {
+		s.recordFor(ctx, taskID, id, repository.EventTaskUnassigned)
+	}
+}
+
+// checkTaskQuota QuotaExceededError, если count новых задач пользователя ownerID не помещаются
+// в его квоту. Анонимные задачи (ownerID == 0) квотой не ограничены.
+// Вызывается в транзакции (Transactor.InTx), которая затем создает задачи: квота блокируется
+// до ее конца, поэтому параллельные запросы не превысят квоту.
+func checkTaskQuota(ctx context.Context, repo repository.TaskRepository, limits Limits, ownerID int32, count int64) error {
+	if ownerID == 0 || limits.MaxTasksPerUser <= 0 {
+		return nil
+	}
+	if err := repo.LockQuota(ctx, ownerID); err != nil {
+		return err
+	}
+	owned, err := repo.CountByOwner(ctx, ownerID)
+	if err != nil {
+		return err
+	}
+	if owned+count > limits.MaxTasksPerUser {
+		return apperrors.NewQuotaExceeded("tasks", limits.MaxTasksPerUser)
+	}
+	return nil
+}
+
+// checkBlockers BlockedError, если у задачи есть невыполненные блокирующие задачи
+func (s *taskService) checkBlockers(ctx context.Context, id int32) error {
+	blockers, err := s.dependencies.OpenBlockers(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(blockers) > 0 {
+		return apperrors.NewBlocked(id, blockers)
+	}
+	return nil
+}
+
+// record добавляет событие в ленту задачи (см. recordEvent)
+func (s *taskService) record(ctx context.Context, taskID int32, eventType string) {
+	recordEvent(ctx, s.activity, taskID, eventType)
+}
+
+// recordFor как record, но для события, касающегося пользователя subjectID
+func (s *taskService) recordFor(ctx context.Context, taskID, subjectID int32, eventType string) {
+	if err := s.activity.RecordFor(ctx, taskID, userID(ctx), subjectID, eventType); err != nil {
+		slog.WarnContext(ctx, "failed to record task event",
+			slog.Int("task_id", int(taskID)),
+			slog.String("event", eventType),
+			slog.Int("subject_id", int(subjectID)),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// recordEvent добавляет в ленту задачи событие клиента запроса. Ошибка только логируется:
+// изменение задачи уже выполнено.
+func recordEvent(ctx context.Context, activity repository.ActivityRepository, taskID int32, eventType string) {
+	if err := activity.Record(ctx, taskID, userID(ctx), eventType); err != nil {
+		slog.WarnContext(ctx, "failed to record task event",
+			slog.Int("task_id", int(taskID)),
+			slog.String("event", eventType),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+func statusEvent(completed bool) string {
+	if completed {
+		return repository.EventTaskCompleted
+	}
+	return repository.EventTaskUncompleted
 }
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// ownerlessTasks одна общая задача без владельца и проекта; запоминает попытки ее изменить
+type ownerlessTasks struct {
+	repository.TaskRepository
+	writes int
+}
+
+func (*ownerlessTasks) GetByID(_ context.Context, id int32) (*db.Task, error) {
+	if id != 1 {
+		return nil, apperrors.NewNotFound("task", id)
+	}
+	return &db.Task{ID: 1, WorkspaceID: 1, Name: "shared"}, nil
+}
+
+func (r *ownerlessTasks) Update(_ context.Context, id int32, _ repository.TaskData) (*db.Task, error) {
+	r.writes++
+	return &db.Task{ID: id, WorkspaceID: 1}, nil
+}
+
+func (r *ownerlessTasks) Delete(context.Context, int32) error {
+	r.writes++
+	return nil
+}
+
+// Задачу без владельца читают все, но ни анонимный, ни вошедший клиент не может ее изменить или удалить
+func TestOwnerlessTaskIsReadOnly(t *testing.T) {
+	callers := map[string]context.Context{
+		"anonymous": context.Background(),
+		"user":      auth.WithPrincipal(context.Background(), auth.Principal{UserID: 7, WorkspaceID: 1}),
+	}
+	for name, ctx := range callers {
+		t.Run(name, func(t *testing.T) {
+			tasks := &ownerlessTasks{}
+			svc := NewTaskService(tasks, NewAuthorizer(nil), nil, nil, nil, nil, nil, nil, nil, Limits{})
+
+			if _, err := svc.GetTaskByID(ctx, 1); err != nil {
+				t.Fatalf("GetTaskByID: %v", err)
+			}
+			if _, err := svc.UpdateTask(ctx, 1, TaskInput{Name: "changed"}); !errors.Is(err, apperrors.ErrForbidden) {
+				t.Errorf("UpdateTask: err = %v, want forbidden", err)
+			}
+			if err := svc.DeleteTask(ctx, 1); !errors.Is(err, apperrors.ErrForbidden) {
+				t.Errorf("DeleteTask: err = %v, want forbidden", err)
+			}
+			if tasks.writes != 0 {
+				t.Fatalf("ownerless task written %d times, want none", tasks.writes)
+			}
+		})
+	}
+}
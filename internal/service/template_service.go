@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"time"
+	"unicode/utf8"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// TemplateService шаблоны деревьев задач. Шаблон проекта видят участники проекта, а изменяют
+// и применяют те, кто может создавать в нем задачи; личный шаблон доступен только автору.
+type TemplateService interface {
+	// ListTemplates шаблоны клиента и его проектов; projectID > 0 - только шаблоны проекта
+	ListTemplates(ctx context.Context, projectID int32) ([]*db.TaskTemplate, error)
+	GetTemplate(ctx context.Context, id int32) (*db.TaskTemplate, error)
+	// CreateTemplate создает шаблон; projectID == 0 - личный шаблон
+	CreateTemplate(ctx context.Context, projectID int32, input TemplateInput) (*db.TaskTemplate, error)
+	UpdateTemplate(ctx context.Context, id int32, input TemplateInput) (*db.TaskTemplate, error)
+	DeleteTemplate(ctx context.Context, id int32) error
+	// Instantiate создает по шаблону все дерево задач со значениями переменных variables;
+	// сроки задач отсчитываются от startAt. Задачи возвращаются в порядке шаблона
+	Instantiate(ctx context.Context, id int32, variables map[string]string, startAt time.Time) ([]*db.Task, error)
+}
+
+// TemplateInput поля шаблона из запроса на создание или изменение
+type TemplateInput struct {
+	Name        string
+	Description string
+	Tasks       []TemplateTask
+}
+
+// TemplateTask задача шаблона; так же дерево хранится в task_templates.tasks
+type TemplateTask struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// DueOffsetMinutes срок задачи через столько минут после начала; nil - без срока
+	DueOffsetMinutes *int32         `json:"due_offset_minutes,omitempty"`
+	Subtasks         []TemplateTask `json:"subtasks,omitempty"`
+}
+
+const (
+	// maxTemplateTasks сколько задач может быть в дереве шаблона
+	maxTemplateTasks = 200
+	// maxTemplateDepth сколько уровней может быть в дереве шаблона
+	maxTemplateDepth = 5
+	// maxTaskName и maxTaskDescription ограничения задачи (см. CreateTaskRequest),
+	// которые после подстановки переменных проверяет сервис
+	maxTaskName        = 255
+	maxTaskDescription = 1000
+)
+
+// templateVariable переменная {{name}} в названии или описании задачи шаблона
+var templateVariable = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+type templateService struct {
+	templates  repository.TemplateRepository
+	tasks      repository.TaskRepository
+	fields     repository.CustomFieldRepository
+	activity   repository.ActivityRepository
+	tx         repository.Transactor
+	authorizer Authorizer
+	limits     Limits
+}
+
+func NewTemplateService(
+	templates repository.TemplateRepository,
+	tasks repository.TaskRepository,
+	fields repository.CustomFieldRepository,
+	activity repository.ActivityRepository,
+	tx repository.Transactor,
+	authorizer Authorizer,
+	limits Limits,
+) TemplateService {
+	return &templateService{
+		templates:  templates,
+		tasks:      tasks,
+		fields:     fields,
+		activity:   activity,
+		tx:         tx,
+		authorizer: authorizer,
+		limits:     limits,
+	}
+}
+
+func (s *templateService) ListTemplates(ctx context.Context, projectID int32) ([]*db.TaskTemplate, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if projectID != 0 {
+		if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksRead); err != nil {
+			return nil, err
+		}
+	}
+	return s.templates.List(ctx, userID, projectID)
+}
+
+func (s *templateService) GetTemplate(ctx context.Context, id int32) (*db.TaskTemplate, error) {
+	return s.template(ctx, id, auth.PermissionTasksRead)
+}
+
+func (s *templateService) CreateTemplate(ctx context.Context, projectID int32, input TemplateInput) (*db.TaskTemplate, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if projectID != 0 {
+		if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksWrite); err != nil {
+			return nil, err
+		}
+	}
+	data, err := templateData(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.templates.Create(ctx, userID, projectID, data)
+}
+
+func (s *templateService) UpdateTemplate(ctx context.Context, id int32, input TemplateInput) (*db.TaskTemplate, error) {
+	if _, err := s.template(ctx, id, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	data, err := templateData(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.templates.Update(ctx, id, data)
+}
+
+func (s *templateService) DeleteTemplate(ctx context.Context, id int32) error {
+	if _, err := s.template(ctx, id, auth.PermissionTasksWrite); err != nil {
+		return err
+	}
+	return s.templates.Delete(ctx, id)
+}
+
+// Instantiate задачи проекта создаются без значений пользовательских полей, поэтому в проекте
+// с обязательными полями шаблон применить нельзя
+func (s *templateService) Instantiate(ctx context.Context, id int32, variables map[string]string, startAt time.Time) ([]*db.Task, error) {
+	template, err := s.template(ctx, id, auth.PermissionTasksWrite)
+	if err != nil {
+		return nil, err
+	}
+	tasks, err := ParseTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+
+	used := TemplateVariables(tasks)
+	for _, name := range used {
+		if _, ok := variables[name]; !ok {
+			return nil, apperrors.NewValidation("variables."+name, "is required")
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(variables)) {
+		if !slices.Contains(used, name) {
+			return nil, apperrors.NewValidation("variables."+name, fmt.Sprintf("template %d has no such variable", id))
+		}
+	}
+
+	nodes, err := templateNodes(tasks, variables, startAt)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := template.ProjectID.Int32
+	if err := checkNoRequiredFields(ctx, s.fields, projectID, "a template"); err != nil {
+		return nil, err
+	}
+
+	ownerID := userID(ctx)
+	var created []*db.Task
+	err = s.tx.InTx(ctx, func(ctx context.Context) error {
+		if err := checkTaskQuota(ctx, s.tasks, s.limits, ownerID, int64(len(nodes))); err != nil {
+			return err
+		}
+		var err error
+		created, err = s.templates.CreateTasks(ctx, ownerID, projectID, nodes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range created {
+		recordEvent(ctx, s.activity, task.ID, repository.EventTaskCreated)
+	}
+	return created, nil
+}
+
+// template загружает шаблон и проверяет право клиента на него; чужой личный шаблон
+// и шаблон чужого проекта не найдены
+func (s *templateService) template(ctx context.Context, id int32, permission auth.Permission) (*db.TaskTemplate, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.templates.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !template.ProjectID.Valid {
+		if template.OwnerID != userID {
+			return nil, apperrors.NewNotFound("template", id)
+		}
+		return template, nil
+	}
+	_, err = s.authorizer.AuthorizeProject(ctx, template.ProjectID.Int32, permission)
+	if errors.Is(err, apperrors.ErrNotFound) {
+		return nil, apperrors.NewNotFound("template", id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// ParseTemplate дерево задач шаблона
+func ParseTemplate(template *db.TaskTemplate) ([]TemplateTask, error) {
+	var tasks []TemplateTask
+	if err := json.Unmarshal(template.Tasks, &tasks); err != nil {
+		return nil, fmt.Errorf("decode template %d tasks: %w", template.ID, err)
+	}
+	return tasks, nil
+}
+
+// TemplateVariables имена переменных дерева задач по алфавиту
+func TemplateVariables(tasks []TemplateTask) []string {
+	seen := map[string]bool{}
+	walkTemplate(tasks, 1, func(task TemplateTask, _ int) {
+		for _, text := range []string{task.Name, task.Description} {
+			for _, match := range templateVariable.FindAllStringSubmatch(text, -1) {
+				seen[match[1]] = true
+			}
+		}
+	})
+	return slices.Sorted(maps.Keys(seen))
+}
+
+// templateData проверяет размер дерева задач шаблона; остальное проверено по спецификации
+func templateData(input TemplateInput) (repository.TemplateData, error) {
+	count, depth := 0, 0
+	walkTemplate(input.Tasks, 1, func(_ TemplateTask, level int) {
+		count++
+		depth = max(depth, level)
+	})
+	if count > maxTemplateTasks {
+		return repository.TemplateData{}, apperrors.NewValidation("tasks", fmt.Sprintf("template can have at most %d tasks", maxTemplateTasks))
+	}
+	if depth > maxTemplateDepth {
+		return repository.TemplateData{}, apperrors.NewValidation("tasks", fmt.Sprintf("template can have at most %d levels of subtasks", maxTemplateDepth))
+	}
+
+	tasks, err := json.Marshal(input.Tasks)
+	if err != nil {
+		return repository.TemplateData{}, fmt.Errorf("encode template tasks: %w", err)
+	}
+	return repository.TemplateData{Name: input.Name, Description: input.Description, Tasks: tasks}, nil
+}
+
+// templateNodes задачи дерева в порядке обхода (родитель перед подзадачами) с подставленными переменными
+func templateNodes(tasks []TemplateTask, variables map[string]string, startAt time.Time) ([]repository.TemplateNode, error) {
+	expand := func(text string) string {
+		return templateVariable.ReplaceAllStringFunc(text, func(match string) string {
+			return variables[templateVariable.FindStringSubmatch(match)[1]]
+		})
+	}
+
+	var nodes []repository.TemplateNode
+	var add func(tasks []TemplateTask, parent int) error
+	add = func(tasks []TemplateTask, parent int) error {
+		for _, task := range tasks {
+			node := repository.TemplateNode{
+				Parent:      parent,
+				Name:        expand(task.Name),
+				Description: expand(task.Description),
+				Tags:        task.Tags,
+			}
+			if node.Name == "" || utf8.RuneCountInString(node.Name) > maxTaskName {
+				return apperrors.NewValidation("variables", fmt.Sprintf("task name %q must be 1 to %d characters after substitution", task.Name, maxTaskName))
+			}
+			if utf8.RuneCountInString(node.Description) > maxTaskDescription {
+				return apperrors.NewValidation("variables", fmt.Sprintf("description of task %q must be at most %d characters after substitution", task.Name, maxTaskDescription))
+			}
+			if task.DueOffsetMinutes != nil {
+				node.DueAt = startAt.Add(time.Duration(*task.DueOffsetMinutes) * time.Minute)
+			}
+			nodes = append(nodes, node)
+			if err := add(task.Subtasks, len(nodes)-1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := add(tasks, -1); err != nil {
+		return nil, err
+	}
+	return nodes, nil
+}
+
+// walkTemplate обходит дерево задач шаблона; level - уровень задачи, у корней 1
+func walkTemplate(tasks []TemplateTask, level int, visit func(task TemplateTask, level int)) {
+	for _, task := range tasks {
+		visit(task, level)
+		walkTemplate(task.Subtasks, level+1, visit)
+	}
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/auth"
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+)
+
+// maxTimesheetDays наибольший период табеля в днях
+const maxTimesheetDays = 366
+
+// TimeService учет времени по задачам: ручные записи и таймер.
+// Видеть записи может любой, кому видна задача; учитывать свое время - пользователь
+// с правом tasks:write на задачу. Удалить запись может только ее автор.
+type TimeService interface {
+	// ListEntries записи задачи и итог по ней
+	ListEntries(ctx context.Context, taskID int32) ([]*db.TaskTimeEntry, *TaskTime, error)
+	// LogTime добавляет завершенный интервал; startedAt == nil - интервал заканчивается сейчас
+	LogTime(ctx context.Context, taskID int32, duration time.Duration, startedAt *time.Time, note string) (*db.TaskTimeEntry, error)
+	// StartTimer запускает таймер на задаче; идущий таймер пользователя останавливается
+	StartTimer(ctx context.Context, taskID int32, note string) (*db.TaskTimeEntry, error)
+	StopTimer(ctx context.Context, taskID int32) (*db.TaskTimeEntry, error)
+	// RunningTimer идущий таймер текущего пользователя; нет таймера - NotFoundError
+	RunningTimer(ctx context.Context) (*db.TaskTimeEntry, error)
+	DeleteEntry(ctx context.Context, taskID, id int32) error
+	// ProjectTime оценки и учтенное время задач проекта
+	ProjectTime(ctx context.Context, projectID int32) ([]*TaskTime, error)
+	// Timesheet табель по задачам, видимым текущему пользователю
+	Timesheet(ctx context.Context, query repository.TimesheetQuery) ([]*db.TimesheetRow, error)
+}
+
+// TaskTime оценка задачи и учтенное по ней время
+type TaskTime struct {
+	TaskID int32
+	// EstimateMinutes 0 - задача не оценена
+	EstimateMinutes int32
+	TrackedSeconds  int64
+	// TotalEstimateMinutes и TotalTrackedSeconds то же вместе со всеми подзадачами задачи
+	TotalEstimateMinutes int64
+	TotalTrackedSeconds  int64
+}
+
+type timeService struct {
+	entries    repository.TimeEntryRepository
+	tasks      repository.TaskRepository
+	authorizer Authorizer
+}
+
+func NewTimeService(entries repository.TimeEntryRepository, tasks repository.TaskRepository, authorizer Authorizer) TimeService {
+	return &timeService{
+		entries:    entries,
+		tasks:      tasks,
+		authorizer: authorizer,
+	}
+}
+
+func (s *timeService) ListEntries(ctx context.Context, taskID int32) ([]*db.TaskTimeEntry, *TaskTime, error) {
+	task, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksRead)
+	if err != nil {
+		return nil, nil, err
+	}
+	entries, err := s.entries.List(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	totals, err := s.entries.Totals(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return entries, &TaskTime{
+		TaskID:               taskID,
+		EstimateMinutes:      task.EstimateMinutes.Int32,
+		TrackedSeconds:       totals.TrackedSeconds,
+		TotalEstimateMinutes: totals.TotalEstimateMinutes,
+		TotalTrackedSeconds:  totals.TotalTrackedSeconds,
+	}, nil
+}
+
+func (s *timeService) LogTime(ctx context.Context, taskID int32, duration time.Duration, startedAt *time.Time, note string) (*db.TaskTimeEntry, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	start := now.Add(-duration)
+	if startedAt != nil {
+		start = *startedAt
+	}
+	if start.After(now) {
+		return nil, apperrors.NewValidation("started_at", "must not be in the future")
+	}
+	return s.entries.Create(ctx, taskID, userID, start, start.Add(duration), note)
+}
+
+func (s *timeService) StartTimer(ctx context.Context, taskID int32, note string) (*db.TaskTimeEntry, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	return s.entries.Start(ctx, taskID, userID, note)
+}
+
+func (s *timeService) StopTimer(ctx context.Context, taskID int32) (*db.TaskTimeEntry, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return nil, err
+	}
+	return s.entries.Stop(ctx, taskID, userID)
+}
+
+func (s *timeService) RunningTimer(ctx context.Context) (*db.TaskTimeEntry, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.entries.Running(ctx, userID)
+}
+
+func (s *timeService) DeleteEntry(ctx context.Context, taskID, id int32) error {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := s.authorizer.LoadTask(ctx, s.tasks, taskID, auth.PermissionTasksWrite); err != nil {
+		return err
+	}
+	entry, err := s.entries.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if entry.TaskID != taskID {
+		return apperrors.NewNotFound("time_entry", id)
+	}
+	if entry.UserID != userID {
+		return apperrors.NewForbidden("only the author can delete a time entry", "")
+	}
+	return s.entries.Delete(ctx, id)
+}
+
+func (s *timeService) ProjectTime(ctx context.Context, projectID int32) ([]*TaskTime, error) {
+	if _, err := s.authorizer.AuthorizeProject(ctx, projectID, auth.PermissionTasksRead); err != nil {
+		return nil, err
+	}
+	rows, err := s.entries.ProjectTasks(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	totals := make([]*TaskTime, len(rows))
+	for i, row := range rows {
+		totals[i] = &TaskTime{
+			TaskID:               row.TaskID,
+			EstimateMinutes:      row.EstimateMinutes.Int32,
+			TrackedSeconds:       row.TrackedSeconds,
+			TotalEstimateMinutes: row.TotalEstimateMinutes,
+			TotalTrackedSeconds:  row.TotalTrackedSeconds,
+		}
+	}
+	return totals, nil
+}
+
+// Timesheet To - первый момент после периода; период не длиннее maxTimesheetDays дней
+func (s *timeService) Timesheet(ctx context.Context, query repository.TimesheetQuery) ([]*db.TimesheetRow, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !query.To.After(query.From) {
+		return nil, apperrors.NewValidation("to", "must not be before from")
+	}
+	if query.To.Sub(query.From) > maxTimesheetDays*24*time.Hour {
+		return nil, apperrors.NewValidation("to", "period must not exceed 366 days")
+	}
+	if query.ProjectID != 0 {
+		if _, err := s.authorizer.AuthorizeProject(ctx, query.ProjectID, auth.PermissionTasksRead); err != nil {
+			return nil, err
+		}
+	}
+	return s.entries.Timesheet(ctx, userID, query)
+}
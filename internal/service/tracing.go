@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+
+	db "GreatProject/internal/database"
+	"GreatProject/internal/repository"
+	"GreatProject/internal/tracing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracedTaskService декоратор TaskService, открывающий span на каждый вызов
+type tracedTaskService struct {
+	next TaskService
+}
+
+func NewTracedTaskService(next TaskService) TaskService {
+	return &tracedTaskService{
+		next: next,
+	}
+}
+
+func (s *tracedTaskService) GetAllTasks(ctx context.Context, filter repository.TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.GetAllTasks", trace.WithAttributes(attribute.String("tasks.sort", string(filter.Sort))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetAllTasks(ctx, filter, limit, offset)
+}
+
+func (s *tracedTaskService) GetTaskByID(ctx context.Context, id int32) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.GetTaskByID", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetTaskByID(ctx, id)
+}
+
+func (s *tracedTaskService) CreateTask(ctx context.Context, projectID int32, input TaskInput) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.CreateTask", trace.WithAttributes(attribute.Int("project.id", int(projectID))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.CreateTask(ctx, projectID, input)
+}
+
+func (s *tracedTaskService) UpdateTask(ctx context.Context, id int32, input TaskInput) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.UpdateTask", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UpdateTask(ctx, id, input)
+}
+
+func (s *tracedTaskService) DeleteTask(ctx context.Context, id int32) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.DeleteTask", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.DeleteTask(ctx, id)
+}
+
+func (s *tracedTaskService) CompleteTask(ctx context.Context, id int32, force bool) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.CompleteTask", trace.WithAttributes(
+		attribute.Int("task.id", int(id)),
+		attribute.Bool("task.force", force),
+	))
+	defer func() { tracing.End(span, err) }()
+	return s.next.CompleteTask(ctx, id, force)
+}
+
+func (s *tracedTaskService) UncompleteTask(ctx context.Context, id int32) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.UncompleteTask", trace.WithAttributes(attribute.Int("task.id", int(id))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.UncompleteTask(ctx, id)
+}
+
+func (s *tracedTaskService) GetCompletedTasks(ctx context.Context, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.GetCompletedTasks")
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetCompletedTasks(ctx, limit, offset)
+}
+
+func (s *tracedTaskService) GetPendingTasks(ctx context.Context, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.GetPendingTasks")
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetPendingTasks(ctx, limit, offset)
+}
+
+func (s *tracedTaskService) GetTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.GetTasksByStatus", trace.WithAttributes(
+		attribute.Bool("task.completed", completed),
+		attribute.String("tasks.sort", string(filter.Sort)),
+	))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetTasksByStatus(ctx, completed, filter, limit, offset)
+}
+
+func (s *tracedTaskService) MoveTask(ctx context.Context, id, beforeID, afterID int32) (task *db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.MoveTask", trace.WithAttributes(
+		attribute.Int("task.id", int(id)),
+		attribute.Int("task.before_id", int(beforeID)),
+		attribute.Int("task.after_id", int(afterID)),
+	))
+	defer func() { tracing.End(span, err) }()
+	return s.next.MoveTask(ctx, id, beforeID, afterID)
+}
+
+func (s *tracedTaskService) CountTasks(ctx context.Context, filter repository.TaskFilter) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.CountTasks")
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountTasks(ctx, filter)
+}
+
+func (s *tracedTaskService) CountTasksByStatus(ctx context.Context, completed bool, filter repository.TaskFilter) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.CountTasksByStatus", trace.WithAttributes(attribute.Bool("task.completed", completed)))
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountTasksByStatus(ctx, completed, filter)
+}
+
+func (s *tracedTaskService) GetAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter, limit, offset int32) (tasks []*db.Task, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.GetAssignedTasks", trace.WithAttributes(attribute.String("tasks.sort", string(filter.Sort))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.GetAssignedTasks(ctx, completed, filter, limit, offset)
+}
+
+func (s *tracedTaskService) CountAssignedTasks(ctx context.Context, completed *bool, filter repository.TaskFilter) (count int64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.CountAssignedTasks")
+	defer func() { tracing.End(span, err) }()
+	return s.next.CountAssignedTasks(ctx, completed, filter)
+}
+
+func (s *tracedTaskService) Details(ctx context.Context, tasks []*db.Task) (details map[int32]TaskDetails, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "TaskService.Details", trace.WithAttributes(attribute.Int("tasks.count", len(tasks))))
+	defer func() { tracing.End(span, err) }()
+	return s.next.Details(ctx, tasks)
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	testBlobStore(t, store)
+}
+
+func TestLocalStoreRejectsKeysOutsideDir(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(filepath.Join(dir, "blobs"))
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	ctx := context.Background()
+
+	for _, key := range []string{"", "../escape", "a/../../escape", "/etc/passwd"} {
+		if err := store.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"); err == nil {
+			t.Errorf("Put(%q) succeeded, want invalid key error", key)
+		}
+		if _, err := store.Open(ctx, key); err == nil {
+			t.Errorf("Open(%q) succeeded, want invalid key error", key)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, "escape")); !os.IsNotExist(err) {
+		t.Fatalf("file outside the storage directory: %v", err)
+	}
+}
+
+func TestLocalStoreDeleteRemovesEmptyDirs(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir)
+	if err != nil {
+		t.Fatalf("NewLocalStore: %v", err)
+	}
+	ctx := context.Background()
+
+	if err := store.Put(ctx, "workspaces/1/tasks/1/a", strings.NewReader("a"), 1, "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Put(ctx, "workspaces/1/tasks/2/b", strings.NewReader("b"), 1, "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	if err := store.Delete(ctx, "workspaces/1/tasks/1/a"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "workspaces", "1", "tasks", "1")); !os.IsNotExist(err) {
+		t.Fatalf("empty key directory is left: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "workspaces", "1", "tasks", "2", "b")); err != nil {
+		t.Fatalf("other key is gone: %v", err)
+	}
+	if _, err := os.Stat(dir); err != nil {
+		t.Fatalf("storage directory is gone: %v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config подключение к S3-совместимому хранилищу (AWS S3, MinIO и т.п.)
+type S3Config struct {
+	// Endpoint адрес без схемы, например s3.amazonaws.com или localhost:9000
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+}
+
+type s3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+// NewS3Store подключается к хранилищу и создает bucket, если его еще нет
+func NewS3Store(ctx context.Context, config S3Config) (BlobStore, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: config.UseSSL,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create S3 client: %w", err)
+	}
+
+	exists, err := client.BucketExists(ctx, config.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("check bucket %s: %w", config.Bucket, err)
+	}
+	if !exists {
+		if err := client.MakeBucket(ctx, config.Bucket, minio.MakeBucketOptions{Region: config.Region}); err != nil {
+			return nil, fmt.Errorf("create bucket %s: %w", config.Bucket, err)
+		}
+	}
+
+	return &s3Store{client: client, bucket: config.Bucket}, nil
+}
+
+// unknownSizePartSize размер части multipart загрузки при неизвестном размере содержимого.
+// Без него клиент рассчитывает части под объект в 5 ТиБ и буферизует сотни мегабайт.
+const unknownSizePartSize = 16 << 20
+
+func (s *s3Store) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	options := minio.PutObjectOptions{ContentType: contentType}
+	if size < 0 {
+		options.PartSize = unknownSizePartSize
+	}
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, options)
+	return err
+}
+
+// Open проверяет наличие объекта сразу: GetObject ленив и иначе сообщил бы об ошибке при первом чтении
+func (s *s3Store) Open(ctx context.Context, key string) (Blob, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := object.Stat(); err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return object, nil
+}
+
+func (s *s3Store) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
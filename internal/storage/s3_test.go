@@ -0,0 +1,267 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestS3Store проверяет хранилище с заглушкой S3 в процессе, а при заданном TEST_S3_ENDPOINT -
+// с настоящим сервисом, например MinIO из docker compose --profile s3:
+// TEST_S3_ENDPOINT=localhost:9000 TEST_S3_ACCESS_KEY=minioadmin TEST_S3_SECRET_KEY=minioadmin
+func TestS3Store(t *testing.T) {
+	config := S3Config{
+		Endpoint:  os.Getenv("TEST_S3_ENDPOINT"),
+		Region:    "us-east-1",
+		Bucket:    fmt.Sprintf("storage-test-%d", time.Now().UnixNano()),
+		AccessKey: os.Getenv("TEST_S3_ACCESS_KEY"),
+		SecretKey: os.Getenv("TEST_S3_SECRET_KEY"),
+	}
+	if config.Endpoint == "" {
+		server := httptest.NewServer(newFakeS3())
+		t.Cleanup(server.Close)
+		config.Endpoint = strings.TrimPrefix(server.URL, "http://")
+		config.AccessKey, config.SecretKey = "test", "test-secret"
+	}
+
+	store, err := NewS3Store(context.Background(), config)
+	if err != nil {
+		t.Fatalf("NewS3Store: %v", err)
+	}
+	// bucket уже есть - подключение не должно пытаться создать его снова
+	if _, err := NewS3Store(context.Background(), config); err != nil {
+		t.Fatalf("NewS3Store with an existing bucket: %v", err)
+	}
+	testBlobStore(t, store)
+}
+
+// fakeS3 S3 API в объеме, который использует s3Store через minio-go: bucket, объекты с Range
+// и multipart загрузка. Подписи запросов не проверяются
+type fakeS3 struct {
+	mu      sync.Mutex
+	buckets map[string]map[string]fakeObject
+	uploads map[string]map[int][]byte
+	nextID  int
+}
+
+type fakeObject struct {
+	data        []byte
+	contentType string
+	modified    time.Time
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		buckets: map[string]map[string]fakeObject{},
+		uploads: map[string]map[int][]byte{},
+	}
+}
+
+func (s *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	query := r.URL.Query()
+	objects, exists := s.buckets[bucket]
+
+	if key == "" {
+		switch r.Method {
+		case http.MethodHead:
+			if !exists {
+				w.WriteHeader(http.StatusNotFound)
+			}
+		case http.MethodPut:
+			if exists {
+				s.error(w, http.StatusConflict, "BucketAlreadyOwnedByYou", bucket, "")
+				return
+			}
+			s.buckets[bucket] = map[string]fakeObject{}
+		default:
+			s.error(w, http.StatusNotImplemented, "NotImplemented", bucket, "")
+		}
+		return
+	}
+	if !exists {
+		s.error(w, http.StatusNotFound, "NoSuchBucket", bucket, key)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextID++
+		id := strconv.Itoa(s.nextID)
+		s.uploads[id] = map[int][]byte{}
+		writeXML(w, struct {
+			XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+			Bucket   string
+			Key      string
+			UploadId string
+		}{Bucket: bucket, Key: key, UploadId: id})
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchUpload", bucket, key)
+			return
+		}
+		data, err := readBody(r)
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "IncompleteBody", bucket, key)
+			return
+		}
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[number] = data
+		w.Header().Set("ETag", etag(data))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchUpload", bucket, key)
+			return
+		}
+		delete(s.uploads, query.Get("uploadId"))
+		numbers := make([]int, 0, len(parts))
+		for number := range parts {
+			numbers = append(numbers, number)
+		}
+		sort.Ints(numbers)
+		var data []byte
+		for _, number := range numbers {
+			data = append(data, parts[number]...)
+		}
+		objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type"), modified: time.Now()}
+		writeXML(w, struct {
+			XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+			Bucket  string
+			Key     string
+			ETag    string
+		}{Bucket: bucket, Key: key, ETag: etag(data)})
+	case r.Method == http.MethodPut:
+		data, err := readBody(r)
+		if err != nil {
+			s.error(w, http.StatusBadRequest, "IncompleteBody", bucket, key)
+			return
+		}
+		objects[key] = fakeObject{data: data, contentType: r.Header.Get("Content-Type"), modified: time.Now()}
+		w.Header().Set("ETag", etag(data))
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		object, ok := objects[key]
+		if !ok {
+			s.error(w, http.StatusNotFound, "NoSuchKey", bucket, key)
+			return
+		}
+		s.serveObject(w, r, object)
+	case r.Method == http.MethodDelete:
+		delete(objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		s.error(w, http.StatusNotImplemented, "NotImplemented", bucket, key)
+	}
+}
+
+// serveObject отдает объект целиком или диапазон из заголовка Range (bytes=a-b, bytes=a-, bytes=-n)
+func (s *fakeS3) serveObject(w http.ResponseWriter, r *http.Request, object fakeObject) {
+	size := int64(len(object.data))
+	w.Header().Set("ETag", etag(object.data))
+	w.Header().Set("Last-Modified", object.modified.UTC().Format(http.TimeFormat))
+	w.Header().Set("Content-Type", object.contentType)
+	w.Header().Set("Accept-Ranges", "bytes")
+
+	start, end := int64(0), size-1
+	status := http.StatusOK
+	if spec, ok := strings.CutPrefix(r.Header.Get("Range"), "bytes="); ok {
+		first, last, _ := strings.Cut(spec, "-")
+		switch {
+		case first == "":
+			n, _ := strconv.ParseInt(last, 10, 64)
+			start = max(size-n, 0)
+		case last == "":
+			start, _ = strconv.ParseInt(first, 10, 64)
+		default:
+			start, _ = strconv.ParseInt(first, 10, 64)
+			end, _ = strconv.ParseInt(last, 10, 64)
+			end = min(end, size-1)
+		}
+		if start >= size {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+			w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+			return
+		}
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end, size))
+		status = http.StatusPartialContent
+	}
+
+	w.Header().Set("Content-Length", strconv.FormatInt(end-start+1, 10))
+	w.WriteHeader(status)
+	if r.Method == http.MethodGet {
+		w.Write(object.data[start : end+1])
+	}
+}
+
+func (s *fakeS3) error(w http.ResponseWriter, status int, code, bucket, key string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	xml.NewEncoder(w).Encode(struct {
+		XMLName    xml.Name `xml:"Error"`
+		Code       string
+		Message    string
+		BucketName string
+		Key        string
+		RequestId  string
+	}{Code: code, Message: code, BucketName: bucket, Key: key, RequestId: "fake"})
+}
+
+func writeXML(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/xml")
+	xml.NewEncoder(w).Encode(v)
+}
+
+func etag(data []byte) string {
+	sum := md5.Sum(data)
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// readBody тело запроса; при потоковой подписи (aws-chunked) - без служебной разметки чанков
+func readBody(r *http.Request) ([]byte, error) {
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	// чанк: <размер hex>;chunk-signature=<подпись>\r\n<данные>\r\n; последний чанк пустой,
+	// за ним могут идти заголовки-трейлеры
+	var data bytes.Buffer
+	body := bufio.NewReader(r.Body)
+	for {
+		line, err := body.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("chunk size %q: %w", sizeHex, err)
+		}
+		if size == 0 {
+			return data.Bytes(), nil
+		}
+		if _, err := io.CopyN(&data, body, size); err != nil {
+			return nil, err
+		}
+		if _, err := body.Discard(2); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,27 @@
+// Package storage хранилища содержимого вложений: локальный диск и S3-совместимые сервисы.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound содержимого с таким ключом нет в хранилище
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore хранилище содержимого по ключу. Ключи выдает вызывающий код (пути вида a/b/c);
+// хранилище их не интерпретирует.
+type BlobStore interface {
+	// Put сохраняет содержимое r; size == -1 - размер заранее неизвестен
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Open открывает содержимое для чтения с произвольной позиции (для HTTP Range)
+	Open(ctx context.Context, key string) (Blob, error)
+	// Delete удаляет содержимое; отсутствие ключа ошибкой не считается
+	Delete(ctx context.Context, key string) error
+}
+
+// Blob открытое содержимое
+type Blob interface {
+	io.ReadSeekCloser
+}
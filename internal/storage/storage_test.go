@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// testBlobStore общие для всех хранилищ проверки BlobStore
+func testBlobStore(t *testing.T, store BlobStore) {
+	ctx := context.Background()
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	read := func(t *testing.T, key string) []byte {
+		t.Helper()
+		blob, err := store.Open(ctx, key)
+		if err != nil {
+			t.Fatalf("Open(%q): %v", key, err)
+		}
+		defer blob.Close()
+		data, err := io.ReadAll(blob)
+		if err != nil {
+			t.Fatalf("read %q: %v", key, err)
+		}
+		return data
+	}
+
+	t.Run("PutOpen", func(t *testing.T) {
+		key := "workspaces/1/tasks/1/known"
+		if err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		if got := read(t, key); !bytes.Equal(got, content) {
+			t.Fatalf("content = %q, want %q", got, content)
+		}
+	})
+
+	t.Run("PutUnknownSize", func(t *testing.T) {
+		key := "workspaces/1/tasks/1/unknown"
+		// без Len клиент не узнает размер из ридера
+		r := io.MultiReader(strings.NewReader(string(content)))
+		if err := store.Put(ctx, key, r, -1, "text/plain"); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		if got := read(t, key); !bytes.Equal(got, content) {
+			t.Fatalf("content = %q, want %q", got, content)
+		}
+	})
+
+	t.Run("Overwrite", func(t *testing.T) {
+		key := "workspaces/1/tasks/1/overwrite"
+		for _, data := range []string{"first version", "second"} {
+			if err := store.Put(ctx, key, strings.NewReader(data), int64(len(data)), "text/plain"); err != nil {
+				t.Fatalf("Put: %v", err)
+			}
+		}
+		if got := read(t, key); string(got) != "second" {
+			t.Fatalf("content = %q, want %q", got, "second")
+		}
+	})
+
+	t.Run("Range", func(t *testing.T) {
+		key := "workspaces/1/tasks/1/range"
+		if err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		blob, err := store.Open(ctx, key)
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+		defer blob.Close()
+
+		ranges := []struct {
+			offset int64
+			whence int
+			length int
+			want   string
+		}{
+			{offset: 10, whence: io.SeekStart, length: 6, want: "abcdef"},
+			{offset: -4, whence: io.SeekEnd, length: 4, want: "wxyz"},
+			{offset: 0, whence: io.SeekStart, length: 3, want: "012"},
+			{offset: 2, whence: io.SeekCurrent, length: 2, want: "56"},
+		}
+		for _, r := range ranges {
+			if _, err := blob.Seek(r.offset, r.whence); err != nil {
+				t.Fatalf("Seek(%d, %d): %v", r.offset, r.whence, err)
+			}
+			buf := make([]byte, r.length)
+			if _, err := io.ReadFull(blob, buf); err != nil {
+				t.Fatalf("read after Seek(%d, %d): %v", r.offset, r.whence, err)
+			}
+			if string(buf) != r.want {
+				t.Fatalf("read after Seek(%d, %d) = %q, want %q", r.offset, r.whence, buf, r.want)
+			}
+		}
+		size, err := blob.Seek(0, io.SeekEnd)
+		if err != nil || size != int64(len(content)) {
+			t.Fatalf("Seek to end = %d, %v; want %d", size, err, len(content))
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		key := "workspaces/1/tasks/2/deleted"
+		if err := store.Put(ctx, key, bytes.NewReader(content), int64(len(content)), "text/plain"); err != nil {
+			t.Fatalf("Put: %v", err)
+		}
+		if err := store.Delete(ctx, key); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := store.Open(ctx, key); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Open after Delete: err = %v, want ErrNotFound", err)
+		}
+		if err := store.Delete(ctx, key); err != nil {
+			t.Fatalf("Delete of a missing key: %v", err)
+		}
+	})
+
+	t.Run("OpenMissing", func(t *testing.T) {
+		if _, err := store.Open(ctx, "workspaces/1/tasks/3/missing"); !errors.Is(err, ErrNotFound) {
+			t.Fatalf("Open: err = %v, want ErrNotFound", err)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+// Package tenant хранит в контексте запроса рабочее пространство, данными которого он оперирует.
+// По нему internal/db устанавливает app.workspace_id, на которой основаны политики RLS.
+package tenant
+
+import "context"
+
+// DefaultWorkspaceID пространство анонимных клиентов и токенов без workspace_id (создается миграцией)
+const DefaultWorkspaceID int32 = 1
+
+type workspaceKey struct{}
+
+type allWorkspacesKey struct{}
+
+// WithWorkspace ограничивает запросы к БД пространством id
+func WithWorkspace(ctx context.Context, id int32) context.Context {
+	return context.WithValue(ctx, workspaceKey{}, id)
+}
+
+// WorkspaceFrom возвращает пространство запроса; ok == false - пространство не задано,
+// и запросы к БД не видят данных ни одного пространства
+func WorkspaceFrom(ctx context.Context) (int32, bool) {
+	id, ok := ctx.Value(workspaceKey{}).(int32)
+	return id, ok
+}
+
+// WithAllWorkspaces снимает изоляцию для системных операций, которым нужны данные всех
+// пространств: метрики, поиск API ключа при аутентификации. Не используется для запросов клиентов.
+func WithAllWorkspaces(ctx context.Context) context.Context {
+	return context.WithValue(ctx, allWorkspacesKey{}, true)
+}
+
+// AllWorkspaces операция видит все пространства
+func AllWorkspaces(ctx context.Context) bool {
+	all, _ := ctx.Value(allWorkspacesKey{}).(bool)
+	return all
+}
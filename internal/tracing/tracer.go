@@ -0,0 +1,70 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"GreatProject/internal/logging"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// QueryTracer открывает клиентский span на каждый SQL запрос (pgx.QueryTracer и pgx.BatchTracer)
+type QueryTracer struct{}
+
+func NewQueryTracer() *QueryTracer {
+	return &QueryTracer{}
+}
+
+func (t *QueryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = startQuery(ctx, data.SQL)
+	return ctx
+}
+
+func (t *QueryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	End(span, data.Err)
+}
+
+type batchStartKey struct{}
+
+// batchStart момент, с которого начинается span следующего запроса пакета
+type batchStart struct {
+	last time.Time
+}
+
+func (t *QueryTracer) TraceBatchStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceBatchStartData) context.Context {
+	return context.WithValue(ctx, batchStartKey{}, &batchStart{last: time.Now()})
+}
+
+// TraceBatchQuery запросы пакета выполняются друг за другом, поэтому span запроса начинается
+// с окончания предыдущего
+func (t *QueryTracer) TraceBatchQuery(ctx context.Context, _ *pgx.Conn, data pgx.TraceBatchQueryData) {
+	start, ok := ctx.Value(batchStartKey{}).(*batchStart)
+	if !ok {
+		return
+	}
+	_, span := startQuery(ctx, data.SQL, trace.WithTimestamp(start.last))
+	span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	End(span, data.Err)
+	start.last = time.Now()
+}
+
+func (t *QueryTracer) TraceBatchEnd(context.Context, *pgx.Conn, pgx.TraceBatchEndData) {}
+
+func startQuery(ctx context.Context, sql string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	name := logging.QueryName(sql)
+	opts = append(opts,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(name),
+			semconv.DBQueryText(sql),
+		),
+	)
+	return Tracer().Start(ctx, "db "+name, opts...)
+}
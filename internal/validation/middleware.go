@@ -0,0 +1,228 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strings"
+
+	"GreatProject/internal/apperrors"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/labstack/echo/v4"
+)
+
+// MiddlewareConfig настройки проверки запросов и ответов по спецификации
+type MiddlewareConfig struct {
+	// ValidateResponses включает проверку ответов (для dev окружения): ответ буферизуется,
+	// и при расхождении со спецификацией клиент получает 500 с описанием нарушения.
+	ValidateResponses bool
+}
+
+// Middleware проверяет запросы (а при необходимости и ответы) маршрутов, описанных в спецификации.
+// Маршруты вне спецификации (/swagger, /openapi.yml и т.п.) пропускаются.
+func (v *Validator) Middleware(config MiddlewareConfig) echo.MiddlewareFunc {
+	options := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+	multipartOptions := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		ExcludeRequestBody: true,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			route := v.route(c)
+			if route == nil {
+				return next(c)
+			}
+
+			pathParams := make(map[string]string, len(c.ParamNames()))
+			for i, name := range c.ParamNames() {
+				pathParams[name] = c.ParamValues()[i]
+			}
+
+			requestInput := &openapi3filter.RequestValidationInput{
+				Request:    c.Request(),
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if isMultipart(c.Request()) {
+				// kin-openapi читает multipart тело в память целиком; файлы проверяет обработчик
+				requestInput.Options = multipartOptions
+			}
+			if err := openapi3filter.ValidateRequest(c.Request().Context(), requestInput); err != nil {
+				return requestError(err)
+			}
+
+			if !config.ValidateResponses || streamsBody(route.Operation) {
+				return next(c)
+			}
+
+			return v.validateResponse(c, next, requestInput)
+		}
+	}
+}
+
+// validateResponse выполняет обработчик с буферизацией ответа и сверяет ответ со спецификацией
+func (v *Validator) validateResponse(c echo.Context, next echo.HandlerFunc, requestInput *openapi3filter.RequestValidationInput) error {
+	original := c.Response().Writer
+	buffer := &bufferedWriter{ResponseWriter: original, status: http.StatusOK}
+	c.Response().Writer = buffer
+	defer func() {
+		c.Response().Writer = original
+	}()
+
+	// ответ с ошибкой пишется в буфер здесь, а сама ошибка возвращается дальше для журнала и метрик
+	handlerErr := next(c)
+	if handlerErr != nil {
+		c.Error(handlerErr)
+	}
+
+	responseInput := &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: requestInput,
+		Status:                 buffer.status,
+		Header:                 original.Header(),
+		Options:                requestInput.Options,
+	}
+	responseInput.SetBodyBytes(buffer.body.Bytes())
+
+	if err := openapi3filter.ValidateResponse(c.Request().Context(), responseInput); err != nil {
+		slog.ErrorContext(c.Request().Context(), "response does not match OpenAPI spec",
+			slog.String("method", c.Request().Method),
+			slog.String("route", c.Path()),
+			slog.Int("status", buffer.status),
+			slog.String("error", err.Error()),
+		)
+
+		original.Header().Del(echo.HeaderContentLength)
+		original.Header().Set(echo.HeaderContentType, "application/problem+json")
+		original.WriteHeader(http.StatusInternalServerError)
+		_, writeErr := original.Write([]byte(`{"type":"about:blank","title":"Internal Server Error","status":500,` +
+			`"error":"Internal Server Error","code":"RESPONSE_VALIDATION_ERROR","message":"Response does not match API specification"}`))
+		return firstError(handlerErr, writeErr)
+	}
+
+	original.WriteHeader(buffer.status)
+	_, writeErr := original.Write(buffer.body.Bytes())
+	return firstError(handlerErr, writeErr)
+}
+
+// firstError ошибка обработчика, а без нее - ошибка записи ответа
+func firstError(handlerErr, writeErr error) error {
+	if handlerErr != nil {
+		return handlerErr
+	}
+	return writeErr
+}
+
+// route строит маршрут kin-openapi по маршруту, уже найденному роутером Echo
+func (v *Validator) route(c echo.Context) *routers.Route {
+	if v.spec.Paths == nil {
+		return nil
+	}
+
+	path := toOpenAPIPath(c.Path())
+	pathItem := v.spec.Paths.Find(path)
+	if pathItem == nil {
+		return nil
+	}
+
+	operation := pathItem.GetOperation(c.Request().Method)
+	if operation == nil {
+		return nil
+	}
+
+	return &routers.Route{
+		Spec:      v.spec,
+		Path:      path,
+		PathItem:  pathItem,
+		Method:    c.Request().Method,
+		Operation: operation,
+	}
+}
+
+func isMultipart(r *http.Request) bool {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get(echo.HeaderContentType))
+	return strings.HasPrefix(mediaType, "multipart/")
+}
+
+// streamsBody успешный ответ операции - не JSON (например, содержимое файла), и буферизовать его
+// для проверки нельзя
+func streamsBody(operation *openapi3.Operation) bool {
+	response := operation.Responses.Status(http.StatusOK)
+	if response == nil || response.Value == nil || len(response.Value.Content) == 0 {
+		return false
+	}
+	// Content.Get подставила бы */* вместо отсутствующего application/json
+	_, ok := response.Value.Content[echo.MIMEApplicationJSON]
+	return !ok
+}
+
+// requestError переводит ошибки openapi3filter в ValidationError с путями полей
+func requestError(err error) error {
+	violations := make(map[string]string)
+	collectRequestError(violations, err)
+	if len(violations) == 0 {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+	}
+	return &apperrors.ValidationError{Fields: violations}
+}
+
+func collectRequestError(violations map[string]string, err error) {
+	// RequestError разворачивается в MultiError своих SchemaError, поэтому проверяется первым,
+	// иначе потеряется имя параметра
+	requestErr, ok := err.(*openapi3filter.RequestError)
+	if !ok {
+		var multi openapi3.MultiError
+		if errors.As(err, &multi) {
+			for _, e := range multi {
+				collectRequestError(violations, e)
+			}
+			return
+		}
+		if !errors.As(err, &requestErr) {
+			violations["body"] = err.Error()
+			return
+		}
+	}
+
+	switch {
+	case requestErr.Parameter != nil:
+		if requestErr.Reason != "" {
+			violations[requestErr.Parameter.Name] = requestErr.Reason
+			return
+		}
+		collect(violations, requestErr.Parameter.Name, requestErr.Err)
+	case requestErr.RequestBody != nil:
+		if requestErr.Err == nil || errors.Is(requestErr.Err, openapi3filter.ErrInvalidRequired) {
+			violations["body"] = "is required"
+			return
+		}
+		collect(violations, "", requestErr.Err)
+	default:
+		violations["body"] = requestErr.Error()
+	}
+}
+
+// bufferedWriter накапливает ответ обработчика до проверки по спецификации
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
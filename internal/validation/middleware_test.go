@@ -0,0 +1,139 @@
+package validation
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"GreatProject/internal/apperrors"
+	"GreatProject/internal/generated"
+
+	"github.com/labstack/echo/v4"
+)
+
+// newValidatedServer маршруты спецификации с проверкой запросов; обработчики ничего не делают,
+// а ошибка middleware сохраняется в *err
+func newValidatedServer(t *testing.T, err *error) *echo.Echo {
+	t.Helper()
+	spec, loadErr := generated.GetSwagger()
+	if loadErr != nil {
+		t.Fatalf("load spec: %v", loadErr)
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = func(handlerErr error, c echo.Context) {
+		*err = handlerErr
+		c.NoContent(http.StatusBadRequest)
+	}
+	e.Use(New(spec).Middleware(MiddlewareConfig{}))
+	noContent := func(c echo.Context) error { return c.NoContent(http.StatusNoContent) }
+	e.GET("/tasks", noContent)
+	e.POST("/tasks", noContent)
+	e.GET("/reports/timesheet", noContent)
+	return e
+}
+
+// Нарушение указывает на параметр, а в массивах параметров и тела - на элемент: <имя>.<индекс>
+func TestMiddlewareReportsFieldPaths(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *http.Request
+		field string
+	}{
+		{
+			name:  "integer query parameter",
+			req:   httptest.NewRequest(http.MethodGet, "/tasks?limit=abc", nil),
+			field: "limit",
+		},
+		{
+			name:  "query parameter pattern",
+			req:   httptest.NewRequest(http.MethodGet, "/tasks?sort=name", nil),
+			field: "sort",
+		},
+		{
+			name:  "repeated query parameter",
+			req:   httptest.NewRequest(http.MethodGet, "/tasks?field=priority:high&field=BAD", nil),
+			field: "field.1",
+		},
+		{
+			name:  "comma separated query parameter",
+			req:   httptest.NewRequest(http.MethodGet, "/reports/timesheet?from=2026-01-01&to=2026-01-31&group_by=day,week", nil),
+			field: "group_by.1",
+		},
+		{
+			name:  "body array",
+			req:   jsonRequest(http.MethodPost, "/tasks", `{"name":"task","description":"","tags":["ok",""]}`),
+			field: "tags.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var err error
+			newValidatedServer(t, &err).ServeHTTP(httptest.NewRecorder(), tt.req)
+
+			var validation *apperrors.ValidationError
+			if !errors.As(err, &validation) {
+				t.Fatalf("err = %v, want validation error", err)
+			}
+			if _, ok := validation.Fields[tt.field]; !ok || len(validation.Fields) != 1 {
+				t.Fatalf("fields = %v, want only %s", validation.Fields, tt.field)
+			}
+		})
+	}
+}
+
+func TestMiddlewareAcceptsValidArrays(t *testing.T) {
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/tasks?field=priority:high&field=size:l", nil),
+		httptest.NewRequest(http.MethodGet, "/reports/timesheet?from=2026-01-01&to=2026-01-31&group_by=day,user", nil),
+		jsonRequest(http.MethodPost, "/tasks", `{"name":"task","description":"","tags":["ok","fine"]}`),
+	}
+	for _, req := range requests {
+		var err error
+		rec := httptest.NewRecorder()
+		newValidatedServer(t, &err).ServeHTTP(rec, req)
+		if err != nil || rec.Code != http.StatusNoContent {
+			t.Errorf("%s %s: status %d, err = %v; want 204", req.Method, req.URL, rec.Code, err)
+		}
+	}
+}
+
+func jsonRequest(method, target, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	return req
+}
+
+// В режиме проверки ответов ошибка обработчика, как и без него, доходит до внешних middleware
+// (журнал запросов, метрики), а клиент получает ответ из HTTPErrorHandler
+func TestValidateResponsesReturnsHandlerError(t *testing.T) {
+	spec, err := generated.GetSwagger()
+	if err != nil {
+		t.Fatalf("load spec: %v", err)
+	}
+	cause := errors.New("database is down")
+
+	e := echo.New()
+	var outer error
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			outer = next(c)
+			return outer
+		}
+	})
+	e.Use(New(spec).Middleware(MiddlewareConfig{ValidateResponses: true}))
+	e.GET("/tasks", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Internal Server Error").SetInternal(cause)
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/tasks", nil))
+	if !errors.Is(outer, cause) {
+		t.Fatalf("error outside the middleware = %v, want the handler error", outer)
+	}
+	if rec.Code != http.StatusInternalServerError {
+		t.Fatalf("status = %d, want 500", rec.Code)
+	}
+}
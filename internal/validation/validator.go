@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Validator проверяет запросы и ответы по OpenAPI спецификации (см. Middleware) и возвращает
+// сразу все нарушения в виде *apperrors.ValidationError.
+type Validator struct {
+	spec *openapi3.T
+}
+
+func New(spec *openapi3.T) *Validator {
+	return &Validator{
+		spec: spec,
+	}
+}
+
+// collect раскладывает ошибки kin-openapi по путям полей
+func collect(violations map[string]string, prefix string, err error) {
+	if err == nil {
+		return
+	}
+
+	var multi openapi3.MultiError
+	if errors.As(err, &multi) {
+		for _, e := range multi {
+			collect(violations, prefix, e)
+		}
+		return
+	}
+
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		violations[fieldPath(prefix, nil)] = err.Error()
+		return
+	}
+
+	field := fieldPath(prefix, schemaErr.JSONPointer())
+	if _, exists := violations[field]; exists {
+		return
+	}
+
+	if schemaErr.SchemaField == "required" {
+		violations[field] = "is required"
+	} else {
+		violations[field] = schemaErr.Reason
+	}
+}
+
+func fieldPath(prefix string, pointer []string) string {
+	parts := make([]string, 0, len(pointer)+1)
+	if prefix != "" {
+		parts = append(parts, prefix)
+	}
+	parts = append(parts, pointer...)
+	if len(parts) == 0 {
+		return "body"
+	}
+	return strings.Join(parts, ".")
+}
+
+// toOpenAPIPath превращает "/tasks/:id" в "/tasks/{id}"
+func toOpenAPIPath(echoPath string) string {
+	segments := strings.Split(echoPath, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -24,11 +24,15 @@ oapi-codegen -package generated -generate server api\openapi.yml > internal\gene
 echo 📝 Генерируем клиент...
 oapi-codegen -package generated -generate client api\openapi.yml > internal\generated\client.go
 
+echo 📝 Встраиваем спецификацию...
+oapi-codegen -package generated -generate spec api\openapi.yml > internal\generated\spec.go
+
 echo ✅ Генерация завершена!
 echo 📁 Сгенерированные файлы:
 echo    - internal\generated\types.go (модели данных)
 echo    - internal\generated\server.go (интерфейсы сервера)
 echo    - internal\generated\client.go (HTTP клиент)
+echo    - internal\generated\spec.go (встроенная спецификация для валидации)
 
 echo.
 echo 🚀 Следующие шаги:
@@ -0,0 +1,27 @@
+-- name: CreateTaskEvent :exec
+INSERT INTO task_events (task_id, actor_id, type)
+VALUES ($1, $2, $3);
+
+-- name: CreateTaskSubjectEvent :exec
+-- Событие, касающееся пользователя @subject_id (назначение или снятие исполнителя)
+INSERT INTO task_events (task_id, actor_id, type, subject_id)
+VALUES (@task_id, @actor_id, @type, @subject_id);
+
+-- name: ListTaskActivity :many
+-- Лента задачи: комментарии и события вперемешку, новые сначала
+SELECT kind, id, actor_id, subject_id, body, created_at
+FROM (
+    SELECT type AS kind, id, actor_id, subject_id, NULL::TEXT AS body, created_at
+    FROM task_events
+    WHERE task_id = @task_id
+    UNION ALL
+    SELECT 'comment', id, author_id, NULL::INTEGER, body, created_at
+    FROM task_comments
+    WHERE task_id = @task_id
+) activity
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountTaskActivity :one
+SELECT ((SELECT COUNT(*) FROM task_comments c WHERE c.task_id = @task_id)
+     + (SELECT COUNT(*) FROM task_events e WHERE e.task_id = @task_id))::BIGINT AS count;
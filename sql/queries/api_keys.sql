@@ -0,0 +1,34 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (user_id, name, prefix, key_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, name, prefix, key_hash, scopes, expires_at, revoked_at, last_used_at, last_used_ip, created_at, workspace_id;
+
+-- name: ListAPIKeysByUser :many
+SELECT id, user_id, name, prefix, key_hash, scopes, expires_at, revoked_at, last_used_at, last_used_ip, created_at, workspace_id
+FROM api_keys
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetAPIKeyByHash :one
+SELECT id, user_id, name, prefix, key_hash, scopes, expires_at, revoked_at, last_used_at, last_used_ip, created_at, workspace_id
+FROM api_keys
+WHERE key_hash = $1;
+
+-- name: RevokeAPIKey :execrows
+-- Повторный отзыв не меняет время первого
+UPDATE api_keys
+SET revoked_at = COALESCE(revoked_at, now())
+WHERE id = $1 AND user_id = $2;
+
+-- name: RotateAPIKey :one
+-- Заменяет секрет ключа, сохраняя имя, права и срок действия; старый секрет сразу перестает действовать
+UPDATE api_keys
+SET prefix = $3, key_hash = $4, last_used_at = NULL, last_used_ip = NULL
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+RETURNING id, user_id, name, prefix, key_hash, scopes, expires_at, revoked_at, last_used_at, last_used_ip, created_at, workspace_id;
+
+-- name: TouchAPIKey :exec
+-- Обновляет время и адрес последнего использования не чаще раза в минуту, чтобы не писать в БД на каждый запрос
+UPDATE api_keys
+SET last_used_at = now(), last_used_ip = $2
+WHERE id = $1 AND (last_used_at IS NULL OR last_used_at < now() - interval '1 minute' OR last_used_ip IS DISTINCT FROM $2);
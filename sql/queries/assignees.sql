@@ -0,0 +1,59 @@
+-- name: ListTaskAssignees :many
+SELECT task_id, user_id, assigned_by, created_at, workspace_id
+FROM task_assignees
+WHERE task_id = ANY(@task_ids::INTEGER[])
+ORDER BY task_id, created_at, user_id;
+
+-- name: AddTaskAssignees :many
+-- Назначает пользователей; возвращает только тех, кто не был назначен раньше
+INSERT INTO task_assignees (task_id, user_id, assigned_by)
+SELECT @task_id, user_id, @assigned_by
+FROM unnest(@user_ids::INTEGER[]) AS user_id
+ON CONFLICT DO NOTHING
+RETURNING user_id;
+
+-- name: RemoveOtherTaskAssignees :many
+-- Снимает всех исполнителей, кроме @user_ids; возвращает снятых
+DELETE FROM task_assignees
+WHERE task_id = @task_id AND NOT (user_id = ANY(@user_ids::INTEGER[]))
+RETURNING user_id;
+
+-- name: ListNonMembers :many
+-- Пользователи из @user_ids, не состоящие в проекте
+SELECT candidate.id::INTEGER AS user_id
+FROM unnest(@user_ids::INTEGER[]) AS candidate(id)
+WHERE NOT EXISTS (
+    SELECT 1 FROM project_members m WHERE m.project_id = @project_id AND m.user_id = candidate.id
+)
+ORDER BY candidate.id;
+
+-- name: ListAssignedTasks :many
+-- Видимые пользователю задачи, на которые он назначен; completed NULL - в любом статусе.
+-- Фильтр по пользовательским полям и порядок - как в ListTasks
+SELECT t.id, t.name, t.description, t.completed, t.created_at, t.updated_at, t.owner_id, t.project_id, t.workspace_id, t.position, t.custom_fields, t.estimate_minutes, t.due_at, t.parent_id, t.tags, t.milestone_id, t.completed_at
+FROM tasks t
+JOIN task_assignees a ON a.task_id = t.id AND a.user_id = @user_id
+WHERE (sqlc.narg('completed')::BOOLEAN IS NULL OR COALESCE(t.completed, false) = sqlc.narg('completed'))
+  AND ((t.project_id IS NULL AND (t.owner_id IS NULL OR t.owner_id = @user_id))
+   OR t.project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest(@field_keys::TEXT[], @field_values::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(t.custom_fields ->> f.key = f.value OR t.custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+ORDER BY CASE WHEN @sort::TEXT = 'position' THEN t.position END,
+         CASE WHEN @sort::TEXT = 'field' THEN t.custom_fields -> @sort_field::TEXT END,
+         CASE WHEN @sort::TEXT = '-field' THEN t.custom_fields -> @sort_field::TEXT END DESC NULLS LAST,
+         t.created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAssignedTasks :one
+SELECT COUNT(*)
+FROM tasks t
+JOIN task_assignees a ON a.task_id = t.id AND a.user_id = @user_id
+WHERE (sqlc.narg('completed')::BOOLEAN IS NULL OR COALESCE(t.completed, false) = sqlc.narg('completed'))
+  AND ((t.project_id IS NULL AND (t.owner_id IS NULL OR t.owner_id = @user_id))
+   OR t.project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest(@field_keys::TEXT[], @field_values::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(t.custom_fields ->> f.key = f.value OR t.custom_fields -> f.key @> to_jsonb(f.value), false)
+  );
@@ -0,0 +1,29 @@
+-- name: CreateTaskAttachment :one
+INSERT INTO task_attachments (task_id, filename, content_type, size, checksum, storage_key, uploaded_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, task_id, filename, content_type, size, checksum, storage_key, uploaded_by, created_at, workspace_id;
+
+-- name: GetTaskAttachment :one
+SELECT id, task_id, filename, content_type, size, checksum, storage_key, uploaded_by, created_at, workspace_id
+FROM task_attachments
+WHERE id = $1;
+
+-- name: ListTaskAttachments :many
+SELECT id, task_id, filename, content_type, size, checksum, storage_key, uploaded_by, created_at, workspace_id
+FROM task_attachments
+WHERE task_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteTaskAttachment :execrows
+DELETE FROM task_attachments
+WHERE id = $1;
+
+-- name: ListBlobDeletions :many
+SELECT storage_key
+FROM blob_deletions
+ORDER BY created_at
+LIMIT $1;
+
+-- name: DeleteBlobDeletion :exec
+DELETE FROM blob_deletions
+WHERE storage_key = $1;
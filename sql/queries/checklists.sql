@@ -0,0 +1,54 @@
+-- name: ListChecklistItems :many
+SELECT id, task_id, text, checked, position, created_at, updated_at, workspace_id
+FROM task_checklist_items
+WHERE task_id = $1
+ORDER BY position, id;
+
+-- name: GetChecklistItem :one
+SELECT id, task_id, text, checked, position, created_at, updated_at, workspace_id
+FROM task_checklist_items
+WHERE id = $1;
+
+-- name: CreateChecklistItem :one
+-- Новый пункт добавляется в конец чек-листа
+INSERT INTO task_checklist_items (task_id, text, checked, position)
+SELECT @task_id::INTEGER, @text::VARCHAR, @checked::BOOLEAN, COALESCE(MAX(position), 0) + 1
+FROM task_checklist_items
+WHERE task_id = @task_id
+RETURNING id, task_id, text, checked, position, created_at, updated_at, workspace_id;
+
+-- name: UpdateChecklistItem :one
+UPDATE task_checklist_items
+SET text = $2, checked = $3, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING id, task_id, text, checked, position, created_at, updated_at, workspace_id;
+
+-- name: DeleteChecklistItem :execrows
+DELETE FROM task_checklist_items
+WHERE id = $1;
+
+-- name: ReorderChecklistItems :execrows
+-- Позиция пункта - его номер в item_ids
+UPDATE task_checklist_items i
+SET position = o.position, updated_at = CURRENT_TIMESTAMP
+FROM unnest(@item_ids::INTEGER[]) WITH ORDINALITY AS o(id, position)
+WHERE i.id = o.id AND i.task_id = @task_id;
+
+-- name: CountChecklistProgress :many
+SELECT task_id, COUNT(*)::INTEGER AS total, (COUNT(*) FILTER (WHERE checked))::INTEGER AS checked
+FROM task_checklist_items
+WHERE task_id = ANY(@task_ids::INTEGER[])
+GROUP BY task_id;
+
+-- name: ConvertChecklistItem :one
+-- Пункт превращается в задачу одной командой: пункт удаляется, задача создается в проекте родительской
+WITH item AS (
+    DELETE FROM task_checklist_items
+    WHERE id = @id
+    RETURNING task_id, text, checked
+)
+INSERT INTO tasks (name, description, completed, completed_at, owner_id, project_id)
+SELECT item.text, '', item.checked, CASE WHEN item.checked THEN now() END, @owner_id, parent.project_id
+FROM item
+JOIN tasks parent ON parent.id = item.task_id
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
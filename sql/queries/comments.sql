@@ -0,0 +1,40 @@
+-- name: CreateTaskComment :one
+INSERT INTO task_comments (task_id, author_id, body, mentions)
+VALUES ($1, $2, $3, $4)
+RETURNING id, task_id, author_id, body, mentions, created_at, edited_at, workspace_id;
+
+-- name: GetTaskComment :one
+SELECT id, task_id, author_id, body, mentions, created_at, edited_at, workspace_id
+FROM task_comments
+WHERE id = $1;
+
+-- name: ListTaskComments :many
+SELECT id, task_id, author_id, body, mentions, created_at, edited_at, workspace_id
+FROM task_comments
+WHERE task_id = $1
+ORDER BY created_at, id
+LIMIT $2 OFFSET $3;
+
+-- name: CountTaskComments :one
+SELECT COUNT(*) FROM task_comments WHERE task_id = $1;
+
+-- name: UpdateTaskComment :one
+-- Заменяемый текст сохраняется в истории той же командой
+WITH previous AS (
+    INSERT INTO task_comment_revisions (comment_id, body)
+    SELECT id, body FROM task_comments WHERE id = @id
+)
+UPDATE task_comments
+SET body = @body, mentions = @mentions, edited_at = CURRENT_TIMESTAMP
+WHERE id = @id
+RETURNING id, task_id, author_id, body, mentions, created_at, edited_at, workspace_id;
+
+-- name: DeleteTaskComment :execrows
+DELETE FROM task_comments
+WHERE id = $1;
+
+-- name: ListTaskCommentRevisions :many
+SELECT id, comment_id, body, created_at, workspace_id
+FROM task_comment_revisions
+WHERE comment_id = $1
+ORDER BY created_at, id;
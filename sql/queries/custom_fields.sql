@@ -0,0 +1,39 @@
+-- name: ListCustomFields :many
+SELECT id, project_id, key, name, type, options, required, created_at, workspace_id
+FROM project_custom_fields
+WHERE project_id = $1
+ORDER BY id;
+
+-- name: GetCustomField :one
+SELECT id, project_id, key, name, type, options, required, created_at, workspace_id
+FROM project_custom_fields
+WHERE id = $1;
+
+-- name: CreateCustomField :one
+INSERT INTO project_custom_fields (project_id, key, name, type, options, required)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, project_id, key, name, type, options, required, created_at, workspace_id;
+
+-- name: UpdateCustomField :one
+UPDATE project_custom_fields
+SET name = $2, options = $3, required = $4
+WHERE id = $1
+RETURNING id, project_id, key, name, type, options, required, created_at, workspace_id;
+
+-- name: DeleteCustomField :exec
+-- Удаляет поле и его значения из задач проекта
+WITH field AS (
+    DELETE FROM project_custom_fields
+    WHERE id = $1
+    RETURNING project_id, key
+)
+UPDATE tasks
+SET custom_fields = tasks.custom_fields - field.key
+FROM field
+WHERE tasks.project_id = field.project_id AND tasks.custom_fields -> field.key IS NOT NULL;
+
+-- name: CountTasksWithFieldValue :one
+-- Задачи проекта, у которых поле @key равно @value (или, для multi_select, содержит его)
+SELECT COUNT(*)
+FROM tasks
+WHERE project_id = @project_id AND custom_fields -> @key::TEXT @> to_jsonb(@value::TEXT);
@@ -0,0 +1,52 @@
+-- name: AddTaskDependency :one
+INSERT INTO task_dependencies (task_id, blocker_id)
+VALUES ($1, $2)
+RETURNING task_id, blocker_id, created_at, workspace_id;
+
+-- name: DeleteTaskDependency :execrows
+DELETE FROM task_dependencies
+WHERE task_id = $1 AND blocker_id = $2;
+
+-- name: ListTaskBlockers :many
+-- Задачи, которые блокируют задачу
+SELECT t.id, t.name, t.description, t.completed, t.created_at, t.updated_at, t.owner_id, t.project_id, t.workspace_id, t.position, t.custom_fields, t.estimate_minutes, t.due_at, t.parent_id, t.tags, t.milestone_id, t.completed_at
+FROM tasks t
+JOIN task_dependencies d ON d.blocker_id = t.id
+WHERE d.task_id = $1
+ORDER BY t.id;
+
+-- name: ListTaskDependents :many
+-- Задачи, которые ждут задачу
+SELECT t.id, t.name, t.description, t.completed, t.created_at, t.updated_at, t.owner_id, t.project_id, t.workspace_id, t.position, t.custom_fields, t.estimate_minutes, t.due_at, t.parent_id, t.tags, t.milestone_id, t.completed_at
+FROM tasks t
+JOIN task_dependencies d ON d.task_id = t.id
+WHERE d.blocker_id = $1
+ORDER BY t.id;
+
+-- name: ListOpenBlockerIDs :many
+SELECT d.blocker_id
+FROM task_dependencies d
+JOIN tasks b ON b.id = d.blocker_id
+WHERE d.task_id = $1 AND NOT COALESCE(b.completed, false)
+ORDER BY d.blocker_id;
+
+-- name: ListBlockedTaskIDs :many
+-- Задачи из списка, у которых есть невыполненные блокирующие задачи
+SELECT DISTINCT d.task_id
+FROM task_dependencies d
+JOIN tasks b ON b.id = d.blocker_id
+WHERE d.task_id = ANY(@task_ids::INTEGER[]) AND NOT COALESCE(b.completed, false);
+
+-- name: ListProjectTasks :many
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
+FROM tasks
+WHERE project_id = $1
+ORDER BY created_at, id;
+
+-- name: ListProjectDependencies :many
+-- Зависимости задач проекта; блокирующая задача может быть и вне проекта
+SELECT d.task_id, d.blocker_id, d.created_at, d.workspace_id
+FROM task_dependencies d
+JOIN tasks t ON t.id = d.task_id
+WHERE t.project_id = $1
+ORDER BY d.task_id, d.blocker_id;
@@ -0,0 +1,67 @@
+-- name: ListMilestones :many
+SELECT id, project_id, name, description, start_date, end_date, created_at, workspace_id
+FROM milestones
+WHERE project_id = $1
+ORDER BY start_date, id;
+
+-- name: GetMilestone :one
+SELECT id, project_id, name, description, start_date, end_date, created_at, workspace_id
+FROM milestones
+WHERE id = $1;
+
+-- name: CreateMilestone :one
+INSERT INTO milestones (project_id, name, description, start_date, end_date)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, project_id, name, description, start_date, end_date, created_at, workspace_id;
+
+-- name: UpdateMilestone :one
+UPDATE milestones
+SET name = $2, description = $3, start_date = $4, end_date = $5
+WHERE id = $1
+RETURNING id, project_id, name, description, start_date, end_date, created_at, workspace_id;
+
+-- name: DeleteMilestone :execrows
+DELETE FROM milestones
+WHERE id = $1;
+
+-- name: CountMilestoneProgress :many
+-- Задачи вех и выполненные из них, всего и в минутах оценки
+SELECT milestone_id::INTEGER AS milestone_id,
+       COUNT(*)::INTEGER AS total,
+       (COUNT(*) FILTER (WHERE completed))::INTEGER AS completed,
+       COALESCE(SUM(estimate_minutes), 0)::INTEGER AS estimate_minutes,
+       COALESCE(SUM(estimate_minutes) FILTER (WHERE completed), 0)::INTEGER AS completed_estimate_minutes
+FROM tasks
+WHERE milestone_id = ANY(@milestone_ids::INTEGER[])
+GROUP BY milestone_id;
+
+-- name: ListMilestoneTasks :many
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
+FROM tasks
+WHERE milestone_id = @milestone_id
+ORDER BY position, id
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: MilestoneBurndown :many
+-- Диаграмма сгорания вехи по дням [@start_date, @end_date] (UTC): на конец каждого дня - сколько задач
+-- вехи уже создано и еще не выполнено, сколько выполнено за день и сколько минут оценки осталось.
+-- В веху входят ее текущие задачи: история переноса задач между вехами не хранится.
+-- Ряд строится по TIMESTAMP без часового пояса, а границы дней переводятся из UTC явно: ряд по DATE
+-- стал бы TIMESTAMPTZ, и границы дней зависели бы от часового пояса сессии
+WITH days AS (
+    SELECT d::DATE AS day,
+           (d::DATE::TIMESTAMP AT TIME ZONE 'UTC') AS day_start,
+           ((d::DATE + 1)::TIMESTAMP AT TIME ZONE 'UTC') AS day_end
+    FROM generate_series(@start_date::DATE::TIMESTAMP, @end_date::DATE::TIMESTAMP, '1 day') AS d
+)
+SELECT days.day,
+       (COUNT(t.id) FILTER (WHERE t.created_at::TIMESTAMPTZ < days.day_end
+                              AND (t.completed_at IS NULL OR t.completed_at >= days.day_end)))::INTEGER AS remaining,
+       (COUNT(t.id) FILTER (WHERE t.completed_at >= days.day_start
+                              AND t.completed_at < days.day_end))::INTEGER AS completed,
+       COALESCE(SUM(t.estimate_minutes) FILTER (WHERE t.created_at::TIMESTAMPTZ < days.day_end
+                              AND (t.completed_at IS NULL OR t.completed_at >= days.day_end)), 0)::INTEGER AS remaining_minutes
+FROM days
+LEFT JOIN tasks t ON t.milestone_id = @milestone_id
+GROUP BY days.day, days.day_start, days.day_end
+ORDER BY days.day;
@@ -0,0 +1,91 @@
+-- name: CreateProject :one
+-- Создатель проекта сразу становится его владельцем; одна команда - проект не останется без участников
+WITH project AS (
+    INSERT INTO projects (name, created_by)
+    VALUES (@name, @user_id)
+    RETURNING id, name, created_by, created_at, workspace_id
+), owner AS (
+    INSERT INTO project_members (project_id, user_id, role)
+    SELECT id, created_by, 'owner' FROM project
+)
+SELECT id, name, created_by, created_at, workspace_id FROM project;
+
+-- name: GetProject :one
+SELECT id, name, created_by, created_at, workspace_id
+FROM projects
+WHERE id = $1;
+
+-- name: ListProjectsByMember :many
+SELECT p.id, p.name, p.created_by, p.created_at, m.role
+FROM projects p
+JOIN project_members m ON m.project_id = p.id
+WHERE m.user_id = $1
+ORDER BY p.created_at DESC;
+
+-- name: DeleteProject :execrows
+DELETE FROM projects
+WHERE id = $1;
+
+-- name: GetProjectMemberRole :one
+SELECT role
+FROM project_members
+WHERE project_id = $1 AND user_id = $2;
+
+-- name: ListProjectMembers :many
+SELECT project_id, user_id, role, created_at, workspace_id
+FROM project_members
+WHERE project_id = $1
+ORDER BY created_at;
+
+-- name: UpdateProjectMemberRole :one
+UPDATE project_members
+SET role = $3
+WHERE project_id = $1 AND user_id = $2
+RETURNING project_id, user_id, role, created_at, workspace_id;
+
+-- name: DeleteProjectMember :execrows
+DELETE FROM project_members
+WHERE project_id = $1 AND user_id = $2;
+
+-- name: CountProjectOwners :one
+SELECT COUNT(*)
+FROM project_members
+WHERE project_id = $1 AND role = 'owner';
+
+-- name: CreateProjectInvitation :one
+INSERT INTO project_invitations (project_id, user_id, role, invited_by)
+VALUES ($1, $2, $3, $4)
+RETURNING id, project_id, user_id, role, invited_by, created_at, workspace_id;
+
+-- name: GetProjectInvitation :one
+SELECT id, project_id, user_id, role, invited_by, created_at, workspace_id
+FROM project_invitations
+WHERE id = $1;
+
+-- name: ListProjectInvitations :many
+SELECT id, project_id, user_id, role, invited_by, created_at, workspace_id
+FROM project_invitations
+WHERE project_id = $1
+ORDER BY created_at;
+
+-- name: ListInvitationsByUser :many
+SELECT id, project_id, user_id, role, invited_by, created_at, workspace_id
+FROM project_invitations
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: DeleteProjectInvitation :execrows
+DELETE FROM project_invitations
+WHERE id = $1;
+
+-- name: AcceptProjectInvitation :one
+-- Приглашение удаляется и превращается в участие одной командой
+WITH invitation AS (
+    DELETE FROM project_invitations
+    WHERE id = @id AND user_id = @user_id
+    RETURNING project_id, user_id, role
+)
+INSERT INTO project_members (project_id, user_id, role)
+SELECT project_id, user_id, role FROM invitation
+ON CONFLICT (project_id, user_id) DO UPDATE SET role = EXCLUDED.role
+RETURNING project_id, user_id, role, created_at, workspace_id;
@@ -0,0 +1,22 @@
+-- name: TakeRateLimitToken :one
+-- Пополняет корзину за прошедшее время (не больше burst) и списывает токен, если он есть
+INSERT INTO rate_limit_buckets AS b (key, tokens, allowed, updated_at)
+VALUES (@key, @burst::float8 - 1, true, now())
+ON CONFLICT (key) DO UPDATE SET
+    tokens = LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * @rate::float8)
+        - CASE WHEN LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * @rate::float8) >= 1 THEN 1 ELSE 0 END,
+    allowed = LEAST(@burst::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * @rate::float8) >= 1,
+    updated_at = now()
+RETURNING tokens, allowed;
+
+-- name: DeleteIdleRateLimitBuckets :execrows
+DELETE FROM rate_limit_buckets
+WHERE updated_at < @idle_since;
+
+-- name: PeekRateLimitTokens :one
+-- Токены в корзине с учетом пополнения к текущему моменту, без списания; нет корзины - она полная
+SELECT COALESCE((
+    SELECT LEAST(@burst::float8, tokens + EXTRACT(EPOCH FROM now() - updated_at)::float8 * @rate::float8)
+    FROM rate_limit_buckets
+    WHERE key = @key
+), @burst::float8)::float8 AS tokens;
@@ -0,0 +1,67 @@
+-- name: ListReminders :many
+-- Напоминания пользователя о задаче; fire_at - когда напоминание сработает (NULL - у задачи нет срока)
+SELECT r.id, r.task_id, r.user_id, r.remind_at, r.offset_minutes, r.email, r.sent_at, r.created_at,
+       COALESCE(r.remind_at, t.due_at - make_interval(mins => r.offset_minutes))::TIMESTAMPTZ AS fire_at
+FROM task_reminders r
+JOIN tasks t ON t.id = r.task_id
+WHERE r.task_id = $1 AND r.user_id = $2
+ORDER BY r.id;
+
+-- name: GetReminder :one
+SELECT id, task_id, user_id, remind_at, offset_minutes, email, sent_at, sent_due_at, claimed_until, attempts, last_error, created_at, workspace_id, delivered_channels
+FROM task_reminders
+WHERE id = $1;
+
+-- name: CreateReminder :one
+INSERT INTO task_reminders (task_id, user_id, remind_at, offset_minutes, email)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, task_id, user_id, remind_at, offset_minutes, email, sent_at, sent_due_at, claimed_until, attempts, last_error, created_at, workspace_id, delivered_channels;
+
+-- name: CountUserReminders :one
+SELECT COUNT(*) FROM task_reminders
+WHERE task_id = $1 AND user_id = $2;
+
+-- name: DeleteReminder :execrows
+DELETE FROM task_reminders
+WHERE id = $1;
+
+-- name: ClaimDueReminders :many
+-- Занимает на @lease_seconds напоминания, время которых пришло. Строки, которые в тот же момент
+-- забирает другой экземпляр сервера, пропускаются (SKIP LOCKED), а после фиксации занятые строки
+-- не подходят под отбор до конца аренды: каждое напоминание отправляет один экземпляр.
+-- Напоминания о выполненных задачах не отправляются
+WITH due AS (
+    SELECT r.id
+    FROM task_reminders r
+    JOIN tasks t ON t.id = r.task_id
+    WHERE (r.claimed_until IS NULL OR r.claimed_until < now())
+      AND r.attempts < @max_attempts::INTEGER
+      AND NOT COALESCE(t.completed, false)
+      AND ((r.remind_at IS NOT NULL AND r.sent_at IS NULL AND r.remind_at <= now())
+        OR (r.offset_minutes IS NOT NULL AND t.due_at IS NOT NULL
+            AND t.due_at - make_interval(mins => r.offset_minutes) <= now()
+            AND r.sent_due_at IS DISTINCT FROM t.due_at))
+    ORDER BY r.id
+    LIMIT @batch::INTEGER
+    FOR UPDATE OF r SKIP LOCKED
+)
+UPDATE task_reminders r
+SET claimed_until = now() + make_interval(secs => @lease_seconds::INTEGER)
+FROM due, tasks t
+WHERE r.id = due.id AND t.id = r.task_id
+RETURNING r.id, r.task_id, r.user_id, r.email, r.remind_at, r.offset_minutes, r.attempts, r.workspace_id, r.delivered_channels,
+          t.name AS task_name, t.due_at;
+
+-- name: MarkReminderSent :exec
+UPDATE task_reminders
+SET sent_at = now(), sent_due_at = @due_at, claimed_until = NULL, attempts = 0, last_error = '', delivered_channels = '{}'
+WHERE id = @id;
+
+-- name: MarkReminderFailed :exec
+-- Повторная попытка - после @retry_seconds и только в каналы не из @delivered_channels;
+-- после max_attempts неудач напоминание больше не отправляется
+UPDATE task_reminders
+SET attempts = attempts + 1, last_error = @last_error,
+    claimed_until = now() + make_interval(secs => @retry_seconds::INTEGER),
+    delivered_channels = @delivered_channels::TEXT[]
+WHERE id = @id;
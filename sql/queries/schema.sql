@@ -0,0 +1,2 @@
+-- name: GetSchemaVersion :one
+SELECT COALESCE(MAX(version), 0)::integer AS version FROM schema_migrations;
@@ -0,0 +1,98 @@
+-- Статистика по задачам, видимым пользователю (как в ListTasks); @project_id > 0 - только задачи проекта.
+-- Просроченная задача - невыполненная задача со сроком в прошлом. Дни и недели считаются по UTC.
+
+-- name: TaskStats :one
+-- Итоги по статусам и среднее время выполнения задач, выполненных в [@completed_from, @completed_to)
+SELECT COUNT(*) AS total,
+       COUNT(*) FILTER (WHERE completed) AS completed,
+       COUNT(*) FILTER (WHERE NOT completed AND due_at < now()) AS overdue,
+       (AVG(EXTRACT(EPOCH FROM completed_at - created_at::TIMESTAMPTZ))
+           FILTER (WHERE completed_at >= @completed_from AND completed_at < @completed_to))::FLOAT8 AS average_completion_seconds
+FROM tasks
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND (@project_id::INTEGER = 0 OR project_id = @project_id);
+
+-- name: TaskStatsByProject :many
+-- Итоги по проектам; строка с project_id NULL - задачи вне проектов
+SELECT project_id,
+       COUNT(*) AS total,
+       COUNT(*) FILTER (WHERE completed) AS completed,
+       COUNT(*) FILTER (WHERE NOT completed AND due_at < now()) AS overdue
+FROM tasks
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND (@project_id::INTEGER = 0 OR project_id = @project_id)
+GROUP BY project_id
+ORDER BY project_id NULLS FIRST;
+
+-- name: TaskStatsByTag :many
+-- Итоги по меткам, самые частые первыми; задача с несколькими метками учитывается в каждой
+SELECT tag::TEXT AS tag,
+       COUNT(*) AS total,
+       COUNT(*) FILTER (WHERE completed) AS completed,
+       COUNT(*) FILTER (WHERE NOT completed AND due_at < now()) AS overdue
+FROM tasks, unnest(tags) AS tag
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND (@project_id::INTEGER = 0 OR project_id = @project_id)
+GROUP BY tag
+ORDER BY total DESC, tag
+LIMIT sqlc.arg('limit');
+
+-- name: TaskStatsSeries :many
+-- Созданные и выполненные задачи по периодам @period ('day' или 'week', недели с понедельника)
+-- с периода, содержащего @from_date, по период, содержащий @to_date
+WITH periods AS (
+    SELECT p AS period
+    FROM generate_series(date_trunc(@period::TEXT, @from_date::DATE::TIMESTAMP),
+                         @to_date::DATE::TIMESTAMP,
+                         ('1 ' || @period::TEXT)::INTERVAL) AS p
+),
+visible AS (
+    SELECT created_at::TIMESTAMPTZ AT TIME ZONE 'UTC' AS created, completed_at AT TIME ZONE 'UTC' AS completed
+    FROM tasks
+    WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+       OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+      AND (@project_id::INTEGER = 0 OR project_id = @project_id)
+      AND (created_at::TIMESTAMPTZ >= date_trunc(@period::TEXT, @from_date::DATE::TIMESTAMP) AT TIME ZONE 'UTC'
+        OR completed_at >= date_trunc(@period::TEXT, @from_date::DATE::TIMESTAMP) AT TIME ZONE 'UTC')
+),
+created AS (
+    SELECT date_trunc(@period::TEXT, created) AS period, COUNT(*) AS count
+    FROM visible
+    GROUP BY 1
+),
+done AS (
+    SELECT date_trunc(@period::TEXT, completed) AS period, COUNT(*) AS count
+    FROM visible
+    WHERE completed IS NOT NULL
+    GROUP BY 1
+)
+SELECT periods.period::DATE AS period,
+       COALESCE(created.count, 0)::INTEGER AS created,
+       COALESCE(done.count, 0)::INTEGER AS completed
+FROM periods
+LEFT JOIN created ON created.period = periods.period
+LEFT JOIN done ON done.period = periods.period
+ORDER BY periods.period;
+
+-- name: CompletionStreaks :one
+-- Серии дней подряд, в каждый из которых пользователь выполнил хотя бы одну задачу.
+-- Текущая серия еще не прервана: ее последний день - @today или вчера
+WITH days AS (
+    SELECT DISTINCT (e.created_at AT TIME ZONE 'UTC')::DATE AS day
+    FROM task_events e
+    JOIN tasks t ON t.id = e.task_id
+    WHERE e.actor_id = @user_id
+      AND e.type = 'completed'
+      AND (@project_id::INTEGER = 0 OR t.project_id = @project_id)
+),
+streaks AS (
+    SELECT COUNT(*) AS length, MAX(day) AS last_day
+    FROM (SELECT day, day - (ROW_NUMBER() OVER (ORDER BY day))::INTEGER AS streak FROM days) numbered
+    GROUP BY streak
+)
+SELECT COALESCE(MAX(length) FILTER (WHERE last_day >= @today::DATE - 1), 0)::INTEGER AS current_days,
+       COALESCE(MAX(length), 0)::INTEGER AS longest_days
+FROM streaks;
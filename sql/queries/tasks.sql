@@ -1,39 +1,64 @@
 -- name: GetTask :one
-SELECT id, name, description, completed, created_at, updated_at 
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 FROM tasks 
 WHERE id = $1;
 
 -- name: ListTasks :many
-SELECT id, name, description, completed, created_at, updated_at 
+-- Задачи, видимые пользователю: его собственные, общие задачи без владельца и задачи его проектов.
+-- Пользовательские поля @field_keys должны иметь значения @field_values (для multi_select - содержать их).
+-- sort: position - ручной порядок, field / -field - по полю @sort_field, иначе новые первыми
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 FROM tasks 
-ORDER BY created_at DESC
-LIMIT $1 OFFSET $2;
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest(@field_keys::TEXT[], @field_values::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+ORDER BY CASE WHEN @sort::TEXT = 'position' THEN position END,
+         CASE WHEN @sort::TEXT = 'position' THEN id END,
+         CASE WHEN @sort::TEXT = 'field' THEN custom_fields -> @sort_field::TEXT END,
+         CASE WHEN @sort::TEXT = '-field' THEN custom_fields -> @sort_field::TEXT END DESC NULLS LAST,
+         created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: ListTasksByStatus :many
-SELECT id, name, description, completed, created_at, updated_at 
+SELECT id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at
 FROM tasks 
-WHERE completed = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+WHERE completed = @completed
+  AND ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest(@field_keys::TEXT[], @field_values::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  )
+ORDER BY CASE WHEN @sort::TEXT = 'position' THEN position END,
+         CASE WHEN @sort::TEXT = 'position' THEN id END,
+         CASE WHEN @sort::TEXT = 'field' THEN custom_fields -> @sort_field::TEXT END,
+         CASE WHEN @sort::TEXT = '-field' THEN custom_fields -> @sort_field::TEXT END DESC NULLS LAST,
+         created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CreateTask :one
-INSERT INTO tasks (name, description, completed)
-VALUES ($1, $2, $3)
-RETURNING id, name, description, completed, created_at, updated_at;
+INSERT INTO tasks (name, description, completed, owner_id, project_id, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
 
 -- name: UpdateTask :one
+-- completed_at - момент выполнения: сохраняется, пока задача выполнена, и сбрасывается при возврате в работу
 UPDATE tasks 
-SET name = $2, description = $3, completed = $4
+SET name = $2, description = $3, completed = $4, custom_fields = $5, estimate_minutes = $6, due_at = $7, tags = $8,
+    milestone_id = $9, completed_at = CASE WHEN $4 THEN COALESCE(completed_at, now()) END
 WHERE id = $1
-RETURNING id, name, description, completed, created_at, updated_at;
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
 
 -- name: CompleteTask :one
 UPDATE tasks 
-SET completed = true
+SET completed = true, completed_at = COALESCE(completed_at, now())
 WHERE id = $1
-RETURNING id, name, description, completed, created_at, updated_at;
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
 
--- name: DeleteTask :exec
+-- name: DeleteTask :execrows
 DELETE FROM tasks 
 WHERE id = $1;
 
@@ -43,8 +68,79 @@ SELECT COUNT(*) FROM tasks;
 -- name: CountTasksByStatus :one
 SELECT COUNT(*) FROM tasks WHERE completed = $1;
 
+-- name: CountVisibleTasks :one
+SELECT COUNT(*) FROM tasks
+WHERE ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest(@field_keys::TEXT[], @field_values::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  );
+
+-- name: CountVisibleTasksByStatus :one
+SELECT COUNT(*) FROM tasks
+WHERE completed = @completed
+  AND ((project_id IS NULL AND (owner_id IS NULL OR owner_id = @user_id))
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND NOT EXISTS (
+      SELECT 1 FROM unnest(@field_keys::TEXT[], @field_values::TEXT[]) AS f(key, value)
+      WHERE NOT COALESCE(custom_fields ->> f.key = f.value OR custom_fields -> f.key @> to_jsonb(f.value), false)
+  );
+
 -- name: UncompleteTask :one
 UPDATE tasks
-SET completed = false
+SET completed = false, completed_at = NULL
 WHERE id = $1
-RETURNING id, name, description, completed, created_at, updated_at;
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
+
+-- name: CountTasksByOwner :one
+SELECT COUNT(*) FROM tasks WHERE owner_id = $1;
+
+-- name: LockTaskQuota :exec
+-- Блокировка квоты задач владельца до конца транзакции: подсчет его задач и вставка новых
+-- в конкурирующих транзакциях выполняются по очереди
+SELECT pg_advisory_xact_lock(hashtext('task_quota'), @owner_id::INTEGER);
+
+-- name: SetTaskPosition :one
+UPDATE tasks
+SET position = $2
+WHERE id = $1
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
+
+-- name: GetPreviousTaskPosition :one
+-- Ранг задачи, стоящей в списке непосредственно перед задачей @id (сама @exclude_id не учитывается).
+-- Список задан проектом, а вне проектов - владельцем
+SELECT t.position
+FROM tasks t, tasks anchor
+WHERE anchor.id = @id
+  AND t.id <> @exclude_id
+  AND t.project_id IS NOT DISTINCT FROM anchor.project_id
+  AND (anchor.project_id IS NOT NULL OR t.owner_id IS NOT DISTINCT FROM anchor.owner_id)
+  AND (t.position, t.id) < (anchor.position, anchor.id)
+ORDER BY t.position DESC, t.id DESC
+LIMIT 1;
+
+-- name: GetNextTaskPosition :one
+-- Ранг задачи, стоящей в списке непосредственно после задачи @id (сама @exclude_id не учитывается)
+SELECT t.position
+FROM tasks t, tasks anchor
+WHERE anchor.id = @id
+  AND t.id <> @exclude_id
+  AND t.project_id IS NOT DISTINCT FROM anchor.project_id
+  AND (anchor.project_id IS NOT NULL OR t.owner_id IS NOT DISTINCT FROM anchor.owner_id)
+  AND (t.position, t.id) > (anchor.position, anchor.id)
+ORDER BY t.position, t.id
+LIMIT 1;
+
+-- name: RebalanceTaskList :execrows
+-- Перенумеровывает список, в котором стоит задача @id, рангами 1, 2, ... с сохранением порядка
+UPDATE tasks
+SET position = ranked.n
+FROM (
+    SELECT t.id, row_number() OVER (ORDER BY t.position, t.id) AS n
+    FROM tasks t, tasks anchor
+    WHERE anchor.id = @id
+      AND t.project_id IS NOT DISTINCT FROM anchor.project_id
+      AND (anchor.project_id IS NOT NULL OR t.owner_id IS NOT DISTINCT FROM anchor.owner_id)
+) ranked
+WHERE tasks.id = ranked.id;
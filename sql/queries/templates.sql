@@ -0,0 +1,47 @@
+-- name: ListTemplates :many
+-- Шаблоны, видимые пользователю: его личные и шаблоны его проектов; @project_id > 0 - только этого проекта
+SELECT id, project_id, owner_id, name, description, tasks, created_at, updated_at, workspace_id
+FROM task_templates
+WHERE ((project_id IS NULL AND owner_id = @user_id)
+   OR project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND (@project_id::INTEGER = 0 OR project_id = @project_id)
+ORDER BY name, id;
+
+-- name: GetTemplate :one
+SELECT id, project_id, owner_id, name, description, tasks, created_at, updated_at, workspace_id
+FROM task_templates
+WHERE id = $1;
+
+-- name: CreateTemplate :one
+INSERT INTO task_templates (project_id, owner_id, name, description, tasks)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, project_id, owner_id, name, description, tasks, created_at, updated_at, workspace_id;
+
+-- name: UpdateTemplate :one
+UPDATE task_templates
+SET name = $2, description = $3, tasks = $4, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+RETURNING id, project_id, owner_id, name, description, tasks, created_at, updated_at, workspace_id;
+
+-- name: DeleteTemplate :execrows
+DELETE FROM task_templates
+WHERE id = $1;
+
+-- name: CreateTemplateTasks :many
+-- Все задачи дерева создаются одной командой. @nodes - задачи в порядке обхода дерева:
+-- [{"idx", "parent_idx", "name", "description", "tags", "due_at"}], parent_idx - номер родителя
+-- или null у корней. Идентификаторы выдаются заранее, чтобы подзадачи ссылались на родителей;
+-- задачи вставляются с конца, и первая встает в начало списка (см. set_task_position)
+WITH nodes AS MATERIALIZED (
+    SELECT n.idx, n.parent_idx, n.name, n.description, n.tags, n.due_at,
+           nextval(pg_get_serial_sequence('tasks', 'id'))::INTEGER AS id
+    FROM jsonb_to_recordset(@nodes::JSONB)
+        AS n(idx INTEGER, parent_idx INTEGER, name TEXT, description TEXT, tags TEXT[], due_at TIMESTAMPTZ)
+    ORDER BY n.idx
+)
+INSERT INTO tasks (id, name, description, completed, owner_id, project_id, parent_id, tags, due_at)
+SELECT n.id, n.name, n.description, false, sqlc.narg('owner_id'), sqlc.narg('project_id'), p.id, n.tags, n.due_at
+FROM nodes n
+LEFT JOIN nodes p ON p.idx = n.parent_idx
+ORDER BY n.idx DESC
+RETURNING id, name, description, completed, created_at, updated_at, owner_id, project_id, workspace_id, position, custom_fields, estimate_minutes, due_at, parent_id, tags, milestone_id, completed_at;
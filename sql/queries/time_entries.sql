@@ -0,0 +1,102 @@
+-- name: ListTimeEntries :many
+SELECT id, task_id, user_id, started_at, ended_at, note, created_at, workspace_id
+FROM task_time_entries
+WHERE task_id = $1
+ORDER BY started_at DESC, id DESC;
+
+-- name: GetTimeEntry :one
+SELECT id, task_id, user_id, started_at, ended_at, note, created_at, workspace_id
+FROM task_time_entries
+WHERE id = $1;
+
+-- name: GetRunningTimeEntry :one
+SELECT id, task_id, user_id, started_at, ended_at, note, created_at, workspace_id
+FROM task_time_entries
+WHERE user_id = $1 AND ended_at IS NULL;
+
+-- name: CreateTimeEntry :one
+INSERT INTO task_time_entries (task_id, user_id, started_at, ended_at, note)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, task_id, user_id, started_at, ended_at, note, created_at, workspace_id;
+
+-- name: StartTimer :one
+-- Останавливает идущий таймер пользователя (на любой задаче) и запускает новый одной командой
+WITH stopped AS (
+    UPDATE task_time_entries
+    SET ended_at = now()
+    WHERE user_id = @user_id AND ended_at IS NULL
+)
+INSERT INTO task_time_entries (task_id, user_id, started_at, note)
+VALUES (@task_id, @user_id, now(), @note)
+RETURNING id, task_id, user_id, started_at, ended_at, note, created_at, workspace_id;
+
+-- name: StopTimer :one
+UPDATE task_time_entries
+SET ended_at = now()
+WHERE task_id = @task_id AND user_id = @user_id AND ended_at IS NULL
+RETURNING id, task_id, user_id, started_at, ended_at, note, created_at, workspace_id;
+
+-- name: DeleteTimeEntry :execrows
+DELETE FROM task_time_entries
+WHERE id = $1;
+
+-- name: GetTaskTimeTotals :one
+-- Учтенное время задачи в секундах и итоги вместе со всеми ее подзадачами любой глубины:
+-- сумма оценок в минутах и учтенное время; идущий таймер - до текущего момента
+WITH RECURSIVE subtree AS (
+    SELECT id, estimate_minutes FROM tasks WHERE id = @task_id
+    UNION
+    SELECT t.id, t.estimate_minutes
+    FROM tasks t
+    JOIN subtree s ON t.parent_id = s.id
+)
+SELECT COALESCE((SELECT SUM(EXTRACT(EPOCH FROM COALESCE(e.ended_at, now()) - e.started_at))
+                 FROM task_time_entries e WHERE e.task_id = @task_id), 0)::BIGINT AS tracked_seconds,
+       COALESCE((SELECT SUM(estimate_minutes) FROM subtree), 0)::BIGINT AS total_estimate_minutes,
+       COALESCE((SELECT SUM(EXTRACT(EPOCH FROM COALESCE(e.ended_at, now()) - e.started_at))
+                 FROM task_time_entries e JOIN subtree s ON s.id = e.task_id), 0)::BIGINT AS total_tracked_seconds;
+
+-- name: ListProjectTaskTime :many
+-- Оценка и учтенное время (в секундах) каждой задачи проекта, а также итоги вместе со всеми
+-- ее подзадачами любой глубины (подзадачи всегда в проекте родителя)
+WITH RECURSIVE own AS (
+    SELECT t.id, t.parent_id, t.estimate_minutes,
+           COALESCE(SUM(EXTRACT(EPOCH FROM COALESCE(e.ended_at, now()) - e.started_at)), 0)::BIGINT AS tracked_seconds
+    FROM tasks t
+    LEFT JOIN task_time_entries e ON e.task_id = t.id
+    WHERE t.project_id = $1
+    GROUP BY t.id
+),
+-- пары (задача, она сама или ее потомок)
+subtree AS (
+    SELECT id AS root_id, id FROM own
+    UNION
+    SELECT s.root_id, o.id
+    FROM subtree s
+    JOIN own o ON o.parent_id = s.id
+)
+SELECT o.id AS task_id, o.estimate_minutes, o.tracked_seconds,
+       COALESCE(SUM(d.estimate_minutes), 0)::BIGINT AS total_estimate_minutes,
+       SUM(d.tracked_seconds)::BIGINT AS total_tracked_seconds
+FROM own o
+JOIN subtree s ON s.root_id = o.id
+JOIN own d ON d.id = s.id
+GROUP BY o.id, o.estimate_minutes, o.tracked_seconds
+ORDER BY o.id;
+
+-- name: Timesheet :many
+-- Учтенное время по записям, начатым в [@period_start, @period_end), на задачах, видимых пользователю.
+-- Группировка по дню (UTC), пользователю и проекту включается флагами; выключенный столбец - NULL
+SELECT CASE WHEN @by_day::BOOLEAN THEN (e.started_at AT TIME ZONE 'UTC')::DATE END AS day,
+       CASE WHEN @by_user::BOOLEAN THEN e.user_id END AS user_id,
+       CASE WHEN @by_project::BOOLEAN THEN t.project_id END AS project_id,
+       SUM(EXTRACT(EPOCH FROM COALESCE(e.ended_at, now()) - e.started_at))::BIGINT AS seconds
+FROM task_time_entries e
+JOIN tasks t ON t.id = e.task_id
+WHERE e.started_at >= @period_start::TIMESTAMPTZ AND e.started_at < @period_end::TIMESTAMPTZ
+  AND ((t.project_id IS NULL AND (t.owner_id IS NULL OR t.owner_id = @user_id))
+   OR t.project_id IN (SELECT project_id FROM project_members WHERE user_id = @user_id))
+  AND (sqlc.narg('project_id')::INTEGER IS NULL OR t.project_id = sqlc.narg('project_id'))
+  AND (sqlc.narg('member_id')::INTEGER IS NULL OR e.user_id = sqlc.narg('member_id'))
+GROUP BY 1, 2, 3
+ORDER BY 1, 2, 3;
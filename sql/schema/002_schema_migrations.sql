@@ -0,0 +1,8 @@
+-- Учет примененных миграций: сервис сверяет максимальную версию с ожидаемой при проверке готовности
+CREATE TABLE IF NOT EXISTS schema_migrations (
+    version INTEGER PRIMARY KEY,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Каждая следующая миграция добавляет свою версию в конце файла
+INSERT INTO schema_migrations (version) VALUES (1), (2) ON CONFLICT DO NOTHING;
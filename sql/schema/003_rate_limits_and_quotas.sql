@@ -0,0 +1,18 @@
+-- Владелец задачи: по нему считается квота на число задач пользователя.
+-- Задачи, созданные без аутентификации, остаются без владельца.
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS owner_id INTEGER;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_id ON tasks(owner_id);
+
+-- Корзины токенов ограничения частоты запросов, общие для всех экземпляров сервиса.
+-- allowed хранит решение по последнему запросу, чтобы вернуть его из того же UPSERT.
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    allowed BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated_at ON rate_limit_buckets(updated_at);
+
+INSERT INTO schema_migrations (version) VALUES (3) ON CONFLICT DO NOTHING;
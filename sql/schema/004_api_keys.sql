@@ -0,0 +1,20 @@
+-- API ключи для доступа сервисов и CI без интерактивного входа.
+-- Хранится только SHA-256 ключа; prefix - первые символы ключа, чтобы пользователь мог его узнать в списке.
+-- Пользователи заводятся во внешнем провайдере, поэтому user_id - идентификатор из JWT (sub), без внешнего ключа.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    key_hash BYTEA NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    last_used_ip TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_user_id ON api_keys(user_id);
+
+INSERT INTO schema_migrations (version) VALUES (4) ON CONFLICT DO NOTHING;
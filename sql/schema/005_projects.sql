@@ -0,0 +1,39 @@
+-- Проекты: общие списки задач с участниками и ролями.
+-- Роль определяет права участника: owner - все, включая управление участниками,
+-- editor - изменение задач, commenter - комментарии, viewer - только чтение.
+CREATE TABLE IF NOT EXISTS projects (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_by INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'editor', 'commenter', 'viewer')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_members_user_id ON project_members(user_id);
+
+-- Приглашение становится участием, когда приглашенный его принимает
+CREATE TABLE IF NOT EXISTS project_invitations (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'editor', 'commenter', 'viewer')),
+    invited_by INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_project_invitations_user_id ON project_invitations(user_id);
+
+-- Задача вне проекта видна только владельцу (или всем, если владельца нет)
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS project_id INTEGER REFERENCES projects(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks(project_id);
+
+INSERT INTO schema_migrations (version) VALUES (5) ON CONFLICT DO NOTHING;
@@ -0,0 +1,41 @@
+-- Рабочие пространства: несколько команд в одной БД.
+-- Изоляция обеспечивается политиками RLS по настройке app.workspace_id, которую приложение
+-- устанавливает в каждой транзакции (internal/db). Без настройки запросы к данным не видят ни одной строки.
+-- Системные операции (метрики, аутентификация по API ключу) видят все пространства при app.all_workspaces = 'on'.
+-- rate_limit_buckets и schema_migrations - служебные таблицы без данных команд, RLS на них не распространяется.
+CREATE TABLE IF NOT EXISTS workspaces (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Пространство по умолчанию: существующие данные, анонимные клиенты и токены без workspace_id
+INSERT INTO workspaces (id, name) VALUES (1, 'default') ON CONFLICT DO NOTHING;
+SELECT setval(pg_get_serial_sequence('workspaces', 'id'), (SELECT MAX(id) FROM workspaces));
+
+CREATE OR REPLACE FUNCTION app_workspace_visible(workspace INTEGER) RETURNS BOOLEAN
+LANGUAGE sql STABLE AS $$
+    SELECT workspace = nullif(current_setting('app.workspace_id', true), '')::INTEGER
+        OR current_setting('app.all_workspaces', true) = 'on'
+$$;
+
+-- Существующие строки попадают в пространство по умолчанию, новые - в пространство транзакции
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['tasks', 'projects', 'project_members', 'project_invitations', 'api_keys'] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN IF NOT EXISTS workspace_id INTEGER NOT NULL DEFAULT 1 REFERENCES workspaces(id)', t);
+        EXECUTE format('ALTER TABLE %I ALTER COLUMN workspace_id SET DEFAULT nullif(current_setting(''app.workspace_id'', true), '''')::INTEGER', t);
+        EXECUTE format('CREATE INDEX IF NOT EXISTS %I ON %I(workspace_id)', 'idx_' || t || '_workspace_id', t);
+
+        -- FORCE: политики действуют и на владельца таблиц, под которым обычно работает приложение
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('DROP POLICY IF EXISTS workspace_isolation ON %I', t);
+        EXECUTE format('CREATE POLICY workspace_isolation ON %I USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id))', t);
+    END LOOP;
+END
+$$;
+
+INSERT INTO schema_migrations (version) VALUES (6) ON CONFLICT DO NOTHING;
@@ -0,0 +1,58 @@
+-- Комментарии к задачам (Markdown) с историей правок и событиями задач для ленты активности.
+-- mentions - пользователи, упомянутые в тексте (@<id>) и имеющие доступ к задаче.
+CREATE TABLE IF NOT EXISTS task_comments (
+    id SERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    author_id INTEGER NOT NULL,
+    body TEXT NOT NULL,
+    mentions INTEGER[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    edited_at TIMESTAMPTZ,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comments_task_id ON task_comments(task_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_task_comments_mentions ON task_comments USING GIN (mentions);
+
+-- Прежние версии комментария: при правке сюда переносится заменяемый текст
+CREATE TABLE IF NOT EXISTS task_comment_revisions (
+    id SERIAL PRIMARY KEY,
+    comment_id INTEGER NOT NULL REFERENCES task_comments(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_comment_revisions_comment_id ON task_comment_revisions(comment_id);
+
+-- События задачи: created, updated, completed, uncompleted. actor_id NULL - анонимный клиент.
+CREATE TABLE IF NOT EXISTS task_events (
+    id SERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    actor_id INTEGER,
+    type VARCHAR(32) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_events_task_id ON task_events(task_id, created_at);
+
+ALTER TABLE task_comments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_comments FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_comments;
+CREATE POLICY workspace_isolation ON task_comments
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+ALTER TABLE task_comment_revisions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_comment_revisions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_comment_revisions;
+CREATE POLICY workspace_isolation ON task_comment_revisions
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+ALTER TABLE task_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_events FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_events;
+CREATE POLICY workspace_isolation ON task_events
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (7) ON CONFLICT DO NOTHING;
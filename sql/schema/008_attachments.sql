@@ -0,0 +1,53 @@
+-- Вложения задач. Содержимое лежит в хранилище (диск или S3) по storage_key, в БД - только описание.
+-- checksum - SHA-256 содержимого (hex), посчитанный при загрузке.
+CREATE TABLE IF NOT EXISTS task_attachments (
+    id SERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    checksum CHAR(64) NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    uploaded_by INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_attachments_task_id ON task_attachments(task_id);
+
+-- Очередь удаления содержимого из хранилища. Триггер ставит в нее ключ любого удаленного вложения,
+-- в том числе удаленного каскадом вместе с задачей или проектом; фоновая очистка удаляет содержимое.
+CREATE TABLE IF NOT EXISTS blob_deletions (
+    storage_key TEXT PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL REFERENCES workspaces(id)
+);
+
+CREATE OR REPLACE FUNCTION queue_blob_deletion() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    INSERT INTO blob_deletions (storage_key, workspace_id)
+    VALUES (OLD.storage_key, OLD.workspace_id)
+    ON CONFLICT DO NOTHING;
+    RETURN OLD;
+END
+$$;
+
+DROP TRIGGER IF EXISTS task_attachments_queue_blob_deletion ON task_attachments;
+CREATE TRIGGER task_attachments_queue_blob_deletion
+    AFTER DELETE ON task_attachments
+    FOR EACH ROW EXECUTE FUNCTION queue_blob_deletion();
+
+ALTER TABLE task_attachments ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_attachments FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_attachments;
+CREATE POLICY workspace_isolation ON task_attachments
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+ALTER TABLE blob_deletions ENABLE ROW LEVEL SECURITY;
+ALTER TABLE blob_deletions FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON blob_deletions;
+CREATE POLICY workspace_isolation ON blob_deletions
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (8) ON CONFLICT DO NOTHING;
@@ -0,0 +1,48 @@
+-- Зависимости задач: task_id заблокирована задачей blocker_id, пока та не выполнена.
+-- Граф зависимостей ацикличен: это проверяет триггер при добавлении ребра.
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    blocker_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id),
+    PRIMARY KEY (task_id, blocker_id),
+    CONSTRAINT task_dependencies_no_cycle CHECK (task_id <> blocker_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocker_id ON task_dependencies(blocker_id);
+
+-- Ребро task_id -> blocker_id замыкает цикл, если task_id уже (транзитивно) блокирует blocker_id.
+-- Добавления сериализуются блокировкой на пространство, иначе две параллельные вставки
+-- (A ждет B и B ждет A) не увидели бы друг друга и вместе образовали цикл.
+CREATE OR REPLACE FUNCTION check_task_dependency_cycle() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('task_dependencies'), NEW.workspace_id);
+
+    IF EXISTS (
+        WITH RECURSIVE blockers(id) AS (
+            SELECT blocker_id FROM task_dependencies WHERE task_id = NEW.blocker_id
+            UNION
+            SELECT d.blocker_id FROM task_dependencies d JOIN blockers b ON d.task_id = b.id
+        )
+        SELECT 1 FROM blockers WHERE id = NEW.task_id
+    ) THEN
+        RAISE EXCEPTION 'task % already blocks task %', NEW.task_id, NEW.blocker_id
+            USING ERRCODE = 'check_violation', CONSTRAINT = 'task_dependencies_no_cycle';
+    END IF;
+    RETURN NEW;
+END
+$$;
+
+DROP TRIGGER IF EXISTS task_dependencies_check_cycle ON task_dependencies;
+CREATE TRIGGER task_dependencies_check_cycle
+    BEFORE INSERT ON task_dependencies
+    FOR EACH ROW EXECUTE FUNCTION check_task_dependency_cycle();
+
+ALTER TABLE task_dependencies ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_dependencies FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_dependencies;
+CREATE POLICY workspace_isolation ON task_dependencies
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (9) ON CONFLICT DO NOTHING;
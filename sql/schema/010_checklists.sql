@@ -0,0 +1,22 @@
+-- Пункты чек-листа задачи: легче подзадачи, без своего статуса, владельца и ленты.
+-- position задает порядок пунктов внутри задачи (1, 2, ...).
+CREATE TABLE IF NOT EXISTS task_checklist_items (
+    id SERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    text VARCHAR(500) NOT NULL,
+    checked BOOLEAN NOT NULL DEFAULT false,
+    position INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_checklist_items_task_id ON task_checklist_items(task_id, position);
+
+ALTER TABLE task_checklist_items ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_checklist_items FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_checklist_items;
+CREATE POLICY workspace_isolation ON task_checklist_items
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (10) ON CONFLICT DO NOTHING;
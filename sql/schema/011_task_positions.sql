@@ -0,0 +1,56 @@
+-- Ручной порядок задач. Список - задачи одного проекта, а вне проектов - задачи одного владельца
+-- (задачи без владельца образуют общий список). position - дробный ранг внутри списка:
+-- при перемещении задача получает середину между соседями, а когда соседние ранги сходятся
+-- слишком близко, приложение перенумеровывает список заново (1, 2, ...).
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS position DOUBLE PRECISION;
+
+-- Существующие задачи сохраняют привычный порядок: новые выше старых.
+-- Политики RLS иначе скрыли бы строки от владельца таблиц.
+SET app.all_workspaces = 'on';
+UPDATE tasks SET position = ranked.n
+FROM (
+    SELECT id, row_number() OVER (
+        PARTITION BY project_id, CASE WHEN project_id IS NULL THEN owner_id END
+        ORDER BY created_at DESC, id DESC
+    ) AS n
+    FROM tasks
+) ranked
+WHERE tasks.id = ranked.id AND tasks.position IS NULL;
+RESET app.all_workspaces;
+
+-- Новая задача встает в начало своего списка
+CREATE OR REPLACE FUNCTION set_task_position() RETURNS TRIGGER
+LANGUAGE plpgsql AS $$
+BEGIN
+    IF NEW.position IS NULL THEN
+        IF NEW.project_id IS NOT NULL THEN
+            SELECT MIN(position) - 1 INTO NEW.position FROM tasks WHERE project_id = NEW.project_id;
+        ELSE
+            SELECT MIN(position) - 1 INTO NEW.position FROM tasks
+            WHERE project_id IS NULL AND owner_id IS NOT DISTINCT FROM NEW.owner_id;
+        END IF;
+        NEW.position := COALESCE(NEW.position, 1);
+    END IF;
+    RETURN NEW;
+END
+$$;
+
+DROP TRIGGER IF EXISTS tasks_set_position ON tasks;
+CREATE TRIGGER tasks_set_position
+    BEFORE INSERT ON tasks
+    FOR EACH ROW EXECUTE FUNCTION set_task_position();
+
+ALTER TABLE tasks ALTER COLUMN position SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_project_position ON tasks(project_id, position);
+CREATE INDEX IF NOT EXISTS idx_tasks_owner_position ON tasks(owner_id, position) WHERE project_id IS NULL;
+
+-- Перемещение и перенумерация не считаются изменением задачи
+DROP TRIGGER IF EXISTS update_tasks_updated_at ON tasks;
+CREATE TRIGGER update_tasks_updated_at
+    BEFORE UPDATE ON tasks
+    FOR EACH ROW
+    WHEN (OLD.position IS NOT DISTINCT FROM NEW.position)
+    EXECUTE FUNCTION update_updated_at_column();
+
+INSERT INTO schema_migrations (version) VALUES (11) ON CONFLICT DO NOTHING;
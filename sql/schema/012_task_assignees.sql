@@ -0,0 +1,23 @@
+-- Исполнители задачи: пользователи, назначенные на нее, отдельно от владельца.
+-- Исполнителем задачи проекта может быть только участник проекта, личной задачи - только ее владелец.
+CREATE TABLE IF NOT EXISTS task_assignees (
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    assigned_by INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id),
+    PRIMARY KEY (task_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_assignees_user_id ON task_assignees(user_id);
+
+-- Пользователь, которого касается событие: для assigned и unassigned - исполнитель
+ALTER TABLE task_events ADD COLUMN IF NOT EXISTS subject_id INTEGER;
+
+ALTER TABLE task_assignees ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_assignees FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_assignees;
+CREATE POLICY workspace_isolation ON task_assignees
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (12) ON CONFLICT DO NOTHING;
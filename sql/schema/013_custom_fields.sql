@@ -0,0 +1,27 @@
+-- Пользовательские поля проекта (story points, заказчик, окружение...) и их значения в задачах.
+-- Значения хранятся в tasks.custom_fields по ключу поля; их соответствие схеме проверяет приложение
+-- (TaskService), так как типы и варианты полей меняются без миграций.
+CREATE TABLE IF NOT EXISTS project_custom_fields (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    key VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    type VARCHAR(16) NOT NULL CHECK (type IN ('text', 'number', 'date', 'select', 'multi_select', 'user')),
+    -- Варианты значений для select и multi_select
+    options TEXT[] NOT NULL DEFAULT '{}',
+    required BOOLEAN NOT NULL DEFAULT false,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id),
+    CONSTRAINT project_custom_fields_key_unique UNIQUE (project_id, key)
+);
+
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS custom_fields JSONB NOT NULL DEFAULT '{}';
+CREATE INDEX IF NOT EXISTS idx_tasks_custom_fields ON tasks USING GIN (custom_fields);
+
+ALTER TABLE project_custom_fields ENABLE ROW LEVEL SECURITY;
+ALTER TABLE project_custom_fields FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON project_custom_fields;
+CREATE POLICY workspace_isolation ON project_custom_fields
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (13) ON CONFLICT DO NOTHING;
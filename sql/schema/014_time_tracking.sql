@@ -0,0 +1,31 @@
+-- Оценки задач и учет времени: записи таймера или введенные вручную интервалы работы пользователя над задачей
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS estimate_minutes INTEGER CHECK (estimate_minutes > 0);
+
+CREATE TABLE IF NOT EXISTS task_time_entries (
+    id SERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    -- NULL - таймер идет
+    ended_at TIMESTAMPTZ,
+    note VARCHAR(500) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id),
+    CONSTRAINT task_time_entries_interval CHECK (ended_at IS NULL OR ended_at >= started_at),
+    -- Не больше одного идущего таймера у пользователя. Проверка отложена до конца транзакции, чтобы
+    -- StartTimer мог одной командой остановить прежний таймер и запустить новый; из двух одновременных
+    -- запусков второй получит ошибку ограничения
+    CONSTRAINT task_time_entries_one_running EXCLUDE USING btree (user_id WITH =)
+        WHERE (ended_at IS NULL) DEFERRABLE INITIALLY DEFERRED
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_time_entries_task_id ON task_time_entries(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_time_entries_started_at ON task_time_entries(started_at);
+
+ALTER TABLE task_time_entries ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_time_entries FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_time_entries;
+CREATE POLICY workspace_isolation ON task_time_entries
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (14) ON CONFLICT DO NOTHING;
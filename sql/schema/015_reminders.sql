@@ -0,0 +1,37 @@
+-- Сроки задач и напоминания о них
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS due_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_due_at ON tasks(due_at) WHERE due_at IS NOT NULL;
+
+-- Напоминание пользователя о задаче: к моменту remind_at или за offset_minutes до срока задачи.
+-- Относительное напоминание следует за сроком: после переноса срока оно срабатывает снова
+CREATE TABLE IF NOT EXISTS task_reminders (
+    id SERIAL PRIMARY KEY,
+    task_id INTEGER NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INTEGER NOT NULL,
+    remind_at TIMESTAMPTZ,
+    offset_minutes INTEGER CHECK (offset_minutes >= 0),
+    -- адрес для уведомления по почте; пустой - письмо не отправляется
+    email VARCHAR(254) NOT NULL DEFAULT '',
+    -- последняя доставка и срок задачи, к которому она относилась
+    sent_at TIMESTAMPTZ,
+    sent_due_at TIMESTAMPTZ,
+    -- до claimed_until напоминание занято экземпляром сервера, который его отправляет
+    claimed_until TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id),
+    CONSTRAINT task_reminders_time CHECK ((remind_at IS NULL) <> (offset_minutes IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_reminders_task_id ON task_reminders(task_id);
+CREATE INDEX IF NOT EXISTS idx_task_reminders_remind_at ON task_reminders(remind_at) WHERE sent_at IS NULL;
+
+ALTER TABLE task_reminders ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_reminders FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_reminders;
+CREATE POLICY workspace_isolation ON task_reminders
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (15) ON CONFLICT DO NOTHING;
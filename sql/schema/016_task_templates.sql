@@ -0,0 +1,32 @@
+-- Подзадачи, метки задач и шаблоны деревьев задач
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS parent_id INTEGER REFERENCES tasks(id) ON DELETE CASCADE;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks(parent_id) WHERE parent_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_tags ON tasks USING GIN (tags);
+
+-- Шаблон - дерево задач (tasks, JSON) с переменными {{name}} в названиях и описаниях.
+-- Шаблон проекта доступен участникам проекта, личный шаблон (project_id IS NULL) - только владельцу.
+-- Структуру дерева проверяет приложение (TemplateService)
+CREATE TABLE IF NOT EXISTS task_templates (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER REFERENCES projects(id) ON DELETE CASCADE,
+    owner_id INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    tasks JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_templates_project_id ON task_templates(project_id);
+CREATE INDEX IF NOT EXISTS idx_task_templates_owner_id ON task_templates(owner_id) WHERE project_id IS NULL;
+
+ALTER TABLE task_templates ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_templates FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON task_templates;
+CREATE POLICY workspace_isolation ON task_templates
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (16) ON CONFLICT DO NOTHING;
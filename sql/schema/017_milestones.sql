@@ -0,0 +1,35 @@
+-- Вехи (спринты) проектов и момент выполнения задач для диаграммы сгорания
+CREATE TABLE IF NOT EXISTS milestones (
+    id SERIAL PRIMARY KEY,
+    project_id INTEGER NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    -- даты по UTC, обе включительно
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    workspace_id INTEGER NOT NULL DEFAULT nullif(current_setting('app.workspace_id', true), '')::INTEGER REFERENCES workspaces(id),
+    CONSTRAINT milestones_dates CHECK (end_date >= start_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_milestones_project_id ON milestones(project_id, start_date);
+
+-- Задача входит не больше чем в одну веху своего проекта; с удалением вехи задачи остаются
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS milestone_id INTEGER REFERENCES milestones(id) ON DELETE SET NULL;
+ALTER TABLE tasks ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_milestone_id ON tasks(milestone_id) WHERE milestone_id IS NOT NULL;
+
+-- Для задач, выполненных до появления столбца, точный момент неизвестен: берем последнее изменение.
+-- Политики RLS иначе скрыли бы строки от владельца таблиц.
+SET app.all_workspaces = 'on';
+UPDATE tasks SET completed_at = updated_at WHERE completed AND completed_at IS NULL;
+RESET app.all_workspaces;
+
+ALTER TABLE milestones ENABLE ROW LEVEL SECURITY;
+ALTER TABLE milestones FORCE ROW LEVEL SECURITY;
+DROP POLICY IF EXISTS workspace_isolation ON milestones;
+CREATE POLICY workspace_isolation ON milestones
+    USING (app_workspace_visible(workspace_id)) WITH CHECK (app_workspace_visible(workspace_id));
+
+INSERT INTO schema_migrations (version) VALUES (17) ON CONFLICT DO NOTHING;
@@ -0,0 +1,5 @@
+-- Индексы для статистики: выполненные задачи по времени выполнения и серии выполнений пользователя
+CREATE INDEX IF NOT EXISTS idx_tasks_completed_at ON tasks(completed_at) WHERE completed_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_task_events_completed ON task_events(actor_id, created_at) WHERE type = 'completed';
+
+INSERT INTO schema_migrations (version) VALUES (18) ON CONFLICT DO NOTHING;
@@ -0,0 +1,5 @@
+-- Каналы, которые уже доставили текущее напоминание: после ошибки одного из каналов
+-- повтор отправляется только в остальные. Очищается при успешной отправке
+ALTER TABLE task_reminders ADD COLUMN IF NOT EXISTS delivered_channels TEXT[] NOT NULL DEFAULT '{}';
+
+INSERT INTO schema_migrations (version) VALUES (19) ON CONFLICT DO NOTHING;